@@ -0,0 +1,187 @@
+// msrpc-call invokes the RPC operation with the JSON request and prints the
+// JSON response.
+//
+// Usage:
+//
+//	msrpc-call [flags] <server> -interface srvsvc -op NetrShareEnum -request '{...}'
+//	msrpc-call [flags] <server> -script session.json
+//	msrpc-call -list [-interface samr]
+//
+// The server is the IP address, host name or string binding. The request is
+// the JSON document (or @file, or - for the standard input) with the field
+// names of the operation Request structure.
+//
+// The session script is the JSON array (or the sequence) of the steps:
+//
+//	[
+//	  {"id": "connect", "interface": "samr", "operation": "SamrConnect", "request": {"desired_access": 33554432}},
+//	  {"operation": "SamrEnumerateDomainsInSamServer", "request": {"server": "${connect.server}", "preferred_maximum_length": 4096}}
+//	]
+//
+// The "${id.path}" references are replaced with the values from the previous
+// step responses, so that the context handles returned by one call are
+// passed to the next.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+
+	config "github.com/oiweiwei/go-msrpc/config"
+	config_flag "github.com/oiweiwei/go-msrpc/config/flag"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/registry"
+	_ "github.com/oiweiwei/go-msrpc/msrpc/registry/all"
+
+	_ "github.com/oiweiwei/go-msrpc/msrpc/erref/hresult"
+	_ "github.com/oiweiwei/go-msrpc/msrpc/erref/ntstatus"
+	_ "github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+)
+
+var (
+	cfg = config.New().DisableEPM()
+
+	iface   string
+	op      string
+	request string
+	script  string
+	list    bool
+)
+
+func init() {
+	config_flag.BindFlags(cfg, flag.CommandLine)
+
+	flag.StringVar(&iface, "interface", "", "interface name with optional version: srvsvc, samr/v1")
+	flag.StringVar(&op, "op", "", "operation name or number")
+	flag.StringVar(&request, "request", "", "JSON request, @file or - for stdin")
+	flag.StringVar(&script, "script", "", "session script file")
+	flag.BoolVar(&list, "list", false, "list the interfaces or the interface operations")
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+
+	flag.Parse()
+
+	if list {
+		return printList(os.Stdout)
+	}
+
+	if cfg.Server == "" && flag.NArg() > 0 {
+		cfg.Server = flag.Arg(0)
+		if err := flag.CommandLine.Parse(flag.Args()[1:]); err != nil {
+			return err
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	steps, err := loadSteps()
+	if err != nil {
+		return err
+	}
+
+	ctx := gssapi.NewSecurityContext(context.Background())
+
+	cc, err := dcerpc.Dial(ctx, cfg.ServerAddr(), cfg.DialOptions(ctx)...)
+	if err != nil {
+		return err
+	}
+
+	defer cc.Close(ctx)
+
+	sess := NewSession(cc, cfg.ClientOptions(ctx)...)
+
+	for _, step := range steps {
+		ret, err := sess.Run(ctx, step)
+		if err != nil {
+			return err
+		}
+		var out any = ret
+		if script == "" {
+			out = ret.Response
+		}
+		if out != nil {
+			b, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+		}
+		// the failed call stops the script.
+		if ret.Error != "" {
+			return fmt.Errorf("%s: %s", ret.Operation, ret.Error)
+		}
+	}
+
+	return nil
+}
+
+func loadSteps() ([]*Step, error) {
+
+	if script != "" {
+		b, err := os.ReadFile(script)
+		if err != nil {
+			return nil, err
+		}
+		return ParseScript(b)
+	}
+
+	if iface == "" || op == "" {
+		return nil, fmt.Errorf("-interface and -op or -script are required")
+	}
+
+	b, err := readRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Step{{Interface: iface, Operation: op, Request: b}}, nil
+}
+
+func readRequest(s string) ([]byte, error) {
+	switch {
+	case s == "-":
+		return io.ReadAll(os.Stdin)
+	case strings.HasPrefix(s, "@"):
+		return os.ReadFile(s[1:])
+	}
+	return []byte(s), nil
+}
+
+func printList(w io.Writer) error {
+
+	if iface == "" {
+		for _, iface := range registry.Interfaces() {
+			fmt.Fprintf(w, "%-40s %s %s\n", iface, iface.UUID(), iface.Package)
+		}
+		return nil
+	}
+
+	ifaces := registry.LookupName(iface)
+	if len(ifaces) == 0 {
+		return fmt.Errorf("%w: %q", registry.ErrUnknownInterface, iface)
+	}
+
+	for _, op := range ifaces[0].AllOperations() {
+		fmt.Fprintf(w, "%4d %-40s %s\n", op.OpNum, op.Name, op.GoName())
+	}
+
+	return nil
+}
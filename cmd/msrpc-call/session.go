@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/registry"
+)
+
+// Step is the session script step.
+type Step struct {
+	// The step identifier, the response can be referenced by the
+	// later steps as "${id.path.to.field}".
+	ID string `json:"id,omitempty"`
+	// The interface name, optionally with the version ("samr/v1"). The
+	// previous step interface is used if empty.
+	Interface string `json:"interface,omitempty"`
+	// The operation name (the IDL name or the Go name) or number.
+	Operation string `json:"operation"`
+	// The JSON request.
+	Request json.RawMessage `json:"request,omitempty"`
+}
+
+// Result is the step result.
+type Result struct {
+	ID        string           `json:"id,omitempty"`
+	Interface string           `json:"interface"`
+	Operation string           `json:"operation"`
+	Response  registry.Message `json:"response,omitempty"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// Session is the invocation session. The session keeps the clients and the
+// step responses so that the context handles returned by one call can be
+// passed to the next.
+type Session struct {
+	// The connection.
+	Conn dcerpc.Conn
+	// The client options.
+	Options []dcerpc.Option
+	// The clients by the interface.
+	clients map[*registry.Interface]any
+	// The step responses (decoded as generic JSON values).
+	vars map[string]any
+	// The last step interface.
+	last string
+}
+
+// NewSession function returns the new session.
+func NewSession(cc dcerpc.Conn, opts ...dcerpc.Option) *Session {
+	return &Session{
+		Conn:    cc,
+		Options: opts,
+		clients: map[*registry.Interface]any{},
+		vars:    map[string]any{},
+	}
+}
+
+// ParseScript function parses the session script: the JSON array of steps or
+// the sequence of the JSON step objects.
+func ParseScript(b []byte) ([]*Step, error) {
+
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		var steps []*Step
+		if err := json.Unmarshal(b, &steps); err != nil {
+			return nil, fmt.Errorf("parse script: %w", err)
+		}
+		return steps, nil
+	}
+
+	var steps []*Step
+
+	for dec := json.NewDecoder(bytes.NewReader(b)); dec.More(); {
+		step := &Step{}
+		if err := dec.Decode(step); err != nil {
+			return nil, fmt.Errorf("parse script: step %d: %w", len(steps), err)
+		}
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// Run function runs the step.
+func (s *Session) Run(ctx context.Context, step *Step) (*Result, error) {
+
+	name := step.Interface
+	if name == "" {
+		name = s.last
+	}
+
+	ifaces := registry.LookupName(name)
+	if len(ifaces) == 0 {
+		return nil, fmt.Errorf("%w: %q", registry.ErrUnknownInterface, name)
+	}
+
+	iface := ifaces[0]
+
+	op, ok := iface.OperationByName(step.Operation)
+	if !ok {
+		if n, err := strconv.Atoi(step.Operation); err == nil {
+			op, ok = iface.Operation(n)
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %q", registry.ErrUnknownOperation, iface, step.Operation)
+	}
+
+	b, err := s.Expand(step.Request)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op.Name, err)
+	}
+
+	req := op.NewRequest()
+	if len(b) > 0 {
+		if err := registry.UnmarshalJSON(b, req); err != nil {
+			return nil, fmt.Errorf("%s: request: %w", op.Name, err)
+		}
+	}
+
+	cli, ok := s.clients[iface]
+	if !ok {
+		if cli, err = iface.NewClient(ctx, s.Conn, s.Options...); err != nil {
+			return nil, fmt.Errorf("%s: new client: %w", iface, err)
+		}
+		s.clients[iface] = cli
+	}
+
+	s.last = name
+
+	ret := &Result{ID: step.ID, Interface: iface.String(), Operation: op.Name}
+
+	resp, err := registry.Invoke(ctx, cli, op, req)
+	if err != nil {
+		ret.Error = err.Error()
+	}
+
+	if resp == nil {
+		return ret, nil
+	}
+
+	ret.Response = resp
+
+	if step.ID != "" {
+		if s.vars[step.ID], err = toJSONValue(resp); err != nil {
+			return nil, fmt.Errorf("%s: response: %w", op.Name, err)
+		}
+	}
+
+	return ret, nil
+}
+
+var reVar = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// Expand function replaces the references to the previous step responses in
+// the request. The string that consists of the single reference is replaced
+// with the referenced value (for example, the context handle object), the
+// reference inside the string is replaced with the value text.
+func (s *Session) Expand(b json.RawMessage) (json.RawMessage, error) {
+
+	if len(b) == 0 || !reVar.Match(b) {
+		return b, nil
+	}
+
+	v, err := toJSONValue(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, err = s.expand(v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v)
+}
+
+func (s *Session) expand(v any) (any, error) {
+
+	var err error
+
+	switch v := v.(type) {
+	case map[string]any:
+		for k := range v {
+			if v[k], err = s.expand(v[k]); err != nil {
+				return nil, err
+			}
+		}
+	case []any:
+		for i := range v {
+			if v[i], err = s.expand(v[i]); err != nil {
+				return nil, err
+			}
+		}
+	case string:
+		if m := reVar.FindStringSubmatch(v); m != nil && m[0] == v {
+			return s.Lookup(m[1])
+		}
+		ret := reVar.ReplaceAllStringFunc(v, func(ref string) string {
+			if err != nil {
+				return ref
+			}
+			var val any
+			if val, err = s.Lookup(ref[2 : len(ref)-1]); err != nil {
+				return ref
+			}
+			return fmt.Sprint(val)
+		})
+		return ret, err
+	}
+
+	return v, nil
+}
+
+// Lookup function returns the value referenced by the path
+// ("id.field.0.field").
+func (s *Session) Lookup(path string) (any, error) {
+
+	id, rest, _ := strings.Cut(path, ".")
+
+	v, ok := s.vars[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown step %q", id)
+	}
+
+	if rest == "" {
+		return v, nil
+	}
+
+	for _, key := range strings.Split(rest, ".") {
+		switch cur := v.(type) {
+		case map[string]any:
+			if v, ok = cur[key]; !ok {
+				return nil, fmt.Errorf("%s: unknown field %q", path, key)
+			}
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(cur) {
+				return nil, fmt.Errorf("%s: invalid index %q", path, key)
+			}
+			v = cur[i]
+		default:
+			return nil, fmt.Errorf("%s: %q is not an object", path, key)
+		}
+	}
+
+	return v, nil
+}
+
+func toJSONValue(v any) (any, error) {
+
+	b, ok := v.(json.RawMessage)
+	if !ok {
+		var err error
+		if b, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	var ret any
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	if err := dec.Decode(&ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/registry"
+	"github.com/oiweiwei/go-msrpc/msrpc/samr/samr/v1"
+)
+
+type testSAMRClient struct {
+	samr.SamrClient
+	handle *samr.Handle
+}
+
+func (c *testSAMRClient) Connect(ctx context.Context, in *samr.ConnectRequest, opts ...dcerpc.CallOption) (*samr.ConnectResponse, error) {
+	return &samr.ConnectResponse{Server: c.handle}, nil
+}
+
+func (c *testSAMRClient) EnumerateDomainsInSAMServer(ctx context.Context, in *samr.EnumerateDomainsInSAMServerRequest, opts ...dcerpc.CallOption) (*samr.EnumerateDomainsInSAMServerResponse, error) {
+
+	if !in.Server.UUID.Equal(c.handle.UUID) {
+		return nil, fmt.Errorf("unexpected handle %v", in.Server.UUID)
+	}
+
+	return &samr.EnumerateDomainsInSAMServerResponse{
+		EnumerationContext: in.PreferredMaximumLength,
+		Buffer: &samr.EnumerationBuffer{
+			EntriesRead: 1,
+			Buffer:      []*samr.RIDEnumeration{{RelativeID: 0, Name: &dtyp.UnicodeString{Buffer: "Builtin"}}},
+		},
+		CountReturned: 1,
+		Return:        0x00000105,
+	}, fmt.Errorf("more entries")
+}
+
+func TestSession(t *testing.T) {
+
+	steps, err := ParseScript([]byte(`
+		{"id": "connect", "interface": "samr/v1", "operation": "SamrConnect", "request": {"desired_access": 33554432}}
+		{"id": "enum", "operation": "EnumerateDomainsInSAMServer", "request": {"server": "${connect.server}", "preferred_maximum_length": 4096}}
+		{"operation": "6", "request": {"server": "${connect.server}", "enumeration_context": "${enum.enumeration_context}"}}
+	`))
+	if err != nil {
+		t.Fatalf("parse script: %v", err)
+	}
+
+	if len(steps) != 3 {
+		t.Fatalf("parse script: unexpected steps %v", steps)
+	}
+
+	cli := &testSAMRClient{handle: &samr.Handle{UUID: dtyp.GUIDFromUUID(uuid.MustParse("3ad5ea4d-ff0b-41ab-a8e4-b0e4b2e7f2a1"))}}
+
+	iface, _ := registry.Lookup(samr.SamrSyntaxV1_0)
+
+	sess := NewSession(nil)
+	sess.clients[iface] = cli
+
+	ret, err := sess.Run(context.Background(), steps[0])
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if resp, ok := ret.Response.(*samr.ConnectResponse); !ok || resp.Server != cli.handle {
+		t.Fatalf("run: unexpected response %v", ret.Response)
+	}
+
+	ret, err = sess.Run(context.Background(), steps[1])
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if ret.Error != "more entries" || ret.Response.(*samr.EnumerateDomainsInSAMServerResponse).Buffer.Buffer[0].Name.Buffer != "Builtin" {
+		t.Fatalf("run: unexpected result %+v", ret)
+	}
+
+	if v, err := sess.Lookup("enum.buffer.buffer.0.name.buffer"); err != nil || v != "Builtin" {
+		t.Errorf("lookup: unexpected value %v: %v", v, err)
+	}
+
+	b, err := sess.Expand(steps[2].Request)
+	if err != nil {
+		t.Fatalf("expand: %v", err)
+	}
+
+	req := &samr.EnumerateDomainsInSAMServerRequest{}
+	if err := registry.UnmarshalJSON(b, req); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if req.EnumerationContext != 4096 || !req.Server.UUID.Equal(cli.handle.UUID) {
+		t.Errorf("expand: unexpected request %s", b)
+	}
+
+	// the reference inside the string is replaced with the value text.
+	if b, err := sess.Expand([]byte(`{"name": "${enum.buffer.buffer.0.name.buffer}/${enum.count_returned}"}`)); err != nil || string(b) != `{"name":"Builtin/1"}` {
+		t.Errorf("expand: unexpected result %s: %v", b, err)
+	}
+
+	if _, err := sess.Lookup("unknown.server"); err == nil {
+		t.Errorf("lookup: expected unknown step error")
+	}
+}
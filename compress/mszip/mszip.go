@@ -0,0 +1,104 @@
+// Package mszip implements the MSZIP compression: the sequence of blocks, where
+// each block is the "CK" signature followed by the deflate stream, and each block
+// uses the previous uncompressed block as the dictionary.
+package mszip
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+)
+
+const (
+	// BlockSize is the maximum size of the uncompressed block.
+	BlockSize = 32768
+)
+
+// Signature is the MSZIP block signature.
+var Signature = []byte("CK")
+
+// CompressBlock function compresses the block of at most BlockSize bytes using
+// the previous uncompressed block dict as the dictionary.
+func CompressBlock(b, dict []byte) ([]byte, error) {
+
+	if len(b) > BlockSize {
+		return nil, fmt.Errorf("mszip: block is too large: %d", len(b))
+	}
+
+	buf := bytes.NewBuffer(append([]byte(nil), Signature...))
+
+	w, err := flate.NewWriterDict(buf, flate.DefaultCompression, dict)
+	if err != nil {
+		return nil, fmt.Errorf("mszip: %w", err)
+	}
+
+	if _, err := w.Write(b); err != nil {
+		return nil, fmt.Errorf("mszip: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("mszip: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecompressBlock function decompresses the block using the previous uncompressed
+// block dict as the dictionary.
+func DecompressBlock(b, dict []byte) ([]byte, error) {
+
+	if !bytes.HasPrefix(b, Signature) {
+		return nil, fmt.Errorf("mszip: invalid block signature")
+	}
+
+	r := flate.NewReaderDict(bytes.NewReader(b[len(Signature):]), dict)
+	defer r.Close()
+
+	out, err := io.ReadAll(io.LimitReader(r, BlockSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("mszip: %w", err)
+	}
+
+	if len(out) > BlockSize {
+		return nil, fmt.Errorf("mszip: block is too large")
+	}
+
+	return out, nil
+}
+
+// Compress function compresses the data into the sequence of blocks.
+func Compress(b []byte) ([][]byte, error) {
+
+	var (
+		blocks [][]byte
+		dict   []byte
+	)
+
+	for start := 0; start < len(b); start += BlockSize {
+		block := b[start:min(start+BlockSize, len(b))]
+		cb, err := CompressBlock(block, dict)
+		if err != nil {
+			return nil, err
+		}
+		blocks, dict = append(blocks, cb), block
+	}
+
+	return blocks, nil
+}
+
+// Decompress function decompresses the sequence of blocks.
+func Decompress(blocks [][]byte) ([]byte, error) {
+
+	var out, dict []byte
+
+	for _, cb := range blocks {
+		block, err := DecompressBlock(cb, dict)
+		if err != nil {
+			return nil, err
+		}
+		out, dict = append(out, block...), block
+	}
+
+	return out, nil
+}
@@ -0,0 +1,199 @@
+package xca
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	// lz77Window is the maximum match offset for the Plain LZ77.
+	lz77Window = 8192
+)
+
+// CompressLZ77 function compresses the data using the Plain LZ77 algorithm.
+func CompressLZ77(b []byte) ([]byte, error) {
+
+	var (
+		out                   = make([]byte, 4, len(b)+len(b)/8+8)
+		flags, flagCount      uint32
+		flagPos, lastHalfByte int
+	)
+
+	m := newMatcher(b, lz77Window)
+
+	for pos := 0; pos < len(b); {
+
+		length, offset := m.find(pos, 0, len(b)-pos)
+
+		if length == 0 {
+			out = append(out, b[pos])
+			m.insert(pos)
+			pos++
+			flags <<= 1
+		} else {
+
+			m.insertRange(pos, length)
+			pos += length
+
+			length -= matchMinimum
+
+			if length < 7 {
+				out = binary.LittleEndian.AppendUint16(out, uint16((offset-1)<<3|length))
+			} else {
+				out = binary.LittleEndian.AppendUint16(out, uint16((offset-1)<<3|7))
+
+				nibble := min(length-7, 15)
+				if lastHalfByte == 0 {
+					lastHalfByte = len(out)
+					out = append(out, byte(nibble))
+				} else {
+					out[lastHalfByte] |= byte(nibble << 4)
+					lastHalfByte = 0
+				}
+
+				if length-7 >= 15 {
+					switch {
+					case length-7-15 < 255:
+						out = append(out, byte(length-7-15))
+					case length < 1<<16:
+						out = append(out, 255)
+						out = binary.LittleEndian.AppendUint16(out, uint16(length))
+					default:
+						out = append(out, 255, 0, 0)
+						out = binary.LittleEndian.AppendUint32(out, uint32(length))
+					}
+				}
+			}
+
+			flags = flags<<1 | 1
+		}
+
+		if flagCount++; flagCount == 32 {
+			binary.LittleEndian.PutUint32(out[flagPos:], flags)
+			flags, flagCount, flagPos = 0, 0, len(out)
+			out = append(out, 0, 0, 0, 0)
+		}
+	}
+
+	// the unused flag bits are set to indicate the end of data.
+	flags = uint32(uint64(flags)<<(32-flagCount) | (uint64(1)<<(32-flagCount) - 1))
+	binary.LittleEndian.PutUint32(out[flagPos:], flags)
+
+	return out, nil
+}
+
+// DecompressLZ77 function decompresses the Plain LZ77 compressed data.
+// The maxSize is the maximum size of the uncompressed data, if the maxSize
+// is negative, the size is not limited.
+func DecompressLZ77(b []byte, maxSize int) ([]byte, error) {
+
+	if maxSize < 0 {
+		maxSize = math.MaxInt
+	}
+
+	var (
+		out               = make([]byte, 0, min(len(b)*2, maxSize))
+		flags, flagCount  uint32
+		pos, lastHalfByte int
+		errCorrupted      = fmt.Errorf("xca: lz77: %w", ErrCorrupted)
+	)
+
+	// read function reads the n-byte little-endian value.
+	read := func(n int) (int, bool) {
+		if pos+n > len(b) {
+			return 0, false
+		}
+		v := 0
+		for i := n - 1; i >= 0; i-- {
+			v = v<<8 | int(b[pos+i])
+		}
+		pos += n
+		return v, true
+	}
+
+	for {
+
+		if flagCount == 0 {
+			v, ok := read(4)
+			if !ok {
+				return out, nil
+			}
+			flags, flagCount = uint32(v), 32
+		}
+
+		flagCount--
+
+		if flags&(1<<flagCount) == 0 {
+			if pos >= len(b) {
+				return out, nil
+			}
+			if len(out) >= maxSize {
+				return nil, fmt.Errorf("xca: lz77: %w", ErrTooLarge)
+			}
+			out = append(out, b[pos])
+			pos++
+			continue
+		}
+
+		if pos == len(b) {
+			return out, nil
+		}
+
+		token, ok := read(2)
+		if !ok {
+			return nil, errCorrupted
+		}
+
+		length, offset := token%8, token/8+1
+
+		if length == 7 {
+
+			if lastHalfByte == 0 {
+				if pos >= len(b) {
+					return nil, errCorrupted
+				}
+				length, lastHalfByte = int(b[pos]%16), pos
+				pos++
+			} else {
+				length, lastHalfByte = int(b[lastHalfByte]/16), 0
+			}
+
+			if length == 15 {
+
+				if length, ok = read(1); !ok {
+					return nil, errCorrupted
+				}
+
+				if length == 255 {
+					if length, ok = read(2); !ok {
+						return nil, errCorrupted
+					}
+					if length == 0 {
+						if length, ok = read(4); !ok {
+							return nil, errCorrupted
+						}
+					}
+					if length < 15+7 {
+						return nil, errCorrupted
+					}
+					length -= 15 + 7
+				}
+
+				length += 15
+			}
+
+			length += 7
+		}
+
+		if offset > len(out) {
+			return nil, errCorrupted
+		}
+
+		if length+matchMinimum > maxSize-len(out) {
+			return nil, fmt.Errorf("xca: lz77: %w", ErrTooLarge)
+		}
+
+		out = copyMatch(out, offset, length+matchMinimum)
+	}
+}
@@ -0,0 +1,405 @@
+package xca
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+const (
+	// huffmanBlockSize is the size of the uncompressed LZ77+Huffman block.
+	huffmanBlockSize = 65536
+	// huffmanWindow is the maximum match offset for the LZ77+Huffman.
+	huffmanWindow = 65535
+	// huffmanSymbols is the number of the Huffman symbols.
+	huffmanSymbols = 512
+	// huffmanMaxBits is the maximum Huffman code length.
+	huffmanMaxBits = 15
+	// huffmanEOF is the end-of-data symbol.
+	huffmanEOF = 256
+)
+
+type huffmanToken struct {
+	literal        byte
+	length, offset int
+}
+
+// symbol function returns the Huffman symbol for the token.
+func (t huffmanToken) symbol() int {
+	if t.length == 0 {
+		return int(t.literal)
+	}
+	return 256 + (bits.Len(uint(t.offset))-1)<<4 + min(t.length-matchMinimum, 15)
+}
+
+// CompressLZ77Huffman function compresses the data using the LZ77+Huffman
+// algorithm.
+func CompressLZ77Huffman(b []byte) ([]byte, error) {
+
+	out := make([]byte, 0, len(b)/2+huffmanSymbols)
+
+	m := newMatcher(b, huffmanWindow)
+
+	for start := 0; ; start += huffmanBlockSize {
+
+		end := min(start+huffmanBlockSize, len(b))
+
+		var (
+			tokens []huffmanToken
+			freq   = make([]int, huffmanSymbols)
+		)
+
+		for pos := start; pos < end; {
+			tok := huffmanToken{literal: b[pos], length: 0}
+			// the match must not cross the block boundary.
+			if tok.length, tok.offset = m.find(pos, 0, end-pos); tok.length == 0 {
+				m.insert(pos)
+				pos++
+			} else {
+				m.insertRange(pos, tok.length)
+				pos += tok.length
+			}
+			tokens = append(tokens, tok)
+			freq[tok.symbol()]++
+		}
+
+		// the end-of-data symbol is written to the block which is not full,
+		// so that the decoder does not attempt to read the next block.
+		last := end-start < huffmanBlockSize
+		if last {
+			freq[huffmanEOF]++
+		}
+
+		lengths := huffmanLengths(freq, huffmanMaxBits)
+		codes := huffmanCodes(lengths)
+
+		table := make([]byte, huffmanSymbols/2)
+		for i := range lengths {
+			table[i/2] |= lengths[i] << (4 * (i % 2))
+		}
+
+		w := newBitWriter(append(out, table...))
+
+		for _, tok := range tokens {
+
+			sym := tok.symbol()
+			w.writeBits(uint32(codes[sym]), int(lengths[sym]))
+
+			if tok.length == 0 {
+				continue
+			}
+
+			if length := tok.length - matchMinimum; length >= 15 {
+				switch {
+				case length-15 < 255:
+					w.out = append(w.out, byte(length-15))
+				case length < 1<<16:
+					w.out = append(w.out, 255)
+					w.out = binary.LittleEndian.AppendUint16(w.out, uint16(length))
+				default:
+					w.out = append(w.out, 255, 0, 0)
+					w.out = binary.LittleEndian.AppendUint32(w.out, uint32(length))
+				}
+			}
+
+			n := bits.Len(uint(tok.offset)) - 1
+			w.writeBits(uint32(tok.offset-1<<n), n)
+		}
+
+		if last {
+			w.writeBits(uint32(codes[huffmanEOF]), int(lengths[huffmanEOF]))
+		}
+
+		if out = w.flush(); last {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+// DecompressLZ77Huffman function decompresses the LZ77+Huffman compressed data.
+// The size is the size of the uncompressed data, if the size is negative, the
+// decompression stops at the end-of-data symbol.
+func DecompressLZ77Huffman(b []byte, size int) ([]byte, error) {
+
+	var (
+		out          = make([]byte, 0, max(size, 0))
+		errCorrupted = fmt.Errorf("xca: lz77+huffman: %w", ErrCorrupted)
+	)
+
+	read16 := func(i int) uint32 {
+		if i+2 > len(b) {
+			return 0
+		}
+		return uint32(binary.LittleEndian.Uint16(b[i:]))
+	}
+
+	done := func() bool { return size >= 0 && len(out) >= size }
+
+	for pos := 0; !done(); {
+
+		if pos+huffmanSymbols/2 > len(b) {
+			return nil, fmt.Errorf("xca: lz77+huffman: input is truncated")
+		}
+
+		lengths, table, err := huffmanTable(b[pos : pos+huffmanSymbols/2])
+		if err != nil {
+			return nil, err
+		}
+
+		pos += huffmanSymbols / 2
+
+		next, extra := read16(pos)<<16|read16(pos+2), 16
+		pos += 4
+
+		// consume function drops n bits and refills the bit buffer.
+		consume := func(n int) {
+			if next, extra = next<<n, extra-n; extra < 0 {
+				next |= read16(pos) << -extra
+				extra += 16
+				pos += 2
+			}
+		}
+
+		for blockEnd := len(out) + huffmanBlockSize; len(out) < blockEnd && !done(); {
+
+			sym := int(table[next>>(32-huffmanMaxBits)])
+			if lengths[sym] == 0 {
+				return nil, errCorrupted
+			}
+
+			consume(int(lengths[sym]))
+
+			if sym < 256 {
+				out = append(out, byte(sym))
+				continue
+			}
+
+			if sym == huffmanEOF && size < 0 && pos >= len(b) {
+				return out, nil
+			}
+
+			sym -= 256
+
+			length, n := sym&15, sym>>4
+
+			if length == 15 {
+
+				if pos >= len(b) {
+					return nil, errCorrupted
+				}
+
+				length = int(b[pos])
+				pos++
+
+				if length == 255 {
+					if pos+2 > len(b) {
+						return nil, errCorrupted
+					}
+					length = int(binary.LittleEndian.Uint16(b[pos:]))
+					pos += 2
+					if length == 0 {
+						if pos+4 > len(b) {
+							return nil, errCorrupted
+						}
+						length = int(binary.LittleEndian.Uint32(b[pos:]))
+						pos += 4
+					}
+					if length < 15 {
+						return nil, errCorrupted
+					}
+					length -= 15
+				}
+
+				length += 15
+			}
+
+			length += matchMinimum
+
+			offset := 1<<n + int(next>>(32-n))
+			consume(n)
+
+			if offset > len(out) {
+				return nil, errCorrupted
+			}
+
+			if size >= 0 {
+				length = min(length, size-len(out))
+			}
+
+			out = copyMatch(out, offset, length)
+		}
+	}
+
+	return out, nil
+}
+
+// huffmanTable function parses the 4-bit code lengths and builds the decoding
+// table indexed by the next 15 bits of the input. The unused table entries refer
+// to the symbol with the zero length.
+func huffmanTable(b []byte) ([]uint8, []uint16, error) {
+
+	lengths := make([]uint8, huffmanSymbols+1)
+	for i := 0; i < huffmanSymbols; i++ {
+		lengths[i] = b[i/2] >> (4 * (i % 2)) & 0x0F
+	}
+
+	table, pos := make([]uint16, 1<<huffmanMaxBits), 0
+
+	for n := 1; n <= huffmanMaxBits; n++ {
+		for sym := 0; sym < huffmanSymbols; sym++ {
+			if int(lengths[sym]) != n {
+				continue
+			}
+			if pos+1<<(huffmanMaxBits-n) > len(table) {
+				return nil, nil, fmt.Errorf("xca: lz77+huffman: invalid huffman table")
+			}
+			for end := pos + 1<<(huffmanMaxBits-n); pos < end; pos++ {
+				table[pos] = uint16(sym)
+			}
+		}
+	}
+
+	for ; pos < len(table); pos++ {
+		table[pos] = huffmanSymbols
+	}
+
+	return lengths, table, nil
+}
+
+// huffmanLengths function returns the code lengths limited to maxBits. If the
+// tree is too deep, the frequencies are halved until it fits.
+func huffmanLengths(freq []int, maxBits int) []uint8 {
+
+	freq = append([]int(nil), freq...)
+
+	for {
+		lengths, depth := huffmanTree(freq)
+		if depth <= maxBits {
+			return lengths
+		}
+		for i := range freq {
+			if freq[i] > 0 {
+				freq[i] = freq[i]>>1 | 1
+			}
+		}
+	}
+}
+
+// huffmanTree function builds the Huffman tree and returns the code lengths
+// and the maximum code length.
+func huffmanTree(freq []int) ([]uint8, int) {
+
+	lengths := make([]uint8, len(freq))
+
+	var leaves []int
+	for sym := range freq {
+		if freq[sym] > 0 {
+			leaves = append(leaves, sym)
+		}
+	}
+
+	switch len(leaves) {
+	case 0:
+		return lengths, 0
+	case 1:
+		lengths[leaves[0]] = 1
+		return lengths, 1
+	}
+
+	sort.SliceStable(leaves, func(i, j int) bool { return freq[leaves[i]] < freq[leaves[j]] })
+
+	// the leaves are the nodes [0, n), the internal nodes are [n, 2n-1) and are
+	// created in the order of the increasing weight.
+	n := len(leaves)
+
+	weight, parent := make([]int, 2*n-1), make([]int, 2*n-1)
+	for i, sym := range leaves {
+		weight[i] = freq[sym]
+	}
+
+	leaf, node, next := 0, n, n
+
+	pick := func() int {
+		if leaf < n && (node >= next || weight[leaf] <= weight[node]) {
+			leaf++
+			return leaf - 1
+		}
+		node++
+		return node - 1
+	}
+
+	for ; next < 2*n-1; next++ {
+		a, b := pick(), pick()
+		weight[next], parent[a], parent[b] = weight[a]+weight[b], next, next
+	}
+
+	depth, maxDepth := make([]int, 2*n-1), 0
+	for i := 2*n - 3; i >= 0; i-- {
+		depth[i] = depth[parent[i]] + 1
+	}
+
+	for i, sym := range leaves {
+		lengths[sym], maxDepth = uint8(depth[i]), max(maxDepth, depth[i])
+	}
+
+	return lengths, maxDepth
+}
+
+// huffmanCodes function returns the canonical Huffman codes ordered by the
+// code length and then by the symbol value.
+func huffmanCodes(lengths []uint8) []uint16 {
+
+	codes, code := make([]uint16, len(lengths)), 0
+
+	for n := 1; n <= huffmanMaxBits; n++ {
+		for sym := range lengths {
+			if int(lengths[sym]) == n {
+				codes[sym] = uint16(code)
+				code++
+			}
+		}
+		code <<= 1
+	}
+
+	return codes
+}
+
+// bitWriter writes the Huffman bit stream as the sequence of the 16-bit
+// little-endian words. The raw bytes (extended match lengths) are appended
+// after the next reserved word, which matches the decoder read-ahead.
+type bitWriter struct {
+	out        []byte
+	pos1, pos2 int
+	value      uint32
+	free       int
+}
+
+func newBitWriter(out []byte) *bitWriter {
+	return &bitWriter{out: append(out, 0, 0, 0, 0), pos1: len(out), pos2: len(out) + 2, free: 16}
+}
+
+func (w *bitWriter) writeBits(v uint32, n int) {
+
+	if n <= w.free {
+		w.value, w.free = w.value<<n|v, w.free-n
+		return
+	}
+
+	n -= w.free
+
+	binary.LittleEndian.PutUint16(w.out[w.pos1:], uint16(w.value<<w.free|v>>n))
+
+	w.pos1, w.pos2 = w.pos2, len(w.out)
+	w.out = append(w.out, 0, 0)
+
+	w.value, w.free = v&(1<<n-1), 16-n
+}
+
+// flush function writes the pending bits and returns the output.
+func (w *bitWriter) flush() []byte {
+	binary.LittleEndian.PutUint16(w.out[w.pos1:], uint16(w.value<<w.free))
+	return w.out
+}
@@ -0,0 +1,181 @@
+package xca
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	// lznt1ChunkSize is the size of the uncompressed LZNT1 chunk.
+	lznt1ChunkSize = 4096
+	// lznt1Signature is the chunk signature.
+	lznt1Signature = 0x3000
+	// lznt1Compressed is the chunk compressed flag.
+	lznt1Compressed = 0x8000
+)
+
+// CompressLZNT1 function compresses the data using the LZNT1 algorithm.
+// The chunk that does not benefit from the compression is stored as is.
+func CompressLZNT1(b []byte) ([]byte, error) {
+
+	out := make([]byte, 0, len(b)+(len(b)/lznt1ChunkSize+1)*2)
+
+	m := newMatcher(b, lznt1ChunkSize)
+
+	for start := 0; start < len(b); start += lznt1ChunkSize {
+
+		end := min(start+lznt1ChunkSize, len(b))
+
+		if chunk := compressLZNT1Chunk(m, start, end); len(chunk) < end-start {
+			out = binary.LittleEndian.AppendUint16(out, uint16(lznt1Compressed|lznt1Signature|(len(chunk)-1)))
+			out = append(out, chunk...)
+		} else {
+			out = binary.LittleEndian.AppendUint16(out, uint16(lznt1Signature|(end-start-1)))
+			out = append(out, b[start:end]...)
+		}
+	}
+
+	return out, nil
+}
+
+func compressLZNT1Chunk(m *matcher, start, end int) []byte {
+
+	var out []byte
+
+	for pos := start; pos < end; {
+
+		flags := len(out)
+		out = append(out, 0)
+
+		for bit := 0; bit < 8 && pos < end; bit++ {
+
+			var length, offset, lengthBits int
+
+			if pos > start {
+				lengthBits = lznt1LengthBits(pos - start)
+				length, offset = m.find(pos, start, min(end-pos, (1<<lengthBits)-1+matchMinimum))
+			}
+
+			if length == 0 {
+				out = append(out, m.b[pos])
+				m.insert(pos)
+				pos++
+				continue
+			}
+
+			out[flags] |= 1 << bit
+			out = binary.LittleEndian.AppendUint16(out, uint16((offset-1)<<lengthBits|(length-matchMinimum)))
+			m.insertRange(pos, length)
+			pos += length
+		}
+	}
+
+	return out
+}
+
+// DecompressLZNT1 function decompresses the LZNT1 compressed data.
+// The maxSize is the maximum size of the uncompressed data, if the maxSize
+// is negative, the size is not limited.
+func DecompressLZNT1(b []byte, maxSize int) ([]byte, error) {
+
+	if maxSize < 0 {
+		maxSize = math.MaxInt
+	}
+
+	var out []byte
+
+	for len(b) >= 2 {
+
+		hdr := binary.LittleEndian.Uint16(b)
+		if hdr == 0 {
+			break
+		}
+
+		size := int(hdr&0x0FFF) + 1
+		if len(b) < 2+size {
+			return nil, fmt.Errorf("xca: lznt1: chunk is truncated")
+		}
+
+		chunk := b[2 : 2+size]
+		b = b[2+size:]
+
+		start := len(out)
+
+		// grow function checks that n more bytes fit into the uncompressed
+		// chunk and into the maximum size.
+		grow := func(n int) error {
+			if n > lznt1ChunkSize-(len(out)-start) {
+				return fmt.Errorf("xca: lznt1: chunk is too large: %w", ErrCorrupted)
+			}
+			if n > maxSize-len(out) {
+				return fmt.Errorf("xca: lznt1: %w", ErrTooLarge)
+			}
+			return nil
+		}
+
+		if hdr&lznt1Compressed == 0 {
+			if err := grow(len(chunk)); err != nil {
+				return nil, err
+			}
+			out = append(out, chunk...)
+			continue
+		}
+
+		for i := 0; i < len(chunk); {
+
+			flags := chunk[i]
+			i++
+
+			for bit := 0; bit < 8 && i < len(chunk); bit++ {
+
+				if flags&(1<<bit) == 0 {
+					if err := grow(1); err != nil {
+						return nil, err
+					}
+					out = append(out, chunk[i])
+					i++
+					continue
+				}
+
+				if i+2 > len(chunk) {
+					return nil, fmt.Errorf("xca: lznt1: %w", ErrCorrupted)
+				}
+
+				token := int(binary.LittleEndian.Uint16(chunk[i:]))
+				i += 2
+
+				pos := len(out) - start
+				if pos == 0 {
+					return nil, fmt.Errorf("xca: lznt1: %w", ErrCorrupted)
+				}
+
+				lengthBits := lznt1LengthBits(pos)
+
+				offset := token>>lengthBits + 1
+				if offset > pos {
+					return nil, fmt.Errorf("xca: lznt1: %w", ErrCorrupted)
+				}
+
+				length := token&(1<<lengthBits-1) + matchMinimum
+				if err := grow(length); err != nil {
+					return nil, err
+				}
+
+				out = copyMatch(out, offset, length)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// lznt1LengthBits function returns the number of the length bits in the
+// match token for the position within the chunk.
+func lznt1LengthBits(pos int) int {
+	bits := 12
+	for i := pos - 1; i >= 0x10; i >>= 1 {
+		bits--
+	}
+	return bits
+}
@@ -0,0 +1,96 @@
+// Package xca implements the [MS-XCA] Xpress Compression Algorithm codecs:
+// LZNT1, Plain LZ77 and LZ77+Huffman.
+package xca
+
+import (
+	"errors"
+)
+
+var (
+	// ErrCorrupted is returned when the compressed data is invalid.
+	ErrCorrupted = errors.New("xca: compressed data is corrupted")
+	// ErrTooLarge is returned when the decompressed data exceeds the maximum size.
+	ErrTooLarge = errors.New("xca: decompressed data is too large")
+)
+
+const (
+	matchHashBits = 15
+	matchMaxChain = 64
+	matchMinimum  = 3
+)
+
+// matcher is a hash-chain based match finder.
+type matcher struct {
+	b      []byte
+	window int
+	head   []int32
+	prev   []int32
+}
+
+func newMatcher(b []byte, window int) *matcher {
+	m := &matcher{b: b, window: window, head: make([]int32, 1<<matchHashBits), prev: make([]int32, len(b))}
+	for i := range m.head {
+		m.head[i] = -1
+	}
+	return m
+}
+
+func (m *matcher) hash(i int) uint32 {
+	return (uint32(m.b[i]) | uint32(m.b[i+1])<<8 | uint32(m.b[i+2])<<16) * 2654435761 >> (32 - matchHashBits)
+}
+
+// insert function adds the position i to the hash chain.
+func (m *matcher) insert(i int) {
+	if i+matchMinimum > len(m.b) {
+		return
+	}
+	h := m.hash(i)
+	m.prev[i], m.head[h] = m.head[h], int32(i)
+}
+
+// find function returns the length and the offset of the longest match for the
+// position i that starts at or after the position low and is at most n bytes long.
+func (m *matcher) find(i, low, n int) (int, int) {
+
+	if n = min(n, len(m.b)-i); n < matchMinimum {
+		return 0, 0
+	}
+
+	low = max(low, i-m.window)
+
+	length, offset := 0, 0
+
+	for j, c := m.head[m.hash(i)], 0; j >= 0 && int(j) >= low && c < matchMaxChain; j, c = m.prev[j], c+1 {
+		l := 0
+		for l < n && m.b[int(j)+l] == m.b[i+l] {
+			l++
+		}
+		if l > length {
+			if length, offset = l, i-int(j); l == n {
+				break
+			}
+		}
+	}
+
+	if length < matchMinimum {
+		return 0, 0
+	}
+
+	return length, offset
+}
+
+// insertRange function adds the positions [i, i+n) to the hash chain.
+func (m *matcher) insertRange(i, n int) {
+	for ; n > 0; i, n = i+1, n-1 {
+		m.insert(i)
+	}
+}
+
+// copyMatch function appends length bytes located offset bytes before
+// the end of the output. The source and destination may overlap.
+func copyMatch(out []byte, offset, length int) []byte {
+	for i := len(out) - offset; length > 0; i, length = i+1, length-1 {
+		out = append(out, out[i])
+	}
+	return out
+}
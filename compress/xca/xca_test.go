@@ -0,0 +1,116 @@
+package xca
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+
+	rnd := rand.New(rand.NewSource(1))
+
+	random := make([]byte, 70000)
+	rnd.Read(random)
+
+	text := bytes.Repeat([]byte("abcdefghijklmnopqrstuvwxyz, the quick brown fox. "), 3000)
+	for i := 0; i < len(text); i += 97 {
+		text[i] = byte(rnd.Intn(256))
+	}
+
+	inputs := map[string][]byte{
+		"empty":  {},
+		"byte":   {'a'},
+		"zeros":  make([]byte, 200000),
+		"block":  bytes.Repeat([]byte("ab"), huffmanBlockSize/2),
+		"random": random,
+		"text":   text,
+	}
+
+	codecs := map[string][2]func([]byte) ([]byte, error){
+		"lznt1": {CompressLZNT1, func(b []byte) ([]byte, error) {
+			return DecompressLZNT1(b, -1)
+		}},
+		"lz77": {CompressLZ77, func(b []byte) ([]byte, error) {
+			return DecompressLZ77(b, -1)
+		}},
+		"lz77+huffman": {CompressLZ77Huffman, func(b []byte) ([]byte, error) {
+			return DecompressLZ77Huffman(b, -1)
+		}},
+	}
+
+	for codec, fn := range codecs {
+		for name, in := range inputs {
+
+			cb, err := fn[0](in)
+			if err != nil {
+				t.Fatalf("%s: %s: compress: %v", codec, name, err)
+			}
+
+			out, err := fn[1](cb)
+			if err != nil {
+				t.Fatalf("%s: %s: decompress: %v", codec, name, err)
+			}
+
+			if !bytes.Equal(in, out) {
+				t.Errorf("%s: %s: data mismatch: %d != %d", codec, name, len(in), len(out))
+			}
+		}
+	}
+
+	cb, _ := CompressLZ77Huffman(text)
+	if out, err := DecompressLZ77Huffman(cb, len(text)); err != nil || !bytes.Equal(out, text) {
+		t.Errorf("lz77+huffman: sized decompress: %v", err)
+	}
+}
+
+func TestDecompressLZ77(t *testing.T) {
+
+	// [MS-XCA] 3.1 example: "abcdefghijklmnopqrstuvwxyz".
+	in := []byte{
+		0x3f, 0x00, 0x00, 0x00, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68,
+		0x69, 0x6a, 0x6b, 0x6c, 0x6d, 0x6e, 0x6f, 0x70, 0x71, 0x72, 0x73, 0x74,
+		0x75, 0x76, 0x77, 0x78, 0x79, 0x7a,
+	}
+
+	out, err := DecompressLZ77(in, -1)
+	if err != nil {
+		t.Fatalf("decompress: %v", err)
+	}
+
+	if string(out) != "abcdefghijklmnopqrstuvwxyz" {
+		t.Errorf("decompress: unexpected output %q", out)
+	}
+}
+
+func TestDecompressBomb(t *testing.T) {
+
+	// the literal "a" and the match of 0x10000000 bytes at the offset 1.
+	lz77 := []byte{
+		0x00, 0x00, 0x00, 0x40, 0x61, 0x07, 0x00, 0x0f, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x10,
+	}
+
+	if _, err := DecompressLZ77(lz77, 4096); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("lz77: expected too large error, got %v", err)
+	}
+
+	// the literal "a" and the match of 4095 bytes at the offset 1.
+	chunk := []byte{0x03, 0xb0, 0x02, 0x61, 0xfc, 0x0f}
+
+	lznt1 := bytes.Repeat(chunk, 3)
+
+	if out, err := DecompressLZNT1(lznt1, -1); err != nil || !bytes.Equal(out, bytes.Repeat([]byte{'a'}, 3*lznt1ChunkSize)) {
+		t.Fatalf("lznt1: decompress: %d, %v", len(out), err)
+	}
+
+	if _, err := DecompressLZNT1(lznt1, 2*lznt1ChunkSize); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("lznt1: expected too large error, got %v", err)
+	}
+
+	// the match of 4098 bytes exceeds the chunk size.
+	if _, err := DecompressLZNT1([]byte{0x03, 0xb0, 0x02, 0x61, 0xff, 0x0f}, -1); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("lznt1: expected corrupted error, got %v", err)
+	}
+}
@@ -871,7 +871,7 @@ func (cfg *Config) Validate() error {
 	for _, typ := range cfg.Auth.Types {
 		if typ == "krb5" {
 			if len(cfg.Auth.KRB5.EncryptionTypes) == 0 {
-				cfg.Auth.KRB5.EncryptionTypes = []string{"aes128-cts-hmac-sha1-96", "aes256-cts-hmac-sha1-96", "arcfour-hmac-md5"}
+				cfg.Auth.KRB5.EncryptionTypes = []string{"aes128-cts-hmac-sha1-96", "aes256-cts-hmac-sha1-96", "aes128-cts-hmac-sha256-128", "aes256-cts-hmac-sha384-192", "arcfour-hmac-md5"}
 			}
 			if cfg.Auth.KRB5.ConfigFile == "" {
 				if _, err := cfg.GenKRB5Config(); err != nil {
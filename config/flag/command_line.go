@@ -40,7 +40,7 @@ func BindFlags(c *config.Config, flagSet *flag.FlagSet) {
 	flagSet.StringVar(&c.Auth.KRB5.AdminServer, "krb5-admin-server", c.Auth.KRB5.AdminServer, "admin server to authenticate to")
 	flagSet.StringVar(&c.Auth.KRB5.Keytab, "krb5-keytab-path", c.Auth.KRB5.Keytab, "path to keytab")
 	flagSet.StringVar(&c.Auth.KRB5.CCache, "krb5-ccache-path", c.Auth.KRB5.CCache, "path to ccache")
-	flagSet.Var(&c.Auth.KRB5.EncryptionTypes, "krb5-encryption-types", "encryption types to use: aes256-cts-hmac-sha1-96, aes128-cts-hmac-sha1-96, arcfour-hmac-md5")
+	flagSet.Var(&c.Auth.KRB5.EncryptionTypes, "krb5-encryption-types", "encryption types to use: aes256-cts-hmac-sha1-96, aes128-cts-hmac-sha1-96, aes256-cts-hmac-sha384-192, aes128-cts-hmac-sha256-128, arcfour-hmac-md5")
 	flagSet.BoolVar(&c.Auth.KRB5.DCEStyle, "krb5-dce-style", c.Auth.KRB5.DCEStyle, "use DCE style")
 	flagSet.BoolVar(&c.Auth.KRB5.DisablePAFXFAST, "krb5-disable-pafx-fast", c.Auth.KRB5.DisablePAFXFAST, "disable PA-FX-FAST")
 	flagSet.BoolVar(&c.Auth.KRB5.MutualAuthn, "krb5-mutual-authn", c.Auth.KRB5.MutualAuthn, "use mutual authentication")
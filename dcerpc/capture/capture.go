@@ -0,0 +1,240 @@
+// Package capture implements the Wireshark-compatible capture of the
+// DCE/RPC and SMB2 traffic and the export of the keys required to decrypt
+// the signed and sealed sessions.
+//
+// The traffic is written in pcapng format, the TCP connections are written
+// as is (with synthesized IPv4 and TCP headers), the DCE/RPC fragments sent
+// over the SMB2 named pipes are additionally written as the synthesized TCP
+// stream to the port 135, so that the DCE/RPC dissector can be used for the
+// named pipe traffic as well.
+//
+// The keys are written in keytab format: for Kerberos the ticket session
+// key and the sub-session key are exported. For NTLM the NT hash of the
+// credential is exported as RC4-HMAC key (the Wireshark NTLMSSP dissector
+// uses the RC4-HMAC keytab entries as the NT password hashes) only if the
+// ntlm.Config.ExportNTHash flag is set. Use the "kerberos.file" (Kerberos
+// keytab file) preference and enable the "kerberos.decrypt" preference to
+// decrypt the capture:
+//
+//	f, _ := os.Create("session.pcapng")
+//	kt, _ := os.Create("session.keytab")
+//
+//	c, err := capture.New(f, capture.WithKeytab(kt))
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer c.Close()
+//
+//	cfg := ntlm.NewConfig()
+//	// export the NT hash to the keytab.
+//	cfg.ExportNTHash = true
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net",
+//		dcerpc.WithCapture(c),
+//		dcerpc.WithMechanism(ssp.NTLM, cfg),
+//		dcerpc.WithSeal())
+//
+// NOTE: the NT hash allows to decrypt any traffic of the credential, the
+// keytab file that contains the NT hash must be protected as the password.
+package capture
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// Option is the capture option.
+type Option func(*Capture)
+
+// WithKeytab option specifies the writer for the exported keys.
+func WithKeytab(w io.Writer) Option {
+	return func(c *Capture) { c.keytab = w }
+}
+
+// Capture is the pcapng capture writer. It is safe for concurrent use.
+type Capture struct {
+	mu sync.Mutex
+	// The buffered pcapng writer.
+	w *bufio.Writer
+	// The keytab writer.
+	keytab io.Writer
+	// The keytab header was written.
+	keytabStarted bool
+	// The exported keys (to avoid duplicates).
+	keys map[string]bool
+	// The next synthesized client port.
+	port uint16
+	// The current time (used for tests).
+	now func() time.Time
+	// The first packet write error.
+	err error
+}
+
+// New function returns the new capture that writes pcapng to `w`.
+func New(w io.Writer, opts ...Option) (*Capture, error) {
+
+	c := &Capture{
+		w:    bufio.NewWriter(w),
+		keys: make(map[string]bool),
+		port: 49152,
+		now:  time.Now,
+	}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	if err := c.writeHeader(); err != nil {
+		return nil, fmt.Errorf("capture: write header: %w", err)
+	}
+
+	return c, nil
+}
+
+// Close function flushes the capture. It returns the first packet write
+// error if any.
+func (c *Capture) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return fmt.Errorf("capture: write packet: %w", c.err)
+	}
+	if err := c.w.Flush(); err != nil {
+		return fmt.Errorf("capture: flush: %w", err)
+	}
+	return nil
+}
+
+// Conn function returns the connection that captures the traffic of the
+// TCP connection `cc`. The connection addresses are used for the
+// synthesized IP and TCP headers (if the addresses are not IPv4 TCP
+// addresses, the synthesized addresses and the port 135 are used).
+func (c *Capture) Conn(cc net.Conn) net.Conn {
+	return &conn{Conn: cc, s: c.newStream(cc.LocalAddr(), cc.RemoteAddr(), 135)}
+}
+
+// Pipe function returns the connection that captures the DCE/RPC fragments
+// sent over the named pipe `rw` as the TCP stream to the port 135.
+func (c *Capture) Pipe(rw io.ReadWriteCloser) io.ReadWriteCloser {
+	return &pipe{ReadWriteCloser: rw, s: c.newStream(nil, nil, 135)}
+}
+
+// DialFunc function wraps the dial function, so that the dialed
+// connections are captured.
+func (c *Capture) DialFunc(dial func(ctx context.Context, network, address string) (net.Conn, error)) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		cc, err := dial(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+		return c.Conn(cc), nil
+	}
+}
+
+// AddKeys function writes the keys to the keytab (if configured). The keys
+// that were already written are skipped.
+func (c *Capture) AddKeys(keys ...*gssapi.ExportedKey) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keytab == nil {
+		return nil
+	}
+
+	for _, key := range keys {
+
+		id := fmt.Sprintf("%d:%x", key.EncType, key.Key)
+		if c.keys[id] {
+			continue
+		}
+
+		if !c.keytabStarted {
+			if _, err := c.keytab.Write(keytabHeader); err != nil {
+				return fmt.Errorf("capture: write keytab: %w", err)
+			}
+			c.keytabStarted = true
+		}
+
+		if _, err := c.keytab.Write(keytabEntry(key, c.now())); err != nil {
+			return fmt.Errorf("capture: write keytab: %w", err)
+		}
+
+		c.keys[id] = true
+	}
+
+	return nil
+}
+
+// conn is the captured TCP connection.
+type conn struct {
+	net.Conn
+	s    *stream
+	once sync.Once
+}
+
+func (cc *conn) Read(b []byte) (int, error) {
+	n, err := cc.Conn.Read(b)
+	if n > 0 {
+		cc.s.write(false, b[:n])
+	}
+	return n, err
+}
+
+func (cc *conn) Write(b []byte) (int, error) {
+	n, err := cc.Conn.Write(b)
+	if n > 0 {
+		cc.s.write(true, b[:n])
+	}
+	return n, err
+}
+
+func (cc *conn) Close() error {
+	cc.once.Do(cc.s.close)
+	return cc.Conn.Close()
+}
+
+// Unwrap function returns the captured connection.
+func (cc *conn) Unwrap() io.ReadWriteCloser {
+	return cc.Conn
+}
+
+// pipe is the captured named pipe.
+type pipe struct {
+	io.ReadWriteCloser
+	s    *stream
+	once sync.Once
+}
+
+func (p *pipe) Read(b []byte) (int, error) {
+	n, err := p.ReadWriteCloser.Read(b)
+	if n > 0 {
+		p.s.write(false, b[:n])
+	}
+	return n, err
+}
+
+func (p *pipe) Write(b []byte) (int, error) {
+	n, err := p.ReadWriteCloser.Write(b)
+	if n > 0 {
+		p.s.write(true, b[:n])
+	}
+	return n, err
+}
+
+func (p *pipe) Close() error {
+	p.once.Do(p.s.close)
+	return p.ReadWriteCloser.Close()
+}
+
+// Unwrap function returns the captured named pipe.
+func (p *pipe) Unwrap() io.ReadWriteCloser {
+	return p.ReadWriteCloser
+}
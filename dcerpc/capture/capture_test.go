@@ -0,0 +1,136 @@
+package capture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// readPackets function returns the packets of the enhanced packet blocks.
+func readPackets(t *testing.T, b []byte) [][]byte {
+
+	var pkts [][]byte
+
+	for len(b) > 0 {
+		if len(b) < 12 {
+			t.Fatalf("pcapng: truncated block")
+		}
+		typ, sz := binary.LittleEndian.Uint32(b), binary.LittleEndian.Uint32(b[4:])
+		if sz%4 != 0 || int(sz) > len(b) || binary.LittleEndian.Uint32(b[sz-4:]) != sz {
+			t.Fatalf("pcapng: invalid block length %d", sz)
+		}
+		if typ == blockTypeEPB {
+			n := binary.LittleEndian.Uint32(b[20:])
+			pkts = append(pkts, b[28:28+n])
+		}
+		b = b[sz:]
+	}
+
+	return pkts
+}
+
+func TestCapture(t *testing.T) {
+
+	var buf bytes.Buffer
+
+	c, err := New(&buf)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+
+	c.now = func() time.Time { return time.Unix(0, 0) }
+
+	client, server := net.Pipe()
+
+	cc := c.Pipe(client)
+
+	go func() {
+		b := make([]byte, 4)
+		io.ReadFull(server, b)
+		server.Write([]byte("pong"))
+		server.Close()
+	}()
+
+	cc.Write([]byte("ping"))
+	io.ReadFull(cc, make([]byte, 4))
+	cc.Close()
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	pkts := readPackets(t, buf.Bytes())
+
+	// handshake, ping, pong, termination.
+	if len(pkts) != 8 {
+		t.Fatalf("unexpected number of packets: %d", len(pkts))
+	}
+
+	var payload []byte
+
+	for i, pkt := range pkts {
+		if checksum(pkt[:ipHeaderSize]) != 0 {
+			t.Errorf("packet %d: invalid ip checksum", i)
+		}
+		tcp := pkt[ipHeaderSize:]
+		pseudo := append(append([]byte{}, pkt[12:20]...), 0, 6, byte(len(tcp)>>8), byte(len(tcp)))
+		if checksum(pseudo, tcp) != 0 {
+			t.Errorf("packet %d: invalid tcp checksum", i)
+		}
+		if binary.BigEndian.Uint16(tcp[2:]) != 135 && binary.BigEndian.Uint16(tcp[0:]) != 135 {
+			t.Errorf("packet %d: unexpected ports", i)
+		}
+		payload = append(payload, tcp[tcpHeaderSize:]...)
+	}
+
+	if string(payload) != "pingpong" {
+		t.Errorf("unexpected payload %q", payload)
+	}
+
+	// the server response acknowledges the client data.
+	if ack := binary.BigEndian.Uint32(pkts[4][ipHeaderSize+8:]); ack != 5 {
+		t.Errorf("unexpected acknowledgment number %d", ack)
+	}
+}
+
+func TestKeytab(t *testing.T) {
+
+	var buf bytes.Buffer
+
+	c, err := New(io.Discard, WithKeytab(&buf))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+
+	c.now = func() time.Time { return time.Unix(1, 0) }
+
+	key := &gssapi.ExportedKey{Principal: []string{"User"}, Realm: "Domain", EncType: 23, Key: []byte{1, 2}}
+
+	// the duplicate keys are skipped.
+	if err := c.AddKeys(key, key); err != nil {
+		t.Fatalf("add keys: %v", err)
+	}
+
+	expected := []byte{
+		0x05, 0x02, // version.
+		0x00, 0x00, 0x00, 0x23, // size.
+		0x00, 0x01, // components.
+		0x00, 0x06, 'D', 'o', 'm', 'a', 'i', 'n', // realm.
+		0x00, 0x04, 'U', 's', 'e', 'r', // principal.
+		0x00, 0x00, 0x00, 0x01, // name type.
+		0x00, 0x00, 0x00, 0x01, // timestamp.
+		0x01,       // vno8.
+		0x00, 0x17, // enctype.
+		0x00, 0x02, 0x01, 0x02, // key.
+		0x00, 0x00, 0x00, 0x01, // vno.
+	}
+
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Errorf("unexpected keytab: %x", buf.Bytes())
+	}
+}
@@ -0,0 +1,47 @@
+package capture
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// keytabHeader is the keytab file format version (0x0502).
+var keytabHeader = []byte{0x05, 0x02}
+
+const (
+	// The principal name type (KRB5_NT_PRINCIPAL).
+	principalNameType = 1
+	// The key version number.
+	keyVersion = 1
+)
+
+// keytabEntry function returns the keytab entry for the key with the
+// timestamp `t`.
+func keytabEntry(key *gssapi.ExportedKey, t time.Time) []byte {
+
+	principal := key.Principal
+	if len(principal) == 0 {
+		principal = []string{"unknown"}
+	}
+
+	b := binary.BigEndian.AppendUint16(nil, uint16(len(principal)))
+	b = appendCounted(b, []byte(key.Realm))
+	for _, component := range principal {
+		b = appendCounted(b, []byte(component))
+	}
+	b = binary.BigEndian.AppendUint32(b, principalNameType)
+	b = binary.BigEndian.AppendUint32(b, uint32(t.Unix()))
+	b = append(b, keyVersion)
+	b = binary.BigEndian.AppendUint16(b, uint16(key.EncType))
+	b = appendCounted(b, key.Key)
+	b = binary.BigEndian.AppendUint32(b, keyVersion)
+
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(b))), b...)
+}
+
+// appendCounted function appends the 16-bit length-prefixed data.
+func appendCounted(b, data []byte) []byte {
+	return append(binary.BigEndian.AppendUint16(b, uint16(len(data))), data...)
+}
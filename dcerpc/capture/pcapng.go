@@ -0,0 +1,245 @@
+package capture
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+const (
+	// The pcapng block types.
+	blockTypeSHB = 0x0A0D0D0A
+	blockTypeIDB = 0x00000001
+	blockTypeEPB = 0x00000006
+	// The byte-order magic.
+	byteOrderMagic = 0x1A2B3C4D
+	// The raw IP link type.
+	linkTypeRaw = 101
+	// The maximum TCP segment payload size.
+	maxSegmentSize = 65495 - ipHeaderSize - tcpHeaderSize
+	// The IPv4 and TCP header sizes.
+	ipHeaderSize  = 20
+	tcpHeaderSize = 20
+)
+
+// The TCP flags.
+const (
+	tcpFIN = 0x01
+	tcpSYN = 0x02
+	tcpPSH = 0x08
+	tcpACK = 0x10
+)
+
+var (
+	// The synthesized client and server addresses (when the connection
+	// addresses are not IPv4 TCP addresses).
+	clientAddr = [4]byte{10, 0, 0, 1}
+	serverAddr = [4]byte{10, 0, 0, 2}
+)
+
+// writeBlock function writes the pcapng block with the body `b`.
+func (c *Capture) writeBlock(typ uint32, b []byte) error {
+
+	pad := (4 - len(b)%4) % 4
+	sz := uint32(12 + len(b) + pad)
+
+	hdr := binary.LittleEndian.AppendUint32(nil, typ)
+	hdr = binary.LittleEndian.AppendUint32(hdr, sz)
+
+	if _, err := c.w.Write(hdr); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(b); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(make([]byte, pad)); err != nil {
+		return err
+	}
+
+	_, err := c.w.Write(binary.LittleEndian.AppendUint32(nil, sz))
+	return err
+}
+
+// writeHeader function writes the section header and the interface
+// description blocks.
+func (c *Capture) writeHeader() error {
+
+	shb := binary.LittleEndian.AppendUint32(nil, byteOrderMagic)
+	shb = binary.LittleEndian.AppendUint16(shb, 1) // major version.
+	shb = binary.LittleEndian.AppendUint16(shb, 0) // minor version.
+	shb = binary.LittleEndian.AppendUint64(shb, 0xFFFFFFFFFFFFFFFF)
+
+	if err := c.writeBlock(blockTypeSHB, shb); err != nil {
+		return err
+	}
+
+	idb := binary.LittleEndian.AppendUint16(nil, linkTypeRaw)
+	idb = binary.LittleEndian.AppendUint16(idb, 0) // reserved.
+	idb = binary.LittleEndian.AppendUint32(idb, 0) // snap length.
+
+	return c.writeBlock(blockTypeIDB, idb)
+}
+
+// writePacket function writes the enhanced packet block.
+func (c *Capture) writePacket(pkt []byte) error {
+
+	ts := uint64(c.now().UnixMicro())
+
+	epb := binary.LittleEndian.AppendUint32(nil, 0) // interface id.
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts>>32))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(pkt)))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(pkt)))
+	epb = append(epb, pkt...)
+
+	return c.writeBlock(blockTypeEPB, epb)
+}
+
+// stream is the synthesized TCP stream.
+type stream struct {
+	c *Capture
+	// The client and server addresses and ports.
+	src, dst     [4]byte
+	sport, dport uint16
+	// The client and server sequence numbers.
+	cseq, sseq uint32
+	// The IP identifier.
+	id uint16
+}
+
+// newStream function returns the new TCP stream and writes the TCP
+// handshake. If the addresses are not IPv4 TCP addresses, the synthesized
+// addresses are used with the server port `port`.
+func (c *Capture) newStream(local, remote net.Addr, port uint16) *stream {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := &stream{c: c, src: clientAddr, dst: serverAddr, sport: c.port, dport: port, cseq: 1, sseq: 1}
+	if c.port++; c.port == 0 {
+		c.port = 49152
+	}
+
+	if l, r := tcp4Addr(local), tcp4Addr(remote); l != nil && r != nil {
+		copy(s.src[:], l.IP.To4())
+		copy(s.dst[:], r.IP.To4())
+		s.sport, s.dport = uint16(l.Port), uint16(r.Port)
+	}
+
+	s.segment(true, tcpSYN, nil)
+	s.segment(false, tcpSYN|tcpACK, nil)
+	s.segment(true, tcpACK, nil)
+
+	return s
+}
+
+// tcp4Addr function returns the IPv4 TCP address or nil.
+func tcp4Addr(addr net.Addr) *net.TCPAddr {
+	if a, ok := addr.(*net.TCPAddr); ok && a.IP.To4() != nil {
+		return a
+	}
+	return nil
+}
+
+// write function writes the data sent by the client (out is true) or by the
+// server.
+func (s *stream) write(out bool, b []byte) {
+
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	for len(b) > 0 {
+		n := min(len(b), maxSegmentSize)
+		s.segment(out, tcpPSH|tcpACK, b[:n])
+		b = b[n:]
+	}
+}
+
+// close function writes the connection termination.
+func (s *stream) close() {
+
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	s.segment(true, tcpFIN|tcpACK, nil)
+	s.segment(false, tcpFIN|tcpACK, nil)
+	s.segment(true, tcpACK, nil)
+}
+
+// segment function writes the single TCP segment. The capture lock must be
+// held. The write errors are ignored (the capture must not break the
+// connection), and reported on Close.
+func (s *stream) segment(out bool, flags uint8, payload []byte) {
+
+	src, dst, sport, dport, seq, ack := s.src, s.dst, s.sport, s.dport, &s.cseq, s.sseq
+	if !out {
+		src, dst, sport, dport, seq, ack = s.dst, s.src, s.dport, s.sport, &s.sseq, s.cseq
+	}
+
+	if flags&tcpSYN != 0 {
+		// the initial sequence number.
+		*seq = 0
+	}
+
+	if flags&tcpACK == 0 {
+		ack = 0
+	}
+
+	pkt := make([]byte, ipHeaderSize+tcpHeaderSize+len(payload))
+
+	// ipv4 header.
+	ip := pkt[:ipHeaderSize]
+	ip[0] = 0x45 // version, ihl.
+	binary.BigEndian.PutUint16(ip[2:], uint16(len(pkt)))
+	binary.BigEndian.PutUint16(ip[4:], s.id)
+	binary.BigEndian.PutUint16(ip[6:], 0x4000) // don't fragment.
+	ip[8], ip[9] = 64, 6                       // ttl, tcp.
+	copy(ip[12:], src[:])
+	copy(ip[16:], dst[:])
+	binary.BigEndian.PutUint16(ip[10:], checksum(ip))
+
+	// tcp header.
+	tcp := pkt[ipHeaderSize:]
+	binary.BigEndian.PutUint16(tcp[0:], sport)
+	binary.BigEndian.PutUint16(tcp[2:], dport)
+	binary.BigEndian.PutUint32(tcp[4:], *seq)
+	binary.BigEndian.PutUint32(tcp[8:], ack)
+	tcp[12], tcp[13] = (tcpHeaderSize/4)<<4, flags
+	binary.BigEndian.PutUint16(tcp[14:], 0xFFFF) // window.
+	copy(tcp[tcpHeaderSize:], payload)
+
+	// pseudo-header checksum.
+	pseudo := append(append(append([]byte{}, src[:]...), dst[:]...), 0, 6, byte(len(tcp)>>8), byte(len(tcp)))
+	binary.BigEndian.PutUint16(tcp[16:], checksum(pseudo, tcp))
+
+	s.id++
+
+	if *seq += uint32(len(payload)); flags&(tcpSYN|tcpFIN) != 0 {
+		*seq++
+	}
+
+	if err := s.c.writePacket(pkt); err != nil && s.c.err == nil {
+		s.c.err = err
+	}
+}
+
+// checksum function computes the internet checksum of the concatenated
+// byte slices (each slice except the last one must be of even length).
+func checksum(bs ...[]byte) uint16 {
+
+	var sum uint32
+
+	for _, b := range bs {
+		for i := 0; i+1 < len(b); i += 2 {
+			sum += uint32(b[i])<<8 | uint32(b[i+1])
+		}
+		if len(b)%2 == 1 {
+			sum += uint32(b[len(b)-1]) << 8
+		}
+	}
+
+	for sum > 0xFFFF {
+		sum = sum&0xFFFF + sum>>16
+	}
+
+	return ^uint16(sum)
+}
@@ -0,0 +1,93 @@
+package dcerpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/capture"
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+	"github.com/oiweiwei/go-msrpc/ssp/ntlm"
+)
+
+func TestCapture(t *testing.T) {
+
+	cred := credential.NewFromPassword("Domain\\User", "Password")
+
+	db := credential.NewLocalDatabase()
+	db.Add(cred)
+
+	cfg := ntlm.NewConfig()
+	cfg.NetBIOSComputerName, cfg.NetBIOSDomainName = "Server", "Domain"
+
+	srv := NewServer(WithMechanism(ssp.NTLM, cfg), WithCredentialDatabase(gssapi.NewCredentialDatabase(db)))
+	defer srv.Close()
+
+	srv.RegisterServer(testEchoServerHandle, WithAbstractSyntax(testEchoSyntax))
+
+	var pcap, keytab bytes.Buffer
+
+	c, err := capture.New(&pcap, capture.WithKeytab(&keytab))
+	if err != nil {
+		t.Fatalf("capture: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := srv.Dial(ctx, WithCapture(c))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	// the nt hash is exported only on request.
+	clientCfg := ntlm.NewConfig()
+	clientCfg.ExportNTHash = true
+
+	cc, err := conn.Bind(ctx, WithSeal(), WithMechanism(ssp.NTLM, clientCfg), WithCredentials(cred), WithAbstractSyntax(testEchoSyntax))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 64)
+
+	if err := cc.Invoke(ctx, &testEchoOperation{Data: data}); err != nil {
+		t.Fatalf("invoke: %v", err)
+	}
+
+	conn.Close(ctx)
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("capture: close: %v", err)
+	}
+
+	// the section header block.
+	if pcap.Len() < 28 || binary.LittleEndian.Uint32(pcap.Bytes()) != 0x0A0D0D0A {
+		t.Fatalf("capture: invalid section header")
+	}
+
+	// the bind pdu (version 5.0, type 11) is captured, the sealed stub
+	// data is not.
+	if !bytes.Contains(pcap.Bytes(), []byte{0x05, 0x00, byte(PacketTypeBind)}) {
+		t.Errorf("capture: bind pdu is not captured")
+	}
+
+	if bytes.Contains(pcap.Bytes(), data[:64]) {
+		t.Errorf("capture: the stub data is captured in plaintext")
+	}
+
+	// the keytab contains the nt hash of the credential.
+	ntHash := []byte{0xa4, 0xf4, 0x9c, 0x40, 0x65, 0x10, 0xbd, 0xca, 0xb6, 0x82, 0x4e, 0xe7, 0xc3, 0x0f, 0xd8, 0x52}
+
+	if !bytes.HasPrefix(keytab.Bytes(), []byte{0x05, 0x02}) {
+		t.Fatalf("keytab: invalid header")
+	}
+
+	if !bytes.Contains(keytab.Bytes(), append([]byte{0x00, 0x17, 0x00, 0x10}, ntHash...)) {
+		t.Errorf("keytab: rc4-hmac key is not exported")
+	}
+}
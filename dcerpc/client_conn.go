@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	dcerpc_errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
 
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
 	"github.com/rs/zerolog"
@@ -42,6 +43,8 @@ type clientConn struct {
 	logger zerolog.Logger
 	// Error mapper.
 	errorMappers []dcerpc_errors.Mapper
+	// The call interceptors.
+	interceptors []UnaryInterceptor
 }
 
 // SubConn interface implements the sub-connection query method
@@ -132,10 +135,7 @@ func (c *clientConn) Context() context.Context {
 // Invoke function invokes the operation.
 func (c *clientConn) Invoke(ctx context.Context, op Operation, opts ...CallOption) error {
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	if err := c.invoke(ctx, op, opts...); err != nil {
+	if err := c.intercept(ctx, op, opts...); err != nil {
 		return fmt.Errorf("dcerpc: invoke: %s: %w", op.OpName(), err)
 	}
 
@@ -145,10 +145,7 @@ func (c *clientConn) Invoke(ctx context.Context, op Operation, opts ...CallOptio
 // InvokeObject function invokes the operation with ObjectUUID.
 func (c *clientConn) InvokeObject(ctx context.Context, obj *uuid.UUID, op Operation, opts ...CallOption) error {
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	if err := c.invoke(ctx, op, append(opts, WithObjectUUID(obj))...); err != nil {
+	if err := c.intercept(ctx, op, append(opts, WithObjectUUID(obj))...); err != nil {
 		return fmt.Errorf("dcerpc: invoke_object: %s: %s: %w", obj.String(), op.OpName(), err)
 	}
 
@@ -172,8 +169,33 @@ func (c *clientConn) BodyWriter(ctx context.Context, op Operation) *Body {
 	return NewBody(ctx, op, c.presentation, true)
 }
 
-// invoke.
-func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOption) error {
+// intercept function invokes the operation through the interceptor chain.
+func (c *clientConn) intercept(ctx context.Context, op Operation, opts ...CallOption) error {
+
+	if len(c.interceptors) == 0 {
+		return c.invokeLocked(ctx, op, opts...)
+	}
+
+	// the presentation is updated by the alter context.
+	c.mu.RLock()
+	info := &CallInfo{
+		AbstractSyntax: c.presentation.AbstractSyntax,
+		TransferSyntax: c.presentation.TransferSyntax,
+		OpNum:          op.OpNum(),
+		OpName:         op.OpName(),
+	}
+	c.mu.RUnlock()
+
+	info.ObjectUUID, _ = HasObjectUUID(opts)
+
+	return chainInterceptors(c.interceptors, info, c.invokeLocked)(ctx, op, opts...)
+}
+
+// invokeLocked function invokes the operation under the connection read lock.
+func (c *clientConn) invokeLocked(ctx context.Context, op Operation, opts ...CallOption) error {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 
 	if c.isClosed() {
 		return ErrConnClosed
@@ -183,6 +205,26 @@ func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOptio
 		return c.presentation.Error
 	}
 
+	ev := c.transport.traceEvent(trace.KindCall)
+	ev.OpNum, ev.OpName = op.OpNum(), op.OpName()
+	ev.AbstractSyntax = syntaxString(c.presentation.AbstractSyntax)
+	ev.TransferSyntax = syntaxString(c.presentation.TransferSyntax)
+
+	if obj, ok := HasObjectUUID(opts); ok && obj != nil {
+		ev.Object = obj.String()
+	}
+
+	ctx, end := trace.Start(ctx, c.transport.settings.Observer, ev)
+
+	err := c.invoke(ctx, ev, op, opts...)
+	end(err)
+
+	return err
+}
+
+// invoke.
+func (c *clientConn) invoke(ctx context.Context, ev *trace.Event, op Operation, opts ...CallOption) error {
+
 	obj, _ := HasObjectUUID(opts)
 
 	call, err := c.transport.MakeCall(ctx)
@@ -215,6 +257,7 @@ func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOptio
 		if err = c.WritePacket(ctx, call, pkt); err != nil {
 			return fmt.Errorf("request: %w", err)
 		}
+		ev.FragmentsOut, ev.BytesOut = ev.FragmentsOut+1, ev.BytesOut+int(pkt.Header.FragLength)
 		// clear the first frag.
 		pkt.Header.PacketFlags &= ^PacketFlagFirstFrag
 	}
@@ -230,6 +273,7 @@ func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOptio
 		if pkt, err = c.ReadPacket(ctx, call, pkt); err != nil {
 			return fmt.Errorf("response: %w", err)
 		}
+		ev.FragmentsIn, ev.BytesIn = ev.FragmentsIn+1, ev.BytesIn+int(pkt.Header.FragLength)
 	}
 
 	c.logger.Debug().Uint32("call_id", call.ID()).Interface("out", op).Msg("operation output")
@@ -237,19 +281,30 @@ func (c *clientConn) invoke(ctx context.Context, op Operation, opts ...CallOptio
 	return nil
 }
 
-// Wrap function wraps the raw bytes with security service.
-func (c *clientConn) Wrap(ctx context.Context, hdr Header, raw []byte, call Call) error {
+// newSecurityPacket function returns the request/response (or fault) packet
+// view over the raw bytes for the security service.
+func newSecurityPacket(hdr Header, raw []byte) *Packet {
 
 	pkt := &Packet{Header: hdr, raw: raw[:hdr.FragLength]}
 
-	if pkt.start = RequestSize; pkt.Header.PacketFlags&PacketFlagObjectUUID != 0 {
-		pkt.start += ObjectUUIDSize
+	if pkt.start = RequestSize; pkt.Header.PacketType == PacketTypeFault {
+		pkt.start = FaultSize
+	} else if pkt.Header.PacketFlags&PacketFlagObjectUUID != 0 {
+		pkt.start += ObjectUUIDSize /* uuid size */
 	}
 
 	if pkt.end = int(pkt.Header.FragLength); pkt.Header.AuthLength != 0 {
 		pkt.end -= int(pkt.Header.AuthLength) + SecurityTrailerSize
 	}
 
+	return pkt
+}
+
+// Wrap function wraps the raw bytes with security service.
+func (c *clientConn) Wrap(ctx context.Context, hdr Header, raw []byte, call Call) error {
+
+	pkt := newSecurityPacket(hdr, raw)
+
 	if c.security.CanWrap(ctx, pkt) {
 		if err := c.security.Wrap(ctx, pkt); err != nil {
 			return err
@@ -267,15 +322,7 @@ func (c *clientConn) Unwrap(ctx context.Context, hdr Header, raw []byte, call Ca
 	// (see afterLock parameter in Unwrap function).
 	// defer call.Ready(ctx)
 
-	pkt := &Packet{Header: hdr, raw: raw[:hdr.FragLength]}
-
-	if pkt.start = RequestSize; pkt.Header.PacketFlags&PacketFlagObjectUUID != 0 {
-		pkt.start += ObjectUUIDSize /* uuid size */
-	}
-
-	if pkt.end = int(pkt.Header.FragLength); pkt.Header.AuthLength != 0 {
-		pkt.end -= int(pkt.Header.AuthLength) + SecurityTrailerSize
-	}
+	pkt := newSecurityPacket(hdr, raw)
 
 	if c.security.CanWrap(ctx, pkt) {
 		if err := c.security.Unwrap(ctx, pkt, call.Ready); err != nil {
@@ -312,6 +359,8 @@ func (c *clientConn) writePacket(ctx context.Context, call Call, pkt *Packet) er
 		return fmt.Errorf("encode packet: %w", err)
 	}
 
+	c.transport.recordFragment(ctx, true, pkt.Header, c.buffer)
+
 	if err := c.Wrap(ctx, pkt.Header, c.buffer, call); err != nil {
 		return fmt.Errorf("wrap packet: %w", err)
 	}
@@ -362,6 +411,8 @@ func (c *clientConn) readPacket(ctx context.Context, call Call, pkt *Packet) (*P
 		return nil, fmt.Errorf("unwrap packet: %w", err)
 	}
 
+	c.transport.recordFragment(ctx, false, hdr, c.buffer)
+
 	if pkt, err = c.transport.DecodePacket(ctx, pkt, c.buffer); err != nil {
 		return nil, fmt.Errorf("decode packet: %w", err)
 	}
@@ -3,6 +3,7 @@ package dcerpc
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"net"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
 	"github.com/oiweiwei/go-msrpc/smb2"
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
@@ -80,6 +82,35 @@ func (conn *BufferedConn) Read(b []byte) (int, error) {
 	return want, nil
 }
 
+// unwrapConn function returns the innermost raw connection for the
+// connection wrappers (for example, capture connections) that implement
+// the `Unwrap() io.ReadWriteCloser` method.
+func unwrapConn(cc RawConn) RawConn {
+	for {
+		u, ok := cc.(interface{ Unwrap() io.ReadWriteCloser })
+		if !ok {
+			return cc
+		}
+		cc = u.Unwrap()
+	}
+}
+
+// lookupConn function returns the first connection of type T in the chain
+// of the connection wrappers (see unwrapConn).
+func lookupConn[T any](cc RawConn) (T, bool) {
+	for {
+		if t, ok := cc.(T); ok {
+			return t, true
+		}
+		u, ok := cc.(interface{ Unwrap() io.ReadWriteCloser })
+		if !ok {
+			var zero T
+			return zero, false
+		}
+		cc = u.Unwrap()
+	}
+}
+
 // conn represents the client's set of transports
 // per binding.
 type conn struct {
@@ -346,7 +377,7 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		t.logger.Debug().Msgf("no established transport was found")
 		for i := range bindings {
 			t.logger.Debug().Msgf("etablishing new transport for binding %s", bindings[i])
-			if selected, err = t.dial(ctx, bindings[i]); err != nil {
+			if selected, err = t.dial(ctx, bindings[i], i); err != nil {
 				t.logger.Error().Err(err).Msgf("bind: dial %s error", bindings[i])
 				continue
 			}
@@ -379,13 +410,29 @@ func (t *conn) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 	return nil, fmt.Errorf("bind: could not find matching binding")
 }
 
-func (t *conn) dial(ctx context.Context, binding StringBinding) ([]*transport, error) {
+func (t *conn) dial(ctx context.Context, binding StringBinding, retries int) ([]*transport, error) {
+
+	ev := &trace.Event{
+		Kind:     trace.KindDial,
+		Protocol: binding.ProtocolSequence.String(),
+		Address:  t.serverAddr,
+		Binding:  binding.String(),
+		Retries:  retries,
+	}
+
+	ctx, end := trace.Start(ctx, t.settings.Observer, ev)
 
 	conn, err := t.dialConn(ctx, binding)
-	if err != nil {
+	if end(err); err != nil {
 		return nil, err
 	}
 
+	if t.settings.Capture != nil {
+		if cc, ok := conn.(net.Conn); ok {
+			conn = t.settings.Capture.Conn(cc)
+		}
+	}
+
 	settings := *t.settings
 
 	return []*transport{{
@@ -398,6 +445,8 @@ func (t *conn) dial(ctx context.Context, binding StringBinding) ([]*transport, e
 		rxQ:      make(chan *call, 64),
 		logger:   t.logger,
 		conn:     t,
+		binding:  binding,
+		address:  t.serverAddr,
 	}}, nil
 }
 
@@ -507,19 +556,41 @@ func (t *conn) dialConn(ctx context.Context, binding StringBinding) (RawConn, er
 			Dialer:    dialer,
 			ShareName: binding.ShareName(),
 			Name:      binding.NamedPipe(),
+			Observer:  t.settings.Observer,
 		}
 
 		if t.settings.Dialer != nil {
 			pipe.NetworkDialFunc = t.settings.Dialer.DialContext
 		}
 
-		if err := pipe.Connect(ctx); err != nil {
-			return nil, fmt.Errorf("ncacn_np: %w", err)
+		if t.settings.Capture != nil {
+			// capture the smb2 traffic as well.
+			if pipe.NetworkDialFunc == nil {
+				pipe.NetworkDialFunc = (&net.Dialer{Timeout: t.settings.Timeout}).DialContext
+			}
+			pipe.NetworkDialFunc = t.settings.Capture.DialFunc(pipe.NetworkDialFunc)
+		}
+
+		dial := func(ctx context.Context) (RawConn, error) {
+
+			if err := pipe.Connect(ctx); err != nil {
+				return nil, fmt.Errorf("ncacn_np: %w", err)
+			}
+
+			t.logger.Debug().Msgf("dialing smb named pipe done")
+
+			if t.settings.Capture != nil {
+				return t.settings.Capture.Pipe(pipe), nil
+			}
+
+			return pipe, nil
 		}
 
-		t.logger.Debug().Msgf("dialing smb named pipe done")
+		if d, ok := t.settings.Dialer.(PipeDialer); ok {
+			return d.DialPipe(ctx, binding.NamedPipe(), dial)
+		}
 
-		return pipe, nil
+		return dial(ctx)
 	}
 
 	return nil, fmt.Errorf("ncacn: %s: not supported", binding.String())
@@ -545,3 +616,30 @@ func (c *conn) closeTransport(ctx context.Context, tr *transport) error {
 func (c *conn) Error(ctx context.Context, value any) error {
 	return errors.New(ctx, value)
 }
+
+// NamedPipe function returns the SMB2 named pipe the connection is
+// established over. The named pipe SMB2 session can be used to mount the
+// file shares without additional authentication (see smb2.NamedPipe.Mount).
+func NamedPipe(cc Conn) (*smb2.NamedPipe, bool) {
+
+	switch cc := cc.(type) {
+	case *clientConn:
+		pipe, ok := unwrapConn(cc.transport.cc.RawConn).(*smb2.NamedPipe)
+		return pipe, ok
+	case *conn:
+		cc.mu.Lock()
+		defer cc.mu.Unlock()
+		for _, transports := range cc.transports {
+			for _, tr := range transports {
+				if tr.err != nil {
+					continue
+				}
+				if pipe, ok := unwrapConn(tr.cc.RawConn).(*smb2.NamedPipe); ok {
+					return pipe, true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
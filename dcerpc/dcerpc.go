@@ -48,6 +48,30 @@ type RawConn interface {
 	io.ReadWriteCloser
 }
 
+// FragmentRecorder is the optional interface implemented by the raw
+// connection (for example, returned by the dialer, see WithDialer) to
+// observe the plaintext fragments: the outgoing fragments are passed before
+// they are signed or encrypted and the incoming fragments after they were
+// decrypted.
+type FragmentRecorder interface {
+	RecordFragment(ctx context.Context, out bool, b []byte)
+}
+
+// SecurityRecorder is the optional interface implemented by the raw
+// connection (see FragmentRecorder) to observe the security contexts once
+// they are established by the client (see Security.ExportSecurityContext).
+type SecurityRecorder interface {
+	RecordSecurity(ctx context.Context, sec *Security)
+}
+
+// PipeDialer is the optional interface implemented by the dialer (see
+// WithDialer) to open the named pipes for the ncacn_np connections. The
+// function `dial` opens the SMB2 named pipe `name`, the dialer can wrap the
+// returned connection or replace it.
+type PipeDialer interface {
+	DialPipe(ctx context.Context, name string, dial func(context.Context) (RawConn, error)) (RawConn, error)
+}
+
 // The DCE/RPC Connection.
 type Conn interface {
 	Bind(context.Context, ...Option) (Conn, error)
@@ -0,0 +1,72 @@
+package dcerpc
+
+import (
+	"context"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+// Invoker is the function that invokes the operation, it is the next
+// interceptor in the chain or the actual operation call.
+type Invoker func(ctx context.Context, op Operation, opts ...CallOption) error
+
+// CallInfo is the information about the operation call passed to the
+// interceptor.
+type CallInfo struct {
+	// The abstract syntax (interface) identifier.
+	AbstractSyntax *SyntaxID
+	// The negotiated transfer syntax.
+	TransferSyntax *SyntaxID
+	// The object UUID (nil if the object UUID is not set).
+	ObjectUUID *uuid.UUID
+	// The operation number.
+	OpNum int
+	// The operation name ("/srvsvc/v3/NetrShareEnum").
+	OpName string
+}
+
+// UnaryInterceptor is the client call interceptor. The interceptor is called
+// instead of the operation call and must call the invoker to proceed.
+//
+// The operation contains the request values before the invoker call and the
+// response values after the invoker call, so the interceptor can inspect or
+// modify both. The interceptor can call the invoker more than once (for
+// example to retry the call) or not call it at all.
+type UnaryInterceptor func(ctx context.Context, info *CallInfo, op Operation, invoker Invoker, opts ...CallOption) error
+
+// WithUnaryInterceptor option adds the client call interceptors. The
+// interceptors are chained in the order they are specified: the first
+// interceptor is the outermost one.
+//
+// Specify this option for the Dial to intercept the calls of all clients,
+// or for the client constructor to intercept the calls of this client only:
+//
+//	logCalls := func(ctx context.Context, info *dcerpc.CallInfo, op dcerpc.Operation, invoker dcerpc.Invoker, opts ...dcerpc.CallOption) error {
+//		start := time.Now()
+//		err := invoker(ctx, op, opts...)
+//		log.Printf("%s: %v: %v", info.OpName, time.Since(start), err)
+//		return err
+//	}
+//
+//	cli, err := winreg.NewWinregClient(ctx, conn, dcerpc.WithSeal(), dcerpc.WithUnaryInterceptor(logCalls))
+func WithUnaryInterceptor(interceptors ...UnaryInterceptor) BindOption {
+	return BindOption(func(o *option) {
+		for _, i := range interceptors {
+			if i != nil {
+				o.Interceptors = append(o.Interceptors, i)
+			}
+		}
+	})
+}
+
+// chainInterceptors function returns the invoker that calls the interceptors
+// and then the invoker.
+func chainInterceptors(interceptors []UnaryInterceptor, info *CallInfo, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, op Operation, opts ...CallOption) error {
+			return interceptor(ctx, info, op, next, opts...)
+		}
+	}
+	return invoker
+}
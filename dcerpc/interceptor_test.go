@@ -0,0 +1,133 @@
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+type testOperation struct {
+	Value int
+}
+
+func (*testOperation) OpNum() int                                             { return 22 }
+func (*testOperation) OpName() string                                         { return "/test/v1/TestOperation" }
+func (*testOperation) MarshalNDRRequest(context.Context, ndr.Writer) error    { return nil }
+func (*testOperation) UnmarshalNDRRequest(context.Context, ndr.Reader) error  { return nil }
+func (*testOperation) MarshalNDRResponse(context.Context, ndr.Writer) error   { return nil }
+func (*testOperation) UnmarshalNDRResponse(context.Context, ndr.Reader) error { return nil }
+
+func TestUnaryInterceptor(t *testing.T) {
+
+	var calls []string
+
+	trace := func(name string) UnaryInterceptor {
+		return func(ctx context.Context, info *CallInfo, op Operation, invoker Invoker, opts ...CallOption) error {
+			calls = append(calls, fmt.Sprintf("%s:%d:%s", name, info.OpNum, info.ObjectUUID))
+			return invoker(ctx, op, opts...)
+		}
+	}
+
+	retries := 0
+
+	retry := func(ctx context.Context, info *CallInfo, op Operation, invoker Invoker, opts ...CallOption) error {
+		// modify the request.
+		op.(*testOperation).Value = 1
+		err := invoker(ctx, op, opts...)
+		for ; err != nil && retries < 2; retries++ {
+			err = invoker(ctx, op, opts...)
+		}
+		return err
+	}
+
+	option := &option{}
+	WithUnaryInterceptor(retry, nil, trace("inner"))(option)
+
+	syntax := &SyntaxID{IfUUID: uuid.MustParse("12345778-1234-abcd-ef00-0123456789ac"), IfVersionMajor: 1}
+
+	cc := &clientConn{
+		mu:           new(sync.RWMutex),
+		presentation: &Presentation{AbstractSyntax: syntax},
+		closed:       true,
+		interceptors: option.Interceptors,
+	}
+
+	op := &testOperation{}
+
+	obj := uuid.MustParse("3ad5ea4d-ff0b-41ab-a8e4-b0e4b2e7f2a1")
+
+	if err := cc.InvokeObject(context.Background(), obj, op); !errors.Is(err, ErrConnClosed) {
+		t.Fatalf("invoke: expected connection closed error, got %v", err)
+	}
+
+	if retries != 2 || len(calls) != 3 || calls[0] != "inner:22:"+obj.String() || op.Value != 1 {
+		t.Errorf("invoke: unexpected calls %v, retries %d, value %d", calls, retries, op.Value)
+	}
+
+	// the interceptor can short-circuit the call.
+	cc.interceptors = []UnaryInterceptor{func(ctx context.Context, info *CallInfo, op Operation, invoker Invoker, opts ...CallOption) error {
+		if info.AbstractSyntax != syntax || info.OpName != op.OpName() {
+			return fmt.Errorf("unexpected call info %+v", info)
+		}
+		return nil
+	}}
+
+	if err := cc.Invoke(context.Background(), op); err != nil {
+		t.Errorf("invoke: %v", err)
+	}
+}
+
+func TestUnaryInterceptorAlterContext(t *testing.T) {
+
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.RegisterServer(testEchoServerHandle, WithAbstractSyntax(testEchoSyntax))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := srv.Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	intercepted := func(ctx context.Context, info *CallInfo, op Operation, invoker Invoker, opts ...CallOption) error {
+		if info.TransferSyntax == nil {
+			return fmt.Errorf("call info: no transfer syntax")
+		}
+		return invoker(ctx, op, opts...)
+	}
+
+	cc, err := conn.Bind(ctx, WithInsecure(), WithAbstractSyntax(testEchoSyntax), WithUnaryInterceptor(intercepted))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 16; i++ {
+			if err := cc.Invoke(ctx, &testEchoOperation{Data: []byte("echo")}); err != nil {
+				t.Errorf("invoke: %v", err)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 16; i++ {
+		if err := cc.AlterContext(ctx, WithInsecure()); err != nil {
+			t.Errorf("alter context: %v", err)
+		}
+	}
+
+	<-done
+}
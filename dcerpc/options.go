@@ -46,6 +46,8 @@ type option struct {
 	Bindings []string
 	// The Error mapper.
 	ErrorMappers []errors.Mapper
+	// The client call interceptors.
+	Interceptors []UnaryInterceptor
 }
 
 // TargetBinding returns the string representation without any trailing slashes or
@@ -130,6 +132,21 @@ func WithCredentials(creds any) SecurityContextOption {
 	})
 }
 
+// WithCredentialDatabase option specifies the credential database used by
+// the server to verify the client credentials.
+//
+//	import "github.com/oiweiwei/go-msrpc/ssp/credential"
+//
+//	db := credential.NewLocalDatabase()
+//	db.Add(credential.NewFromPassword("Domain\\User", "Password"))
+//
+//	srv := dcerpc.NewServer(dcerpc.WithMechanism(ssp.NTLM, cfg), dcerpc.WithCredentialDatabase(gssapi.NewCredentialDatabase(db)))
+func WithCredentialDatabase(db gssapi.CredentialDatabase) SecurityContextOption {
+	return SecurityContextOption(func(o *option) {
+		o.SecurityOptions = append(o.SecurityOptions, gssapi.WithCredentialDatabase(db))
+	})
+}
+
 // NoBindOption option indicates that no bind must be performed
 // for this connection.
 type NoBindOption struct{ Conn Conn }
@@ -0,0 +1,268 @@
+// Package otelrpc implements the OpenTelemetry tracing and metrics for the
+// dcerpc and smb2 packages.
+//
+// Install the observer once to instrument all connections:
+//
+//	otelrpc.Install()
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", ...)
+//
+// Or specify it for the single connection:
+//
+//	conn, err := dcerpc.Dial(ctx, "contoso.net", dcerpc.WithObserver(otelrpc.New()))
+//
+// The spans are created for dial, SMB2 session setup, tree connect and named
+// pipe open, bind, alter context and each call. The metrics are the event
+// duration histogram, the retry counter, and the number of active associations.
+//
+// The package is the separate module, so that the OpenTelemetry dependencies
+// are not required by the go-msrpc module:
+//
+//	go get github.com/oiweiwei/go-msrpc/dcerpc/otelrpc
+package otelrpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
+)
+
+// ScopeName is the instrumentation scope name.
+const ScopeName = "github.com/oiweiwei/go-msrpc/dcerpc/otelrpc"
+
+// Option is the observer option.
+type Option func(*config)
+
+type config struct {
+	tracerProvider oteltrace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider option sets the tracer provider (default is the global
+// tracer provider).
+func WithTracerProvider(tp oteltrace.TracerProvider) Option {
+	return func(c *config) { c.tracerProvider = tp }
+}
+
+// WithMeterProvider option sets the meter provider (default is the global
+// meter provider).
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) { c.meterProvider = mp }
+}
+
+// Observer is the OpenTelemetry trace.Observer implementation.
+type Observer struct {
+	tracer       oteltrace.Tracer
+	duration     metric.Float64Histogram
+	retries      metric.Int64Counter
+	associations metric.Int64UpDownCounter
+	bytes        metric.Int64Counter
+}
+
+// New function returns the new OpenTelemetry observer.
+func New(opts ...Option) *Observer {
+
+	c := &config{}
+	for _, o := range opts {
+		o(c)
+	}
+
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+	if c.meterProvider == nil {
+		c.meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := c.meterProvider.Meter(ScopeName)
+
+	o := &Observer{tracer: c.tracerProvider.Tracer(ScopeName)}
+
+	// the instrument errors are reported to the global error handler and the
+	// no-op instruments are returned.
+	var err error
+	if o.duration, err = meter.Float64Histogram("rpc.client.duration",
+		metric.WithDescription("The duration of the dial, bind and call events."),
+		metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if o.retries, err = meter.Int64Counter("rpc.client.retries",
+		metric.WithDescription("The number of the dial and named pipe open retries."),
+		metric.WithUnit("{retry}")); err != nil {
+		otel.Handle(err)
+	}
+	if o.associations, err = meter.Int64UpDownCounter("rpc.client.active_associations",
+		metric.WithDescription("The number of the active associations."),
+		metric.WithUnit("{association}")); err != nil {
+		otel.Handle(err)
+	}
+	if o.bytes, err = meter.Int64Counter("rpc.client.bytes",
+		metric.WithDescription("The number of bytes transferred by the calls."),
+		metric.WithUnit("By")); err != nil {
+		otel.Handle(err)
+	}
+
+	return o
+}
+
+// Install function sets the new OpenTelemetry observer as the global
+// observer (see trace.SetObserver) and returns it.
+func Install(opts ...Option) *Observer {
+	o := New(opts...)
+	trace.SetObserver(o)
+	return o
+}
+
+type startKey struct{}
+
+// Start function starts the span for the event.
+func (o *Observer) Start(ctx context.Context, ev *trace.Event) context.Context {
+
+	kind := oteltrace.SpanKindClient
+	if ev.Kind == trace.KindAssociation {
+		kind = oteltrace.SpanKindInternal
+	}
+
+	ctx, _ = o.tracer.Start(ctx, "dcerpc."+ev.Kind.String(),
+		oteltrace.WithSpanKind(kind),
+		oteltrace.WithAttributes(Attributes(ev)...))
+
+	if ev.Kind == trace.KindAssociation && o.associations != nil {
+		o.associations.Add(ctx, 1, metric.WithAttributes(metricAttributes(ev)...))
+	}
+
+	return context.WithValue(ctx, startKey{}, time.Now())
+}
+
+// End function ends the span for the event and records the metrics.
+func (o *Observer) End(ctx context.Context, ev *trace.Event, err error) {
+
+	span := oteltrace.SpanFromContext(ctx)
+
+	// set the attributes known at the end of the event.
+	span.SetAttributes(Attributes(ev)...)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+
+	attrs := metricAttributes(ev)
+	if err != nil {
+		attrs = append(attrs, attribute.Bool("error", true))
+	}
+
+	if ev.Kind == trace.KindAssociation {
+		if o.associations != nil {
+			o.associations.Add(ctx, -1, metric.WithAttributes(metricAttributes(ev)...))
+		}
+		return
+	}
+
+	set := metric.WithAttributes(attrs...)
+
+	if start, ok := ctx.Value(startKey{}).(time.Time); ok && o.duration != nil {
+		o.duration.Record(ctx, time.Since(start).Seconds(), set)
+	}
+
+	if ev.Retries > 0 && o.retries != nil {
+		o.retries.Add(ctx, int64(ev.Retries), set)
+	}
+
+	if ev.Kind == trace.KindCall && o.bytes != nil {
+		o.bytes.Add(ctx, int64(ev.BytesOut), metric.WithAttributes(append(attrs, attribute.String("direction", "out"))...))
+		o.bytes.Add(ctx, int64(ev.BytesIn), metric.WithAttributes(append(attrs, attribute.String("direction", "in"))...))
+	}
+}
+
+// Attributes function returns the span attributes for the event. Only
+// non-empty values are returned.
+func Attributes(ev *trace.Event) []attribute.KeyValue {
+
+	attrs := []attribute.KeyValue{attribute.String("rpc.system", "dcerpc")}
+
+	str := func(k, v string) {
+		if v != "" {
+			attrs = append(attrs, attribute.String(k, v))
+		}
+	}
+
+	num := func(k string, v int) {
+		if v != 0 {
+			attrs = append(attrs, attribute.Int(k, v))
+		}
+	}
+
+	str("network.protocol.name", ev.Protocol)
+	str("server.address", ev.Address)
+	str("dcerpc.binding", ev.Binding)
+	str("smb2.share", ev.Share)
+	str("smb2.pipe", ev.Pipe)
+	if ev.Dialect != 0 {
+		attrs = append(attrs, attribute.Int("smb2.dialect", int(ev.Dialect)))
+	}
+	num("dcerpc.retries", ev.Retries)
+	str("dcerpc.abstract_syntax", ev.AbstractSyntax)
+	str("dcerpc.transfer_syntax", ev.TransferSyntax)
+	str("dcerpc.auth_type", ev.AuthType)
+	str("dcerpc.auth_level", ev.AuthLevel)
+	if ev.AssocGroupID != 0 {
+		attrs = append(attrs, attribute.Int64("dcerpc.assoc_group_id", int64(ev.AssocGroupID)))
+	}
+	if len(ev.Features) > 0 {
+		attrs = append(attrs, attribute.StringSlice("dcerpc.features", ev.Features))
+	}
+	if ev.Kind == trace.KindCall {
+		attrs = append(attrs, attribute.Int("dcerpc.opnum", ev.OpNum))
+		str("rpc.service", service(ev.OpName))
+		str("rpc.method", method(ev.OpName))
+		str("dcerpc.object", ev.Object)
+		num("dcerpc.fragments.out", ev.FragmentsOut)
+		num("dcerpc.fragments.in", ev.FragmentsIn)
+		num("dcerpc.bytes.out", ev.BytesOut)
+		num("dcerpc.bytes.in", ev.BytesIn)
+		if ev.FaultStatus != 0 {
+			attrs = append(attrs, attribute.Int64("dcerpc.fault_status", int64(ev.FaultStatus)))
+		}
+	}
+
+	return attrs
+}
+
+// metricAttributes function returns the low-cardinality attributes for the
+// metrics.
+func metricAttributes(ev *trace.Event) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("dcerpc.event", ev.Kind.String())}
+	if ev.Protocol != "" {
+		attrs = append(attrs, attribute.String("network.protocol.name", ev.Protocol))
+	}
+	if ev.OpName != "" {
+		attrs = append(attrs, attribute.String("rpc.service", service(ev.OpName)), attribute.String("rpc.method", method(ev.OpName)))
+	}
+	return attrs
+}
+
+// service function returns the "iface/vN" part of the "/iface/vN/OpName"
+// operation name.
+func service(opName string) string {
+	if i := strings.LastIndexByte(opName, '/'); i > 0 {
+		return strings.TrimPrefix(opName[:i], "/")
+	}
+	return ""
+}
+
+// method function returns the "OpName" part of the "/iface/vN/OpName"
+// operation name.
+func method(opName string) string {
+	return opName[strings.LastIndexByte(opName, '/')+1:]
+}
@@ -0,0 +1,89 @@
+package otelrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
+)
+
+func TestObserver(t *testing.T) {
+
+	rec := tracetest.NewSpanRecorder()
+
+	o := New(WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))))
+
+	ctx, endBind := trace.Start(context.Background(), o, &trace.Event{Kind: trace.KindBind, Protocol: "ncacn_ip_tcp"})
+
+	ev := &trace.Event{Kind: trace.KindCall, OpNum: 15, OpName: "/srvsvc/v3/NetrShareEnum"}
+
+	callCtx, endCall := trace.Start(ctx, o, ev)
+
+	if cev, ok := trace.FromContext(callCtx, trace.KindCall); !ok || cev != ev {
+		t.Fatalf("from context: call event not found")
+	}
+
+	ev.FragmentsOut, ev.BytesOut, ev.FaultStatus = 1, 120, 0x1c010003
+
+	endCall(errors.New("fault"))
+	endBind(nil)
+
+	spans := rec.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("spans: expected 2, got %d", len(spans))
+	}
+
+	call, bind := spans[0], spans[1]
+
+	if call.Name() != "dcerpc.call" || bind.Name() != "dcerpc.bind" {
+		t.Errorf("span names: %s, %s", call.Name(), bind.Name())
+	}
+
+	if call.Parent().SpanID() != bind.SpanContext().SpanID() {
+		t.Errorf("call span is not the child of the bind span")
+	}
+
+	if call.Status().Code != codes.Error {
+		t.Errorf("call span status: %v", call.Status())
+	}
+
+	attrs := attribute.NewSet(call.Attributes()...)
+
+	for k, v := range map[attribute.Key]attribute.Value{
+		"rpc.service":          attribute.StringValue("srvsvc/v3"),
+		"rpc.method":           attribute.StringValue("NetrShareEnum"),
+		"dcerpc.opnum":         attribute.IntValue(15),
+		"dcerpc.bytes.out":     attribute.IntValue(120),
+		"dcerpc.fault_status":  attribute.Int64Value(0x1c010003),
+		"dcerpc.fragments.out": attribute.IntValue(1),
+	} {
+		if actual, ok := attrs.Value(k); !ok || actual != v {
+			t.Errorf("call span attribute %s: expected %v, got %v", k, v.Emit(), actual.Emit())
+		}
+	}
+}
+
+func TestDefaultObserver(t *testing.T) {
+
+	if _, end := trace.Start(context.Background(), nil, &trace.Event{Kind: trace.KindDial}); end == nil {
+		t.Fatalf("start: nil end function")
+	}
+
+	rec := tracetest.NewSpanRecorder()
+
+	Install(WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))))
+	defer trace.SetObserver(nil)
+
+	_, end := trace.Start(context.Background(), nil, &trace.Event{Kind: trace.KindDial})
+	end(nil)
+
+	if len(rec.Ended()) != 1 {
+		t.Errorf("global observer: expected 1 span, got %d", len(rec.Ended()))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
 	"github.com/oiweiwei/go-msrpc/midl/uuid"
 	"github.com/oiweiwei/go-msrpc/ndr"
 )
@@ -283,6 +284,9 @@ func (c *transport) DecodePacket(ctx context.Context, pkt *Packet, raw []byte) (
 	case *Response:
 		maxLen = int(pdu.AllocHint)
 	case *Fault:
+		if ev, ok := trace.FromContext(ctx, trace.KindCall); ok {
+			ev.FaultStatus = pdu.Status
+		}
 		if pdu.Status != 0 {
 			return nil, errors.New(ctx, pdu.Status)
 		}
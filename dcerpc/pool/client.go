@@ -0,0 +1,174 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+// InvalidatedError is returned by the client call when the association
+// of the client was dropped and re-established. The context handles opened
+// by the client before are no longer valid and must be re-opened.
+type InvalidatedError struct {
+	// The error that caused the association to fail, if nil, the
+	// association was re-established before the call and the call was not
+	// sent, otherwise the call result is unknown.
+	Err error
+}
+
+func (e *InvalidatedError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("pool: association was re-established, context handles are invalidated: %v", e.Err)
+	}
+	return "pool: association was re-established, context handles are invalidated"
+}
+
+func (e *InvalidatedError) Unwrap() error {
+	return e.Err
+}
+
+// Client is the connection bound to the single association of the pool.
+// It implements the dcerpc.Conn interface.
+type Client struct {
+	m *member
+	// The bind options.
+	opts []dcerpc.Option
+
+	mu sync.Mutex
+	// The bound connection.
+	cc dcerpc.Conn
+	// The association generation the connection is bound to.
+	gen uint64
+}
+
+// conn function returns the bound connection. If the association was
+// re-established since the last bind, the client is bound to the new
+// association and the *InvalidatedError is returned.
+func (c *Client) conn(ctx context.Context) (dcerpc.Conn, uint64, error) {
+
+	// the lock is not held while waiting for the reconnection, so that
+	// the other calls (and the Context) are not blocked.
+	conn, gen, err := c.m.get(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cc != nil && c.gen >= gen {
+		// the client is bound to the same (or newer) association by the
+		// concurrent call.
+		return c.cc, c.gen, nil
+	}
+
+	cc, err := conn.Bind(ctx, c.opts...)
+	if err != nil {
+		if IsConnError(err) {
+			c.m.fail(gen, err)
+		}
+		return nil, 0, fmt.Errorf("pool: bind: %w", err)
+	}
+
+	invalidated := c.cc != nil
+
+	if c.cc, c.gen = cc, gen; invalidated {
+		return nil, 0, &InvalidatedError{}
+	}
+
+	return cc, gen, nil
+}
+
+// Bind function binds the new client to the same association.
+func (c *Client) Bind(ctx context.Context, opts ...dcerpc.Option) (dcerpc.Conn, error) {
+	return c.m.bind(ctx, opts...)
+}
+
+// AlterContext function alters the context of the bound connection. The
+// options are applied to the subsequent binds as well.
+func (c *Client) AlterContext(ctx context.Context, opts ...dcerpc.Option) error {
+
+	cc, _, err := c.conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := cc.AlterContext(ctx, opts...); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.opts = append(c.opts[:len(c.opts):len(c.opts)], opts...)
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Context function returns the context of the bound connection.
+func (c *Client) Context() context.Context {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cc == nil {
+		return context.Background()
+	}
+
+	return c.cc.Context()
+}
+
+// Invoke function invokes the operation on the bound connection.
+func (c *Client) Invoke(ctx context.Context, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+	return c.invoke(ctx, func(cc dcerpc.Conn) error { return cc.Invoke(ctx, op, opts...) })
+}
+
+// InvokeObject function invokes the operation on the bound connection.
+func (c *Client) InvokeObject(ctx context.Context, id *uuid.UUID, op dcerpc.Operation, opts ...dcerpc.CallOption) error {
+	return c.invoke(ctx, func(cc dcerpc.Conn) error { return cc.InvokeObject(ctx, id, op, opts...) })
+}
+
+func (c *Client) invoke(ctx context.Context, call func(dcerpc.Conn) error) error {
+
+	cc, gen, err := c.conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := call(cc); err != nil {
+		if IsConnError(err) {
+			c.m.fail(gen, err)
+			c.reset(gen)
+			return &InvalidatedError{Err: err}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// reset function resets the connection bound to the association of
+// generation `gen`, so that the invalidation is not signalled twice.
+func (c *Client) reset(gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.gen == gen {
+		c.cc = nil
+	}
+}
+
+// Close function detaches the client, the association is closed with the
+// pool.
+func (c *Client) Close(ctx context.Context) error {
+	return nil
+}
+
+// RegisterServer function implements the dcerpc.Conn interface.
+func (c *Client) RegisterServer(dcerpc.ServerHandle, ...dcerpc.Option) {}
+
+// Error function implements the dcerpc.Conn interface.
+func (c *Client) Error(ctx context.Context, value any) error {
+	return c.m.p.Error(ctx, value)
+}
@@ -0,0 +1,62 @@
+package pool
+
+import (
+	"context"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/iobjectexporter/v0"
+	"github.com/oiweiwei/go-msrpc/msrpc/mgmt/mgmt/v1"
+)
+
+// HealthCheck function is called once the association `cc` (the connection
+// returned by dcerpc.Dial) is established. It must bind the client that
+// is used to check the liveness of the association and return the probe.
+// The connection error (see IsConnError) returned by the health check or
+// the probe, or the probe that does not complete within the health check
+// interval, fails the association. Other errors (for example, access
+// denied) are ignored, the health check that has not returned the probe
+// is retried on the next interval.
+type HealthCheck func(ctx context.Context, cc dcerpc.Conn) (Probe, error)
+
+// Probe function checks the liveness of the association.
+type Probe func(ctx context.Context) error
+
+// InquireInterfaceIDs function returns the health check that uses the
+// rpc__mgmt_inq_if_ids call. The options are passed to the mgmt client
+// bind.
+func InquireInterfaceIDs(opts ...dcerpc.Option) HealthCheck {
+	return func(ctx context.Context, cc dcerpc.Conn) (Probe, error) {
+
+		cli, err := mgmt.NewManagementClient(ctx, cc, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		probe := func(ctx context.Context) error {
+			_, err := cli.InquireInterfaceIDs(ctx, &mgmt.InquireInterfaceIDsRequest{})
+			return err
+		}
+
+		return probe, probe(ctx)
+	}
+}
+
+// ServerAlive2 function returns the health check that uses the
+// IObjectExporter ServerAlive2 call. The options are passed to the object
+// exporter client bind.
+func ServerAlive2(opts ...dcerpc.Option) HealthCheck {
+	return func(ctx context.Context, cc dcerpc.Conn) (Probe, error) {
+
+		cli, err := iobjectexporter.NewObjectExporterClient(ctx, cc, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		probe := func(ctx context.Context) error {
+			_, err := cli.ServerAlive2(ctx, &iobjectexporter.ServerAlive2Request{})
+			return err
+		}
+
+		return probe, probe(ctx)
+	}
+}
@@ -0,0 +1,476 @@
+// Package pool implements the pooled dcerpc.Conn that keeps the set of
+// authenticated associations to the server, checks their liveness and
+// transparently re-establishes the associations that were dropped.
+//
+// The pool implements the dcerpc.Conn interface and can be used to create
+// the generated clients:
+//
+//	p, err := pool.Dial(ctx, "contoso.net",
+//		pool.WithSize(4),
+//		pool.WithHealthCheck(pool.InquireInterfaceIDs(dcerpc.WithSeal()), 30*time.Second),
+//		pool.WithDialOptions(dcerpc.WithCredentials(cred), dcerpc.WithMechanism(ssp.NTLM)))
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer p.Close(ctx)
+//
+//	cli, err := samr.NewSamrClient(ctx, p, dcerpc.WithSeal())
+//
+// Each client is pinned to the single association of the pool (the clients
+// are distributed between the associations in round-robin order), so that
+// the context handles opened by the client remain valid. Once the
+// association is re-established, the client is bound to the new
+// association, and the first call of the client returns the
+// *InvalidatedError to signal that the context handles opened by the client
+// must be re-opened.
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	dcerpc_errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+)
+
+// ErrClosed is returned when the pool is closed.
+var ErrClosed = errors.New("pool: closed")
+
+// Option is the pool option.
+type Option func(*config)
+
+type config struct {
+	size        int
+	check       HealthCheck
+	interval    time.Duration
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	dialOptions []dcerpc.Option
+}
+
+// WithSize option sets the number of associations (default is 1).
+func WithSize(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.size = n
+		}
+	}
+}
+
+// WithHealthCheck option sets the liveness check of the associations that
+// is performed every `interval`.
+func WithHealthCheck(check HealthCheck, interval time.Duration) Option {
+	return func(c *config) { c.check, c.interval = check, interval }
+}
+
+// WithBackoff option sets the minimum and the maximum reconnection delays
+// (default is 100ms and 30s). The delay is doubled after each failed
+// reconnection attempt.
+func WithBackoff(min, max time.Duration) Option {
+	return func(c *config) { c.minBackoff, c.maxBackoff = min, max }
+}
+
+// WithDialOptions option sets the options passed to the dcerpc.Dial
+// function for each association.
+func WithDialOptions(opts ...dcerpc.Option) Option {
+	return func(c *config) { c.dialOptions = append(c.dialOptions, opts...) }
+}
+
+// Pool is the set of associations to the server. It implements the
+// dcerpc.Conn interface.
+type Pool struct {
+	addr string
+	cfg  config
+
+	mu      sync.Mutex
+	members []*member
+	next    int
+	closed  bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Dial function establishes the pool of associations to the server
+// `addr` (see dcerpc.Dial). The function fails if none of the associations
+// can be established, the failed associations are re-established in the
+// background.
+func Dial(ctx context.Context, addr string, opts ...Option) (*Pool, error) {
+
+	p := &Pool{
+		addr: addr,
+		cfg: config{
+			size:       1,
+			minBackoff: 100 * time.Millisecond,
+			maxBackoff: 30 * time.Second,
+		},
+	}
+
+	for _, o := range opts {
+		o(&p.cfg)
+	}
+
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
+	var lastErr error
+
+	for i := 0; i < p.cfg.size; i++ {
+		m := &member{p: p, ready: make(chan struct{})}
+		if lastErr = m.connect(ctx); lastErr != nil {
+			m.fail(0, lastErr)
+		}
+		p.members = append(p.members, m)
+	}
+
+	if p.Healthy() == 0 {
+		p.Close(ctx)
+		return nil, fmt.Errorf("pool: dial: %w", lastErr)
+	}
+
+	if p.cfg.check != nil && p.cfg.interval > 0 && p.goAsync() {
+		go p.healthCheck()
+	}
+
+	return p, nil
+}
+
+// Healthy function returns the number of the established associations.
+func (p *Pool) Healthy() int {
+
+	n := 0
+	for _, m := range p.members {
+		if _, _, ok := m.current(); ok {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Bind function binds the new client to the next association of the pool.
+func (p *Pool) Bind(ctx context.Context, opts ...dcerpc.Option) (dcerpc.Conn, error) {
+
+	p.mu.Lock()
+	m := p.members[p.next%len(p.members)]
+	p.next++
+	p.mu.Unlock()
+
+	return m.bind(ctx, opts...)
+}
+
+// AlterContext function implements the dcerpc.Conn interface.
+func (p *Pool) AlterContext(context.Context, ...dcerpc.Option) error {
+	return fmt.Errorf("pool: alter context: the pool is not binded")
+}
+
+// Context function implements the dcerpc.Conn interface.
+func (p *Pool) Context() context.Context {
+	return context.Background()
+}
+
+// Invoke function implements the dcerpc.Conn interface.
+func (p *Pool) Invoke(context.Context, dcerpc.Operation, ...dcerpc.CallOption) error {
+	return fmt.Errorf("pool: invoke: the pool is not binded")
+}
+
+// InvokeObject function implements the dcerpc.Conn interface.
+func (p *Pool) InvokeObject(context.Context, *uuid.UUID, dcerpc.Operation, ...dcerpc.CallOption) error {
+	return fmt.Errorf("pool: invoke_object: the pool is not binded")
+}
+
+// RegisterServer function implements the dcerpc.Conn interface.
+func (p *Pool) RegisterServer(dcerpc.ServerHandle, ...dcerpc.Option) {}
+
+// Error function implements the dcerpc.Conn interface.
+func (p *Pool) Error(ctx context.Context, value any) error {
+	return dcerpc_errors.New(ctx, value)
+}
+
+// Close function stops the health checks and reconnections and closes
+// all associations.
+func (p *Pool) Close(ctx context.Context) error {
+
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+
+	p.cancel()
+	p.wg.Wait()
+
+	for _, m := range p.members {
+		m.close(ctx)
+	}
+
+	return nil
+}
+
+// goAsync function registers the background goroutine, it returns `false`
+// if the pool is closed.
+func (p *Pool) goAsync() bool {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return false
+	}
+
+	p.wg.Add(1)
+	return true
+}
+
+// healthCheck function periodically checks the established associations.
+func (p *Pool) healthCheck() {
+
+	defer p.wg.Done()
+
+	t := time.NewTicker(p.cfg.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		for _, m := range p.members {
+			m.probe(p.ctx)
+		}
+	}
+}
+
+// member is the single association of the pool.
+type member struct {
+	p *Pool
+
+	mu sync.Mutex
+	// The connection returned by dcerpc.Dial.
+	conn dcerpc.Conn
+	// The liveness probe of the association.
+	probeFn Probe
+	// The association generation, incremented on each reconnection.
+	gen uint64
+	// The error that caused the association to fail.
+	err error
+	// The channel closed once the association is established.
+	ready chan struct{}
+	// The reconnection is in progress.
+	reconnecting bool
+}
+
+// connect function establishes the association.
+func (m *member) connect(ctx context.Context) error {
+
+	conn, err := dcerpc.Dial(ctx, m.p.addr, m.p.cfg.dialOptions...)
+	if err != nil {
+		return err
+	}
+
+	var probe Probe
+
+	if m.p.cfg.check != nil {
+		// the health check that fails with the error other than the
+		// connection error (for example, access denied) does not fail
+		// the association.
+		if probe, err = m.p.cfg.check(ctx, conn); IsConnError(err) {
+			conn.Close(ctx)
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// the reconnection is completed before the association is published,
+	// so that the failure of the new association is not dropped.
+	m.conn, m.probeFn, m.err, m.reconnecting = conn, probe, nil, false
+	m.gen++
+	close(m.ready)
+
+	return nil
+}
+
+// current function returns the established association.
+func (m *member) current() (dcerpc.Conn, uint64, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.conn, m.gen, m.conn != nil && m.err == nil
+}
+
+// get function returns the established association and waits for the
+// reconnection if required.
+func (m *member) get(ctx context.Context) (dcerpc.Conn, uint64, error) {
+
+	for {
+		m.mu.Lock()
+		conn, gen, err, ready := m.conn, m.gen, m.err, m.ready
+		m.mu.Unlock()
+
+		if conn != nil && err == nil {
+			return conn, gen, nil
+		}
+
+		if err == ErrClosed {
+			return nil, 0, ErrClosed
+		}
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			if err != nil {
+				return nil, 0, fmt.Errorf("pool: wait for reconnection: %w: %w", ctx.Err(), err)
+			}
+			return nil, 0, ctx.Err()
+		case <-m.p.ctx.Done():
+			return nil, 0, ErrClosed
+		}
+	}
+}
+
+// fail function marks the association of generation `gen` as failed and
+// starts the reconnection.
+func (m *member) fail(gen uint64, err error) {
+
+	m.mu.Lock()
+
+	if gen != m.gen || m.reconnecting || m.err == ErrClosed {
+		m.mu.Unlock()
+		return
+	}
+
+	conn := m.conn
+
+	m.conn, m.probeFn, m.err, m.reconnecting = nil, nil, err, true
+	if gen != 0 {
+		// the association was established.
+		m.ready = make(chan struct{})
+	}
+
+	m.mu.Unlock()
+
+	if conn != nil {
+		conn.Close(context.Background())
+	}
+
+	if m.p.goAsync() {
+		go m.reconnect()
+	}
+}
+
+// reconnect function re-establishes the association with backoff.
+func (m *member) reconnect() {
+
+	defer m.p.wg.Done()
+
+	for delay := m.p.cfg.minBackoff; ; delay = min(delay*2, m.p.cfg.maxBackoff) {
+
+		select {
+		case <-m.p.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		err := m.connect(m.p.ctx)
+		if err == nil {
+			return
+		}
+
+		m.mu.Lock()
+		m.err = err
+		m.mu.Unlock()
+	}
+}
+
+// probe function checks the liveness of the established association and
+// starts the reconnection if the check fails with the connection error or
+// does not complete within the health check interval.
+func (m *member) probe(ctx context.Context) {
+
+	m.mu.Lock()
+	conn, probe, gen, ok := m.conn, m.probeFn, m.gen, m.conn != nil && m.err == nil
+	m.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, m.p.cfg.interval)
+	defer cancel()
+
+	var err error
+
+	if probe != nil {
+		err = probe(probeCtx)
+	} else if probe, err = m.p.cfg.check(probeCtx, conn); probe != nil {
+		// the health check has failed with the error other than the
+		// connection error when the association was established.
+		m.mu.Lock()
+		if m.gen == gen && m.probeFn == nil {
+			m.probeFn = probe
+		}
+		m.mu.Unlock()
+	}
+
+	// the probe of the hung association is interrupted by the timeout.
+	if IsConnError(err) || (err != nil && probeCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil) {
+		m.fail(gen, fmt.Errorf("pool: health check: %w", err))
+	}
+}
+
+// bind function binds the new client to the association.
+func (m *member) bind(ctx context.Context, opts ...dcerpc.Option) (dcerpc.Conn, error) {
+
+	c := &Client{m: m, opts: opts}
+
+	if _, _, err := c.conn(ctx); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// close function closes the association.
+func (m *member) close(ctx context.Context) {
+
+	m.mu.Lock()
+	conn := m.conn
+	m.conn, m.err = nil, ErrClosed
+	m.mu.Unlock()
+
+	if conn != nil {
+		conn.Close(ctx)
+	}
+}
+
+// IsConnError function returns `true` if the error indicates that the
+// association was dropped.
+func IsConnError(err error) bool {
+
+	if err == nil {
+		return false
+	}
+
+	for _, target := range []error{
+		dcerpc.ErrShutdown,
+		dcerpc.ErrClosed,
+		io.EOF,
+		io.ErrUnexpectedEOF,
+		io.ErrClosedPipe,
+		net.ErrClosed,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	var netErr *net.OpError
+	return errors.As(err, &netErr)
+}
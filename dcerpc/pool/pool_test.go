@@ -0,0 +1,346 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcetypes"
+	"github.com/oiweiwei/go-msrpc/msrpc/mgmt/mgmt/v1"
+)
+
+type testServer struct {
+	mgmt.UnimplementedManagementServer
+}
+
+func (testServer) InquireInterfaceIDs(context.Context, *mgmt.InquireInterfaceIDsRequest) (*mgmt.InquireInterfaceIDsResponse, error) {
+	return &mgmt.InquireInterfaceIDsResponse{InterfaceIDVector: &dcetypes.InterfaceIDVector{}}, nil
+}
+
+// testDialer dials the in-process server and allows to drop the dialed
+// connections.
+type testDialer struct {
+	srv *dcerpc.Server
+
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (d *testDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+
+	cc, err := d.srv.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	d.conns = append(d.conns, cc)
+	d.mu.Unlock()
+
+	return cc, nil
+}
+
+func (d *testDialer) dials() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.conns)
+}
+
+func (d *testDialer) drop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, cc := range d.conns {
+		cc.Close()
+	}
+}
+
+func TestPool(t *testing.T) {
+
+	srv := dcerpc.NewServer()
+	defer srv.Close()
+
+	srv.RegisterServer(mgmt.NewManagementServerHandle(testServer{}), dcerpc.WithAbstractSyntax(mgmt.ManagementSyntaxV1_0))
+
+	for _, tc := range []struct {
+		name  string
+		check bool
+	}{
+		{"reconnect_on_call", false},
+		{"reconnect_on_health_check", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			d := &testDialer{srv: srv}
+
+			opts := []Option{
+				WithSize(2),
+				WithBackoff(10*time.Millisecond, 50*time.Millisecond),
+				WithDialOptions(dcerpc.WithDialer(d), dcerpc.WithInsecure()),
+			}
+
+			if tc.check {
+				opts = append(opts, WithHealthCheck(InquireInterfaceIDs(dcerpc.WithInsecure()), 20*time.Millisecond))
+			}
+
+			p, err := Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", opts...)
+			if err != nil {
+				t.Fatalf("dial: %v", err)
+			}
+			defer p.Close(ctx)
+
+			cli, err := mgmt.NewManagementClient(ctx, p, dcerpc.WithInsecure())
+			if err != nil {
+				t.Fatalf("bind: %v", err)
+			}
+
+			if _, err := cli.InquireInterfaceIDs(ctx, &mgmt.InquireInterfaceIDsRequest{}); err != nil {
+				t.Fatalf("invoke: %v", err)
+			}
+
+			dials := d.dials()
+
+			d.drop()
+
+			if tc.check {
+				// wait until the health check re-establishes the associations.
+				for d.dials() < dials+2 || p.Healthy() != 2 {
+					if ctx.Err() != nil {
+						t.Fatalf("health check: associations were not re-established")
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+			}
+
+			var invalidated *InvalidatedError
+
+			_, err = cli.InquireInterfaceIDs(ctx, &mgmt.InquireInterfaceIDsRequest{})
+			if !errors.As(err, &invalidated) {
+				t.Fatalf("invoke: expected invalidated error, got %v", err)
+			}
+
+			if tc.check != (invalidated.Err == nil) {
+				t.Errorf("invoke: unexpected invalidation cause: %v", invalidated.Err)
+			}
+
+			// the client is bound to the new association.
+			if _, err := cli.InquireInterfaceIDs(ctx, &mgmt.InquireInterfaceIDsRequest{}); err != nil {
+				t.Fatalf("invoke: %v", err)
+			}
+		})
+	}
+}
+
+func TestPoolHealthCheckError(t *testing.T) {
+
+	srv := dcerpc.NewServer()
+	defer srv.Close()
+
+	srv.RegisterServer(mgmt.NewManagementServerHandle(testServer{}), dcerpc.WithAbstractSyntax(mgmt.ManagementSyntaxV1_0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		probes int
+	)
+
+	// the probe that is rejected by the server.
+	check := func(ctx context.Context, cc dcerpc.Conn) (Probe, error) {
+		probe := func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			probes++
+			return errors.New("access denied")
+		}
+		return probe, probe(ctx)
+	}
+
+	p, err := Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]",
+		WithHealthCheck(check, 10*time.Millisecond),
+		WithDialOptions(dcerpc.WithDialer(&testDialer{srv: srv}), dcerpc.WithInsecure()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer p.Close(ctx)
+
+	for {
+		mu.Lock()
+		n := probes
+		mu.Unlock()
+		if n >= 5 {
+			break
+		}
+		if ctx.Err() != nil {
+			t.Fatalf("health check: probe was not called")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, gen, ok := p.members[0].current(); !ok || gen != 1 {
+		t.Fatalf("health check: association was re-established: generation %d", gen)
+	}
+}
+
+func TestPoolFailAfterReconnect(t *testing.T) {
+
+	srv := dcerpc.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	p, err := Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]",
+		WithBackoff(time.Hour, time.Hour),
+		WithDialOptions(dcerpc.WithDialer(&testDialer{srv: srv}), dcerpc.WithInsecure()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer p.Close(ctx)
+
+	m := p.members[0]
+
+	// the association is re-established, but the reconnection goroutine
+	// has not returned yet.
+	m.mu.Lock()
+	m.conn, m.err, m.reconnecting, m.ready = nil, errors.New("dropped"), true, make(chan struct{})
+	m.mu.Unlock()
+
+	if err := m.connect(ctx); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	_, gen, _ := m.current()
+
+	m.fail(gen, errors.New("dropped"))
+
+	if _, _, ok := m.current(); ok {
+		t.Fatalf("fail: the failure of the new association was dropped")
+	}
+}
+
+func TestClientWaitForReconnect(t *testing.T) {
+
+	srv := dcerpc.NewServer()
+	defer srv.Close()
+
+	srv.RegisterServer(mgmt.NewManagementServerHandle(testServer{}), dcerpc.WithAbstractSyntax(mgmt.ManagementSyntaxV1_0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	p, err := Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]",
+		WithBackoff(time.Hour, time.Hour),
+		WithDialOptions(dcerpc.WithDialer(&testDialer{srv: srv}), dcerpc.WithInsecure()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer p.Close(ctx)
+
+	cc, err := p.Bind(ctx, dcerpc.WithInsecure(), dcerpc.WithAbstractSyntax(mgmt.ManagementSyntaxV1_0))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	_, gen, _ := p.members[0].current()
+	p.members[0].fail(gen, errors.New("dropped"))
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer waitCancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cc.(*Client).conn(waitCtx)
+	}()
+
+	// the client is waiting for the reconnection.
+	time.Sleep(50 * time.Millisecond)
+
+	ready := make(chan struct{})
+	go func() {
+		defer close(ready)
+		cc.Context()
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(time.Second):
+		t.Fatalf("context: blocked by the call waiting for the reconnection")
+	}
+
+	waitCancel()
+	<-done
+}
+
+func TestPoolHealthCheckTimeout(t *testing.T) {
+
+	srv := dcerpc.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		checks int
+	)
+
+	// the first check fails to create the probe, the probe of the first
+	// association hangs.
+	check := func(ctx context.Context, cc dcerpc.Conn) (Probe, error) {
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if checks++; checks == 1 {
+			return nil, errors.New("access denied")
+		}
+
+		return func(ctx context.Context) error {
+			mu.Lock()
+			hung := checks == 2
+			mu.Unlock()
+			if hung {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return nil
+		}, nil
+	}
+
+	p, err := Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]",
+		WithHealthCheck(check, 20*time.Millisecond),
+		WithBackoff(10*time.Millisecond, 50*time.Millisecond),
+		WithDialOptions(dcerpc.WithDialer(&testDialer{srv: srv}), dcerpc.WithInsecure()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer p.Close(ctx)
+
+	// the probe is created by the next health check and the association
+	// of the hung probe is re-established.
+	for {
+		if _, gen, ok := p.members[0].current(); ok && gen > 1 {
+			break
+		}
+		if ctx.Err() != nil {
+			t.Fatalf("health check: hung association was not re-established")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if checks != 3 {
+		t.Errorf("health check: unexpected number of checks %d", checks)
+	}
+}
@@ -0,0 +1,241 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	dcerpc_errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// Player is the dialer that serves the recorded responses.
+type Player struct {
+	srv *dcerpc.Server
+
+	mu sync.Mutex
+	// The exchanges that were not yet replayed by the interface and
+	// operation number.
+	queue map[string][]*Exchange
+	// The recorded security contexts.
+	contexts []*SecurityContext
+	// The index of the next security context to establish.
+	nextContext int
+}
+
+// NewPlayer function returns the new player for the fixture. The options
+// are passed to the dcerpc.NewServer function, the server accepts the
+// recorded security contexts (see Mechanism), so the options must not
+// contain the mechanisms of the recorded types.
+func NewPlayer(f *Fixture, opts ...dcerpc.Option) *Player {
+
+	p := &Player{
+		queue:    make(map[string][]*Exchange),
+		contexts: f.SecurityContexts,
+	}
+
+	var mechTypes []gssapi.OID
+
+	for _, sc := range f.SecurityContexts {
+		if slices.ContainsFunc(mechTypes, sc.MechanismType.Equal) {
+			continue
+		}
+		mechTypes = append(mechTypes, sc.MechanismType)
+		opts = append(opts, dcerpc.WithMechanism(&mechanismFactory{p: p, mechType: sc.MechanismType}))
+	}
+
+	p.srv = dcerpc.NewServer(opts...)
+
+	var (
+		syntaxes []*dcerpc.SyntaxID
+		// the recorded transfer syntaxes by abstract syntax.
+		transferSyntaxes = make(map[string][]dcerpc.Option)
+	)
+
+	for _, ex := range f.Exchanges {
+
+		if ex.AbstractSyntax == nil || ex.AbstractSyntax.IfUUID == nil {
+			continue
+		}
+
+		k := key(ex.AbstractSyntax, ex.OpNum)
+		p.queue[k] = append(p.queue[k], ex)
+
+		syntax := syntaxKey(ex.AbstractSyntax)
+		if _, ok := transferSyntaxes[syntax]; !ok {
+			syntaxes, transferSyntaxes[syntax] = append(syntaxes, ex.AbstractSyntax), []dcerpc.Option{}
+		}
+
+		switch {
+		case ex.TransferSyntax == nil:
+		case ex.TransferSyntax.Is(dcerpc.TransferNDR64SyntaxV1_0):
+			transferSyntaxes[syntax] = append(transferSyntaxes[syntax], dcerpc.WithNDR64())
+		default:
+			transferSyntaxes[syntax] = append(transferSyntaxes[syntax], dcerpc.WithNDR20())
+		}
+	}
+
+	// the session that negotiates the transfer syntax other than the
+	// recorded one is rejected at bind, since the recorded stub data
+	// cannot be decoded.
+	for _, syntax := range syntaxes {
+		opts := append(transferSyntaxes[syntaxKey(syntax)], dcerpc.WithAbstractSyntax(syntax))
+		p.srv.RegisterServer(p.handle(syntax), opts...)
+	}
+
+	return p
+}
+
+// DialContext function implements the dcerpc.Dialer interface.
+func (p *Player) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+
+	if !isTCP(network) {
+		return nil, fmt.Errorf("replay: play: %s: %w", network, ErrUnsupportedNetwork)
+	}
+
+	return p.srv.DialContext(ctx, network, address)
+}
+
+// DialPipe function implements the dcerpc.PipeDialer interface. The named
+// pipe is served by the player without the SMB2 session.
+func (p *Player) DialPipe(ctx context.Context, name string, _ func(context.Context) (dcerpc.RawConn, error)) (dcerpc.RawConn, error) {
+	return p.srv.DialContext(ctx, "pipe", name)
+}
+
+// Mechanism function returns the mechanism factory that replaces the
+// mechanism `mech` on the client side. The mechanism establishes the next
+// recorded security context of the mechanism type without the token
+// exchange (and so without the credentials and the KDC), and uses the
+// recorded keys to sign and seal the messages:
+//
+//	cli, err := dcerpc.Dial(ctx, addr,
+//		dcerpc.WithDialer(p),
+//		dcerpc.WithSeal(),
+//		dcerpc.WithMechanism(p.Mechanism(ssp.KRB5)))
+func (p *Player) Mechanism(mech gssapi.MechanismFactory) gssapi.MechanismFactory {
+	return &mechanismFactory{p: p, mechType: mech.Type()}
+}
+
+// nextSecurityContext function returns the index of the next recorded
+// security context.
+func (p *Player) nextSecurityContext() int {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	i := p.nextContext
+	p.nextContext++
+
+	return i
+}
+
+// securityContext function returns the recorded security context `i`.
+func (p *Player) securityContext(i int) (*SecurityContext, bool) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if i < 0 || i >= len(p.contexts) {
+		return nil, false
+	}
+
+	return p.contexts[i], true
+}
+
+// Remaining function returns the number of the recorded calls that were not
+// replayed.
+func (p *Player) Remaining() int {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := 0
+	for _, q := range p.queue {
+		n += len(q)
+	}
+
+	return n
+}
+
+// Close function closes the player connections.
+func (p *Player) Close() error {
+	return p.srv.Close()
+}
+
+// next function returns the next recorded call for the interface and
+// operation number.
+func (p *Player) next(syntax *dcerpc.SyntaxID, opNum int) (*Exchange, bool) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	k := key(syntax, opNum)
+
+	q := p.queue[k]
+	if len(q) == 0 {
+		return nil, false
+	}
+
+	p.queue[k] = q[1:]
+
+	return q[0], true
+}
+
+// handle function returns the server handle that replays the calls for the
+// interface.
+func (p *Player) handle(syntax *dcerpc.SyntaxID) dcerpc.ServerHandle {
+	return func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+
+		ex, ok := p.next(syntax, opNum)
+		if !ok {
+			return nil, fmt.Errorf("replay: no recorded call for %s opnum %d", syntaxKey(syntax), opNum)
+		}
+
+		if ex.FaultStatus != 0 {
+			return nil, &dcerpc_errors.RPCError{Code: ex.FaultStatus}
+		}
+
+		return &recordedOperation{ex}, nil
+	}
+}
+
+// syntaxKey function returns the interface key.
+func syntaxKey(syntax *dcerpc.SyntaxID) string {
+	return fmt.Sprintf("%s v%d.%d", syntax.IfUUID, syntax.IfVersionMajor, syntax.IfVersionMinor)
+}
+
+// key function returns the queue key for the interface and operation
+// number.
+func key(syntax *dcerpc.SyntaxID, opNum int) string {
+	return fmt.Sprintf("%s/%d", syntaxKey(syntax), opNum)
+}
+
+// recordedOperation is the operation that writes the recorded response
+// stub data.
+type recordedOperation struct {
+	ex *Exchange
+}
+
+func (o *recordedOperation) OpNum() int     { return o.ex.OpNum }
+func (o *recordedOperation) OpName() string { return "" }
+
+func (o *recordedOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	_, err := w.Write(o.ex.Response)
+	return err
+}
+
+func (o *recordedOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	return dcerpc.ErrNotImplemented
+}
+
+func (o *recordedOperation) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	return dcerpc.ErrNotImplemented
+}
+
+func (o *recordedOperation) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	return dcerpc.ErrNotImplemented
+}
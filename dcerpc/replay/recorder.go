@@ -0,0 +1,294 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// Recorder is the dialer that records the calls performed over the dialed
+// connections.
+type Recorder struct {
+	// The underlying dialer (default is net.Dialer).
+	Dialer dcerpc.Dialer
+
+	mu      sync.Mutex
+	fixture Fixture
+}
+
+// NewRecorder function returns the new recorder that dials the connections
+// with the dialer `d` (if nil, net.Dialer is used).
+func NewRecorder(d dcerpc.Dialer) *Recorder {
+	return &Recorder{Dialer: d}
+}
+
+// DialContext function implements the dcerpc.Dialer interface.
+func (r *Recorder) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+
+	if !isTCP(network) {
+		return nil, fmt.Errorf("replay: record: %s: %w", network, ErrUnsupportedNetwork)
+	}
+
+	d := r.Dialer
+	if d == nil {
+		d = &net.Dialer{}
+	}
+
+	cc, err := d.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordConn{Conn: cc, session: r.newSession()}, nil
+}
+
+// DialPipe function implements the dcerpc.PipeDialer interface. The SMB2
+// session of the named pipe is not recorded. If the underlying dialer
+// implements the dcerpc.PipeDialer interface, the named pipe is opened
+// with it.
+func (r *Recorder) DialPipe(ctx context.Context, name string, dial func(context.Context) (dcerpc.RawConn, error)) (dcerpc.RawConn, error) {
+
+	var (
+		cc  dcerpc.RawConn
+		err error
+	)
+
+	if d, ok := r.Dialer.(dcerpc.PipeDialer); ok {
+		cc, err = d.DialPipe(ctx, name, dial)
+	} else {
+		cc, err = dial(ctx)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordPipe{ReadWriteCloser: cc, session: r.newSession()}, nil
+}
+
+// Fixture function returns the fixture with the calls completed so far.
+func (r *Recorder) Fixture() *Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Fixture{
+		Exchanges:        append([]*Exchange(nil), r.fixture.Exchanges...),
+		SecurityContexts: append([]*SecurityContext(nil), r.fixture.SecurityContexts...),
+	}
+}
+
+func (r *Recorder) add(ex *Exchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fixture.Exchanges = append(r.fixture.Exchanges, ex)
+}
+
+func (r *Recorder) addSecurityContext(sc *SecurityContext) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fixture.SecurityContexts = append(r.fixture.SecurityContexts, sc)
+}
+
+// newSession function returns the new recorded session.
+func (r *Recorder) newSession() *session {
+	return &session{
+		rec:      r,
+		contexts: make(map[uint16]*presentation),
+		pending:  make(map[uint32][]*dcerpc.Context),
+		calls:    make(map[uint32]*Exchange),
+		security: make(map[uint32]bool),
+	}
+}
+
+// presentation is the negotiated presentation context.
+type presentation struct {
+	abstractSyntax *dcerpc.SyntaxID
+	transferSyntax *dcerpc.SyntaxID
+}
+
+// recordConn is the recorded TCP connection.
+type recordConn struct {
+	net.Conn
+	*session
+}
+
+// recordPipe is the recorded named pipe.
+type recordPipe struct {
+	io.ReadWriteCloser
+	*session
+}
+
+// Unwrap function returns the named pipe connection.
+func (c *recordPipe) Unwrap() io.ReadWriteCloser {
+	return c.ReadWriteCloser
+}
+
+// session is the recorded session. It implements the
+// dcerpc.FragmentRecorder interface to observe the plaintext fragments and
+// the dcerpc.SecurityRecorder interface to export the security contexts.
+type session struct {
+	rec *Recorder
+
+	mu sync.Mutex
+	// The negotiated presentation contexts.
+	contexts map[uint16]*presentation
+	// The proposed presentation contexts by call identifier.
+	pending map[uint32][]*dcerpc.Context
+	// The calls in progress.
+	calls map[uint32]*Exchange
+	// The recorded security contexts by identifier.
+	security map[uint32]bool
+}
+
+// RecordSecurity function implements the dcerpc.SecurityRecorder interface.
+func (c *session) RecordSecurity(ctx context.Context, sec *dcerpc.Security) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.security[sec.ID()] {
+		return
+	}
+
+	c.security[sec.ID()] = true
+
+	mechType := mechanismType(sec.Type)
+	if mechType == nil {
+		return
+	}
+
+	// the security context is exported once it is established, before the
+	// first call is sealed.
+	b, err := sec.ExportSecurityContext()
+	if err != nil {
+		return
+	}
+
+	sc := &SecurityContext{
+		MechanismType: mechType,
+		AuthType:      sec.Type,
+		AuthLevel:     sec.Level,
+		Context:       b,
+	}
+
+	if key, ok := sec.Attribute(gssapi.AttributeSMBEffectiveSessionKey); ok {
+		sc.EffectiveSessionKey, _ = key.([]byte)
+	}
+
+	c.rec.addSecurityContext(sc)
+}
+
+// RecordFragment function implements the dcerpc.FragmentRecorder interface.
+func (c *session) RecordFragment(ctx context.Context, out bool, b []byte) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var hdr dcerpc.Header
+
+	r := ndr.NDR20(b, ndr.DefaultDataRepresentation)
+	if err := hdr.ReadFrom(ctx, r); err != nil {
+		return
+	}
+
+	switch hdr.PacketType {
+	case dcerpc.PacketTypeBind:
+		pdu := &dcerpc.Bind{}
+		if pdu.ReadFrom(ctx, r) == nil {
+			c.pending[hdr.CallID] = pdu.ContextList
+		}
+	case dcerpc.PacketTypeAlterContext:
+		pdu := &dcerpc.AlterContext{}
+		if pdu.ReadFrom(ctx, r) == nil {
+			c.pending[hdr.CallID] = pdu.ContextList
+		}
+	case dcerpc.PacketTypeBindAck:
+		pdu := &dcerpc.BindAck{}
+		if pdu.ReadFrom(ctx, r) == nil {
+			c.accept(hdr.CallID, pdu.ResultList)
+		}
+	case dcerpc.PacketTypeAlterContextResponse:
+		pdu := &dcerpc.AlterContextResponse{}
+		if pdu.ReadFrom(ctx, r) == nil {
+			c.accept(hdr.CallID, pdu.ResultList)
+		}
+	case dcerpc.PacketTypeRequest:
+		pdu := &dcerpc.Request{}
+		if hdr.PacketFlags.IsSet(dcerpc.PacketFlagObjectUUID) {
+			pdu.ObjectUUID = &uuid.UUID{}
+		}
+		if pdu.ReadFrom(ctx, r) != nil {
+			return
+		}
+		ex, ok := c.calls[hdr.CallID]
+		if !ok {
+			ex = &Exchange{OpNum: int(pdu.OpNum), ObjectUUID: pdu.ObjectUUID}
+			if p, ok := c.contexts[pdu.ContextID]; ok {
+				ex.AbstractSyntax, ex.TransferSyntax = p.abstractSyntax, p.transferSyntax
+			}
+			c.calls[hdr.CallID] = ex
+		}
+		ex.Request = append(ex.Request, stubData(hdr, b, r.Offset())...)
+	case dcerpc.PacketTypeResponse:
+		pdu := &dcerpc.Response{}
+		ex, ok := c.calls[hdr.CallID]
+		if !ok || pdu.ReadFrom(ctx, r) != nil {
+			return
+		}
+		if ex.Response = append(ex.Response, stubData(hdr, b, r.Offset())...); hdr.PacketFlags.IsSet(dcerpc.PacketFlagLastFrag) {
+			delete(c.calls, hdr.CallID)
+			c.rec.add(ex)
+		}
+	case dcerpc.PacketTypeFault:
+		pdu := &dcerpc.Fault{}
+		ex, ok := c.calls[hdr.CallID]
+		if !ok || pdu.ReadFrom(ctx, r) != nil {
+			return
+		}
+		ex.Response, ex.FaultStatus = nil, pdu.Status
+		delete(c.calls, hdr.CallID)
+		c.rec.add(ex)
+	}
+}
+
+// accept function saves the accepted presentation contexts.
+func (c *session) accept(callID uint32, results []*dcerpc.Result) {
+
+	proposed := c.pending[callID]
+	delete(c.pending, callID)
+
+	for i := 0; i < len(proposed) && i < len(results); i++ {
+		if results[i].DefResult == dcerpc.Acceptance {
+			c.contexts[proposed[i].ContextID] = &presentation{
+				abstractSyntax: proposed[i].AbstractSyntax,
+				transferSyntax: results[i].TransferSyntax,
+			}
+		}
+	}
+}
+
+// stubData function returns the copy of the fragment stub data that
+// starts at offset `start` (the security trailer and the padding are
+// excluded).
+func stubData(hdr dcerpc.Header, b []byte, start int) []byte {
+
+	end := int(hdr.FragLength)
+	if hdr.AuthLength > 0 {
+		// auth_pad_length is the third byte of the security trailer.
+		if end -= int(hdr.AuthLength) + dcerpc.SecurityTrailerSize; end > start && end+2 < len(b) {
+			end -= int(b[end+2])
+		}
+	}
+
+	if end < start {
+		return nil
+	}
+
+	return append([]byte(nil), b[start:end]...)
+}
@@ -0,0 +1,171 @@
+// Package replay implements the record and replay transport for the
+// deterministic RPC tests.
+//
+// The Recorder is the dialer that records the plaintext (decrypted) request
+// and response stub data of each call to the fixture:
+//
+//	rec := replay.NewRecorder(nil)
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:dc01.contoso.net[49667]", dcerpc.WithDialer(rec))
+//	// ... perform the calls.
+//
+//	if err := rec.Fixture().WriteFile("testdata/session.json"); err != nil {
+//		// handle error.
+//	}
+//
+// The Player is the dialer that serves the recorded responses to the same
+// sequence of calls without network access:
+//
+//	f, err := replay.ReadFile("testdata/session.json")
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	p := replay.NewPlayer(f)
+//	defer p.Close()
+//
+//	conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:dc01.contoso.net[49667]", dcerpc.WithDialer(p))
+//
+// The calls are matched by the abstract syntax and operation number in the
+// recorded order, the call identifiers, and the request stub data (that may
+// contain nonces and timestamps) are not compared. The player is the real
+// in-process DCE/RPC server (see dcerpc.NewServer), so the bind is
+// performed again.
+//
+// The recorder exports the keys and the sequence numbers of each
+// established security context (see gssapi.ExportSecurityContext) to the
+// fixture. To replay the signed or sealed session, the client binds with
+// the player mechanism instead of the recorded one, the player mechanism
+// establishes the recorded security contexts in the recorded order without
+// the token exchange, so neither the credentials, nor the KDC, nor the
+// server-side credential database is required:
+//
+//	cli, err := winreg.NewWinregClient(ctx, conn,
+//		dcerpc.WithMechanism(p.Mechanism(ssp.KRB5)),
+//		dcerpc.WithSeal())
+//
+// The recorded responses are sealed with the recorded keys, the fixture
+// contains the session keys of the recorded sessions (but not the
+// long-term keys) and must be protected as the session capture.
+//
+// The limitations are:
+//
+//   - Only ncacn_ip_tcp and ncacn_np connections can be recorded and
+//     replayed, the other networks are rejected with ErrUnsupportedNetwork.
+//     The SMB2 session of the named pipe is not recorded, the SMB2 session
+//     key that is used by some interfaces to encrypt the secrets is
+//     recorded only for the authenticated security contexts.
+//
+//   - The Netlogon secure channel contexts cannot be exported, and such
+//     sessions cannot be replayed.
+//
+//   - The client must negotiate the recorded transfer syntax (NDR20 or
+//     NDR64), otherwise the bind is rejected.
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// ErrUnsupportedNetwork is returned when the connection other than
+// ncacn_ip_tcp or ncacn_np is recorded or replayed.
+var ErrUnsupportedNetwork = errors.New("only ncacn_ip_tcp and ncacn_np connections are supported")
+
+// isTCP function returns true if the network is the TCP network.
+func isTCP(network string) bool {
+	return network == "tcp" || network == "tcp4" || network == "tcp6"
+}
+
+// mechanismType function returns the security mechanism type for the
+// authentication type or nil if the security context of the mechanism
+// cannot be recorded.
+func mechanismType(authType dcerpc.AuthType) gssapi.OID {
+	switch authType {
+	case dcerpc.AuthTypeWinNT:
+		return ssp.MechanismTypeNTLM
+	case dcerpc.AuthTypeKerberos:
+		return ssp.MechanismTypeKRB5
+	case dcerpc.AuthTypeGSSNegotiate:
+		return ssp.MechanismTypeSPNEGO
+	}
+	return nil
+}
+
+// Fixture is the recorded session.
+type Fixture struct {
+	// The recorded calls in the order of completion.
+	Exchanges []*Exchange `json:"exchanges"`
+	// The recorded security contexts in the order of establishment.
+	SecurityContexts []*SecurityContext `json:"security_contexts,omitempty"`
+}
+
+// SecurityContext is the recorded security context.
+type SecurityContext struct {
+	// The security mechanism type.
+	MechanismType gssapi.OID `json:"mechanism_type"`
+	// The authentication type.
+	AuthType dcerpc.AuthType `json:"auth_type"`
+	// The authentication level.
+	AuthLevel dcerpc.AuthLevel `json:"auth_level"`
+	// The exported security context (see gssapi.ExportSecurityContext).
+	Context []byte `json:"context"`
+	// The SMB2 effective session key (for the named pipes).
+	EffectiveSessionKey []byte `json:"effective_session_key,omitempty"`
+}
+
+// Exchange is the single recorded call.
+type Exchange struct {
+	// The interface abstract syntax.
+	AbstractSyntax *dcerpc.SyntaxID `json:"abstract_syntax"`
+	// The negotiated transfer syntax (NDR20 or NDR64).
+	TransferSyntax *dcerpc.SyntaxID `json:"transfer_syntax"`
+	// The operation number.
+	OpNum int `json:"opnum"`
+	// The object UUID (if any).
+	ObjectUUID *uuid.UUID `json:"object_uuid,omitempty"`
+	// The plaintext request stub data.
+	Request []byte `json:"request,omitempty"`
+	// The plaintext response stub data.
+	Response []byte `json:"response,omitempty"`
+	// The fault status (if the call has failed).
+	FaultStatus uint32 `json:"fault_status,omitempty"`
+}
+
+// ReadFile function reads the fixture from the JSON file.
+func ReadFile(name string) (*Fixture, error) {
+
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("replay: read fixture: %w", err)
+	}
+
+	f := &Fixture{}
+	if err := json.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("replay: read fixture: %w", err)
+	}
+
+	return f, nil
+}
+
+// WriteFile function writes the fixture to the JSON file.
+func (f *Fixture) WriteFile(name string) error {
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("replay: write fixture: %w", err)
+	}
+
+	if err := os.WriteFile(name, b, 0o644); err != nil {
+		return fmt.Errorf("replay: write fixture: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	dcerpc_errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+	"github.com/oiweiwei/go-msrpc/ssp/ntlm"
+)
+
+var testSyntax = &dcerpc.SyntaxID{IfUUID: uuid.MustParse("0e1f8d2c-4b6a-4f0e-8a53-7d1c2b3e4f50"), IfVersionMajor: 1}
+
+// testOperation echoes the data with the call counter appended.
+type testOperation struct {
+	Data []byte
+}
+
+func (*testOperation) OpNum() int     { return 0 }
+func (*testOperation) OpName() string { return "/test/v1/Echo" }
+
+func (o *testOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	if err := w.WriteData(uint32(len(o.Data))); err != nil {
+		return err
+	}
+	_, err := w.Write(o.Data)
+	return err
+}
+
+func (o *testOperation) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	var sz uint32
+	if err := r.ReadData(&sz); err != nil {
+		return err
+	}
+	o.Data = make([]byte, sz)
+	_, err := io.ReadFull(r, o.Data)
+	return err
+}
+
+func (o *testOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return o.MarshalNDRRequest(ctx, w)
+}
+
+func (o *testOperation) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	return o.UnmarshalNDRRequest(ctx, r)
+}
+
+type testFailedOperation struct{ testOperation }
+
+func (*testFailedOperation) OpNum() int { return 1 }
+
+// pipeServer opens the named pipes on the in-process server.
+type pipeServer struct {
+	*dcerpc.Server
+}
+
+func (s pipeServer) DialPipe(ctx context.Context, name string, _ func(context.Context) (dcerpc.RawConn, error)) (dcerpc.RawConn, error) {
+	return s.DialContext(ctx, "pipe", name)
+}
+
+func TestRecordReplay(t *testing.T) {
+
+	cred := credential.NewFromPassword("Domain\\User", "Password")
+
+	db := credential.NewLocalDatabase()
+	db.Add(cred)
+
+	cfg := ntlm.NewConfig()
+	cfg.NetBIOSComputerName, cfg.NetBIOSDomainName = "Server", "Domain"
+
+	serverOpts := []dcerpc.Option{
+		dcerpc.WithFragmentSize(dcerpc.MinimumXmitSize),
+		dcerpc.WithMechanism(ssp.NTLM, cfg),
+		dcerpc.WithCredentialDatabase(gssapi.NewCredentialDatabase(db)),
+	}
+
+	bindOpts := []dcerpc.Option{
+		dcerpc.WithSeal(),
+		dcerpc.WithMechanism(ssp.NTLM),
+		dcerpc.WithCredentials(cred),
+		dcerpc.WithAbstractSyntax(testSyntax),
+	}
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 512)
+
+	// the server response depends on the number of calls.
+	calls := 0
+
+	srv := dcerpc.NewServer(serverOpts...)
+	defer srv.Close()
+
+	srv.RegisterServer(func(ctx context.Context, opNum int, r ndr.Reader) (dcerpc.Operation, error) {
+		if opNum != 0 {
+			return nil, &dcerpc_errors.RPCError{Code: 0x00000005}
+		}
+		op := &testOperation{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		calls++
+		op.Data = append(op.Data, byte(calls))
+		return op, nil
+	}, dcerpc.WithAbstractSyntax(testSyntax))
+
+	session := func(d dcerpc.Dialer, addr string, bindOpts ...dcerpc.Option) [][]byte {
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		conn, err := dcerpc.Dial(ctx, addr, dcerpc.WithDialer(d), dcerpc.WithFragmentSize(dcerpc.MinimumXmitSize))
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		defer conn.Close(ctx)
+
+		cc, err := conn.Bind(ctx, bindOpts...)
+		if err != nil {
+			t.Fatalf("bind: %v", err)
+		}
+
+		var resp [][]byte
+		for i := 0; i < 2; i++ {
+			op := &testOperation{Data: data}
+			if err := cc.Invoke(ctx, op); err != nil {
+				t.Fatalf("invoke: %v", err)
+			}
+			resp = append(resp, op.Data)
+		}
+
+		if err := cc.Invoke(ctx, &testFailedOperation{}); err == nil {
+			t.Fatalf("invoke: expected fault")
+		}
+
+		return resp
+	}
+
+	for _, tc := range []struct {
+		name string
+		addr string
+	}{
+		{"ncacn_ip_tcp", "ncacn_ip_tcp:127.0.0.1[135]"},
+		{"ncacn_np", `ncacn_np:127.0.0.1[\pipe\test]`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+
+			calls = 0
+
+			rec := NewRecorder(pipeServer{srv})
+
+			recorded := session(rec, tc.addr, bindOpts...)
+
+			name := filepath.Join(t.TempDir(), "session.json")
+
+			if err := rec.Fixture().WriteFile(name); err != nil {
+				t.Fatalf("write fixture: %v", err)
+			}
+
+			f, err := ReadFile(name)
+			if err != nil {
+				t.Fatalf("read fixture: %v", err)
+			}
+
+			if len(f.Exchanges) != 3 || f.Exchanges[2].FaultStatus != 0x00000005 {
+				t.Fatalf("fixture: unexpected exchanges %d", len(f.Exchanges))
+			}
+
+			// the stub data is recorded decrypted.
+			if !bytes.Contains(f.Exchanges[0].Request, data) || !bytes.Contains(f.Exchanges[0].Response, data) {
+				t.Errorf("fixture: the stub data is not recorded in plaintext")
+			}
+
+			if len(f.SecurityContexts) != 1 || f.SecurityContexts[0].AuthLevel != dcerpc.AuthLevelPktPrivacy {
+				t.Fatalf("fixture: unexpected security contexts %d", len(f.SecurityContexts))
+			}
+
+			// the kerberos session with the same calls.
+			krb5 := *f
+			krb5.SecurityContexts = []*SecurityContext{{
+				MechanismType: ssp.KRB5.Type(),
+				AuthType:      dcerpc.AuthTypeKerberos,
+				AuthLevel:     dcerpc.AuthLevelPktPrivacy,
+				Context:       []byte(`{"key_type":18,"key":"AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=","dce_style":true}`),
+			}}
+
+			for _, mc := range []struct {
+				name string
+				f    *Fixture
+				mech gssapi.MechanismFactory
+			}{
+				{"ntlm", f, ssp.NTLM},
+				{"krb5", &krb5, ssp.KRB5},
+			} {
+				// the player does not require the credentials, the KDC
+				// and the server credential database to re-seal the
+				// session.
+				p := NewPlayer(mc.f, dcerpc.WithFragmentSize(dcerpc.MinimumXmitSize))
+				defer p.Close()
+
+				replayed := session(p, tc.addr,
+					dcerpc.WithSeal(),
+					dcerpc.WithMechanism(p.Mechanism(mc.mech)),
+					dcerpc.WithAbstractSyntax(testSyntax))
+
+				for i := range recorded {
+					if !bytes.Equal(recorded[i], replayed[i]) {
+						t.Errorf("%s: replay: response %d mismatch", mc.name, i)
+					}
+				}
+
+				if calls != 2 {
+					t.Errorf("%s: replay: the server was called %d times", mc.name, calls)
+				}
+
+				if n := p.Remaining(); n != 0 {
+					t.Errorf("%s: replay: %d calls were not replayed", mc.name, n)
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			for _, tc := range []struct {
+				name string
+				// the bind options.
+				bindOpts []dcerpc.Option
+			}{
+				// the recorded security context is established without the
+				// token exchange.
+				{"token_exchange", bindOpts},
+				// the recorded stub data is NDR20.
+				{"transfer_syntax_mismatch", []dcerpc.Option{
+					dcerpc.WithNDR64(),
+					dcerpc.WithAbstractSyntax(testSyntax),
+				}},
+			} {
+				p := NewPlayer(f)
+				defer p.Close()
+
+				conn, err := dcerpc.Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", dcerpc.WithDialer(p))
+				if err != nil {
+					t.Fatalf("%s: dial: %v", tc.name, err)
+				}
+				defer conn.Close(ctx)
+
+				// the bind failure can be reported with the first call.
+				cc, err := conn.Bind(ctx, tc.bindOpts...)
+				if err == nil {
+					err = cc.Invoke(ctx, &testOperation{Data: data})
+				}
+
+				if err == nil {
+					t.Errorf("%s: expected bind error", tc.name)
+				}
+			}
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := NewRecorder(srv).DialContext(ctx, "udp", "127.0.0.1:135"); !errors.Is(err, ErrUnsupportedNetwork) {
+		t.Errorf("record: expected unsupported network error, got %v", err)
+	}
+}
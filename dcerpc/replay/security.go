@@ -0,0 +1,159 @@
+package replay
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// mechanismFactory is the factory of the mechanism that establishes the
+// recorded security contexts.
+type mechanismFactory struct {
+	p *Player
+	// The recorded mechanism type.
+	mechType gssapi.OID
+}
+
+// mechanismConfig is the configuration of the player mechanism.
+type mechanismConfig struct {
+	mechType gssapi.OID
+}
+
+func (c *mechanismConfig) Type() gssapi.OID             { return c.mechType }
+func (c *mechanismConfig) Copy() gssapi.MechanismConfig { return &mechanismConfig{c.mechType} }
+
+// Type function returns the recorded mechanism type, so that the bind
+// uses the recorded authentication type.
+func (f *mechanismFactory) Type() gssapi.OID {
+	return f.mechType
+}
+
+// DefaultConfig function returns the default config.
+func (f *mechanismFactory) DefaultConfig(ctx context.Context) (gssapi.MechanismConfig, error) {
+	return &mechanismConfig{f.mechType}, nil
+}
+
+// New function returns the new mechanism instance.
+func (f *mechanismFactory) New(ctx context.Context) (gssapi.Mechanism, error) {
+	return &mechanism{mechanismFactory: f}, nil
+}
+
+// mechanism is the mechanism that establishes the recorded security
+// context without the token exchange. The initiator sends the index of the
+// recorded security context as the token, the acceptor imports the same
+// security context and returns the index back.
+type mechanism struct {
+	*mechanismFactory
+	// The imported security context.
+	sc context.Context
+}
+
+// importSecurityContext function imports the recorded security context
+// `i` and returns the token with the security context index.
+func (m *mechanism) importSecurityContext(ctx context.Context, i int, isServer bool) ([]byte, error) {
+
+	sc, ok := m.p.securityContext(i)
+	if !ok {
+		return nil, fmt.Errorf("replay: no recorded security context %d", i)
+	}
+
+	if !sc.MechanismType.Equal(m.mechType) {
+		return nil, fmt.Errorf("replay: recorded security context %d mechanism %s, expected %s", i, sc.MechanismType, m.mechType)
+	}
+
+	m.sc = gssapi.NewSecurityContext(context.Background(), ssp.SPNEGO, ssp.KRB5, ssp.NTLM)
+
+	if err := gssapi.ImportSecurityContext(m.sc, sc.Context, isServer, gssapi.WithMechanismType(sc.MechanismType)); err != nil {
+		return nil, fmt.Errorf("replay: import security context %d: %w", i, err)
+	}
+
+	if key, ok := gssapi.GetAttribute(m.sc, gssapi.AttributeSessionKey); ok {
+		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, key)
+	}
+
+	if len(sc.EffectiveSessionKey) > 0 {
+		gssapi.SetAttribute(ctx, gssapi.AttributeSMBEffectiveSessionKey, sc.EffectiveSessionKey)
+	}
+
+	return binary.LittleEndian.AppendUint32(nil, uint32(i)), nil
+}
+
+// Init function establishes the next recorded security context.
+func (m *mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token, error) {
+
+	b, err := m.importSecurityContext(ctx, m.p.nextSecurityContext(), false)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
+}
+
+// Accept function establishes the recorded security context with the
+// index received from the initiator.
+func (m *mechanism) Accept(ctx context.Context, tok *gssapi.Token) (*gssapi.Token, error) {
+
+	if len(tok.Payload) != 4 {
+		return nil, gssapi.ContextError(ctx, gssapi.DefectiveToken, gssapi.ErrDefectiveToken)
+	}
+
+	b, err := m.importSecurityContext(ctx, int(binary.LittleEndian.Uint32(tok.Payload)), true)
+	if err != nil {
+		return nil, gssapi.ContextError(ctx, gssapi.Failure, err)
+	}
+
+	// the bind_ack must carry the authentication data.
+	return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
+}
+
+func (m *mechanism) Capabilities(ctx context.Context) gssapi.Cap {
+	return gssapi.FromContext(m.sc).Capabilities
+}
+
+func (m *mechanism) WrapSizeLimit(ctx context.Context, sz int, conf bool) int {
+	if conf {
+		return gssapi.WrapSizeLimit(m.sc, sz, gssapi.WithRequest(gssapi.Confidentiality))
+	}
+	return gssapi.WrapSizeLimit(m.sc, sz)
+}
+
+func (m *mechanism) Wrap(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+	return gssapi.Wrap(m.sc, tok)
+}
+
+func (m *mechanism) Unwrap(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+	return gssapi.Unwrap(m.sc, tok)
+}
+
+func (m *mechanism) MakeSignature(ctx context.Context, tok *gssapi.MessageToken) (*gssapi.MessageToken, error) {
+	return gssapi.MakeSignature(m.sc, tok)
+}
+
+func (m *mechanism) VerifySignature(ctx context.Context, tok *gssapi.MessageToken) error {
+	return gssapi.VerifySignature(m.sc, tok)
+}
+
+func (m *mechanism) WrapEx(ctx context.Context, tok *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+	return gssapi.WrapEx(m.sc, tok)
+}
+
+func (m *mechanism) UnwrapEx(ctx context.Context, tok *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+	return gssapi.UnwrapEx(m.sc, tok)
+}
+
+func (m *mechanism) MakeSignatureEx(ctx context.Context, tok *gssapi.MessageTokenEx) (*gssapi.MessageTokenEx, error) {
+	return gssapi.MakeSignatureEx(m.sc, tok)
+}
+
+func (m *mechanism) VerifySignatureEx(ctx context.Context, tok *gssapi.MessageTokenEx) error {
+	return gssapi.VerifySignatureEx(m.sc, tok)
+}
+
+var (
+	_ gssapi.MechanismFactory = (*mechanismFactory)(nil)
+	_ gssapi.Mechanism        = (*mechanism)(nil)
+	_ gssapi.MechanismEx      = (*mechanism)(nil)
+)
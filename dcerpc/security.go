@@ -255,6 +255,48 @@ func (cc *Security) SetAttribute(name string, value any) {
 	gssapi.SetAttribute(cc.ctx, name, value)
 }
 
+// Attribute function returns the attribute of the security context.
+func (cc *Security) Attribute(name string) (any, bool) {
+	return gssapi.GetAttribute(cc.ctx, name, cc.options()...)
+}
+
+// ExportSecurityContext function returns the token that contains the keys
+// and the sequence numbers of the established security context (see
+// gssapi.ExportSecurityContext).
+func (cc *Security) ExportSecurityContext() ([]byte, error) {
+
+	if !cc.Established() {
+		return nil, fmt.Errorf("export security context: %w", gssapi.ErrNoContext)
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	b, err := gssapi.ExportSecurityContext(cc.ctx, cc.options()...)
+	if err != nil {
+		return nil, fmt.Errorf("export security context: %w", err)
+	}
+
+	return b, nil
+}
+
+// ExportedKeys function returns the keys used by the established security
+// context (see gssapi.AttributeExportedKeys).
+func (cc *Security) ExportedKeys() []*gssapi.ExportedKey {
+
+	if !cc.Established() {
+		return nil
+	}
+
+	attr, ok := gssapi.GetAttribute(cc.ctx, gssapi.AttributeExportedKeys, cc.options()...)
+	if !ok {
+		return nil
+	}
+
+	keys, _ := attr.([]*gssapi.ExportedKey)
+	return keys
+}
+
 // Established function returns `true` if the security context was established
 // and can be used for Wrap/Unwrap functions.
 func (cc *Security) Established() bool {
@@ -300,6 +342,44 @@ func (cc *Security) Init(ctx context.Context, b []byte) ([]byte, error) {
 	return tok.Payload, nil
 }
 
+// NewServerSecurity function returns the new server security context for
+// the security trailer received from the client.
+func NewServerSecurity(ctx context.Context, trailer SecurityTrailer, opts ...gssapi.ContextOption) *Security {
+	return &Security{
+		id:            trailer.AuthContextID,
+		ctx:           gssapi.NewSecurityContext(ctx, opts...),
+		Impersonation: ImpersonationLevelImpersonate,
+		Type:          trailer.AuthType,
+		Level:         trailer.AuthLevel,
+	}
+}
+
+// Accept function accepts the security context token received from the
+// client and returns the token that must be sent back.
+func (cc *Security) Accept(ctx context.Context, b []byte) ([]byte, error) {
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if cc.established {
+		return []byte{}, nil
+	}
+
+	if cc.Level == AuthLevelNone || cc.Type == AuthTypeNone {
+		cc.established = true
+		return []byte{}, nil
+	}
+
+	tok, err := gssapi.AcceptSecurityContext(cc.ctx, &gssapi.Token{Payload: b}, cc.options()...)
+	if err != nil {
+		return nil, fmt.Errorf("accept security context: %w", err)
+	}
+
+	cc.established = gssapi.IsComplete(cc.ctx)
+
+	return tok.Payload, nil
+}
+
 // AuthLength function returns the expected length for the authentication
 // trailer.
 func (cc *Security) AuthLength(ctx context.Context, pkt *Packet) int {
@@ -331,9 +411,16 @@ func (cc *Security) authLength(ctx context.Context, pkt *Packet) int {
 }
 
 // CanWrap function returns true if security context can be applied to the
-// packet (context is established and packet is request or response).
+// packet (context is established and packet is request or response, or the
+// fault with the authentication verifier).
 func (cc *Security) CanWrap(ctx context.Context, pkt *Packet) bool {
-	return cc.Established() && (pkt.Header.PacketType == PacketTypeRequest || pkt.Header.PacketType == PacketTypeResponse)
+	switch pkt.Header.PacketType {
+	case PacketTypeRequest, PacketTypeResponse:
+		return cc.Established()
+	case PacketTypeFault:
+		return cc.Established() && pkt.Header.AuthLength > 0
+	}
+	return false
 }
 
 // Wrap function depending on the security level encrypts and computes the
@@ -0,0 +1,720 @@
+package dcerpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+
+	dcerpc_errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// Server is the connection-oriented DCE/RPC server that dispatches the
+// incoming calls to the server handles registered with RegisterServer.
+//
+// The server implements the Dialer interface and can be used as the
+// in-process transport for the generated clients, the client and the server
+// are connected with the in-memory pipe and perform the real bind, security
+// context establishment, fragmentation and NDR encoding:
+//
+//	srv := dcerpc.NewServer()
+//	srv.RegisterServer(winreg.NewWinregServerHandle(impl), dcerpc.WithAbstractSyntax(winreg.WinregSyntaxV1_0))
+//
+//	conn, err := srv.Dial(ctx)
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer conn.Close(ctx)
+//
+//	cli, err := winreg.NewWinregClient(ctx, conn, dcerpc.WithInsecure())
+//
+// To accept the signed or sealed connections, provide the security mechanism
+// (with the server names) and the credential database:
+//
+//	cfg := ntlm.NewConfig()
+//	cfg.NetBIOSComputerName, cfg.NetBIOSDomainName = "SERVER", "DOMAIN"
+//
+//	srv := dcerpc.NewServer(dcerpc.WithMechanism(ssp.NTLM, cfg), dcerpc.WithCredentialDatabase(gssapi.NewCredentialDatabase(db)))
+type Server struct {
+	mu sync.RWMutex
+	// The server handles by abstract syntax.
+	handles map[string]ServerHandle
+	// The accepted transfer syntaxes by abstract syntax (if restricted).
+	transferSyntaxes map[string][]*SyntaxID
+	// The transport settings.
+	settings Transport
+	// The security context options for the server security contexts.
+	securityOptions []gssapi.ContextOption
+	// The logger.
+	logger zerolog.Logger
+	// The association group identifier generator.
+	groupID atomic.Uint32
+	// The server context (cancelled when the server is closed).
+	ctx    context.Context
+	cancel func()
+	// The flag that indicates whether the server is closed (guarded by mu).
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewServer function returns the new server. The connect options (for
+// example WithFragmentSize), security context options (WithMechanism,
+// WithCredentialDatabase) and the logger option are accepted.
+func NewServer(opts ...Option) *Server {
+
+	s := &Server{
+		handles:          make(map[string]ServerHandle),
+		transferSyntaxes: make(map[string][]*SyntaxID),
+		settings:         NewTransport(),
+		logger:           zerolog.Nop(),
+	}
+
+	o := ParseSecurityOptions(context.Background(), opts...)
+
+	for i := range opts {
+		switch opt := opts[i].(type) {
+		case ConnectOption:
+			opt(&s.settings)
+		case BindOption:
+			opt(o)
+		}
+	}
+
+	s.securityOptions, s.logger = o.SecurityOptions, o.Logger
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	return s
+}
+
+// RegisterServer function registers the server handle for the abstract
+// syntax specified with WithAbstractSyntax option. The accepted transfer
+// syntaxes can be restricted with WithNDR20 and WithNDR64 options.
+func (s *Server) RegisterServer(h ServerHandle, opts ...Option) {
+
+	o := &option{}
+
+	for i := range opts {
+		if opt, ok := opts[i].(BindOption); ok {
+			opt(o)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, syntax := range o.AbstractSyntaxes {
+		s.handles[syntaxString(syntax)] = h
+		if len(o.TransferSyntaxes) > 0 {
+			s.transferSyntaxes[syntaxString(syntax)] = o.TransferSyntaxes
+		} else {
+			delete(s.transferSyntaxes, syntaxString(syntax))
+		}
+	}
+}
+
+// acceptTransferSyntax function returns true if the transfer syntax is
+// accepted for the abstract syntax.
+func (s *Server) acceptTransferSyntax(abstractSyntax, syntax *SyntaxID) bool {
+
+	if !syntax.Is(TransferNDRSyntaxV2_0) && !syntax.Is(TransferNDR64SyntaxV1_0) {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	accepted, ok := s.transferSyntaxes[syntaxString(abstractSyntax)]
+	if !ok {
+		return true
+	}
+
+	for _, tr := range accepted {
+		if syntax.Is(tr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handle function returns the server handle for the abstract syntax.
+func (s *Server) handle(syntax *SyntaxID) (ServerHandle, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	h, ok := s.handles[syntaxString(syntax)]
+	return h, ok
+}
+
+// DialContext function implements the Dialer interface, it returns the
+// client side of the in-memory connection and serves the server side.
+func (s *Server) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+
+	if !s.goAsync() {
+		return nil, fmt.Errorf("dcerpc: server: %w", ErrClosed)
+	}
+
+	client, server := net.Pipe()
+
+	go func() {
+		defer s.wg.Done()
+		if err := s.Serve(s.ctx, server); err != nil {
+			s.logger.Error().Err(err).Msg("serve connection")
+		}
+	}()
+
+	return client, nil
+}
+
+// Dial function returns the new connection to the in-process server. The
+// options are passed to the Dial function.
+func (s *Server) Dial(ctx context.Context, opts ...Option) (Conn, error) {
+	return Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", append([]Option{WithDialer(s)}, opts...)...)
+}
+
+// Close function closes all server connections.
+func (s *Server) Close() error {
+
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+// goAsync function registers the connection goroutine, it returns `false`
+// if the server is closed.
+func (s *Server) goAsync() bool {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	s.wg.Add(1)
+	return true
+}
+
+// Serve function serves the single connection until the connection is
+// closed by the client or the context is cancelled. The connection is
+// closed on return.
+func (s *Server) Serve(ctx context.Context, cc RawConn) error {
+
+	settings := s.settings
+
+	sc := &serverConn{
+		srv: s,
+		cc:  cc,
+		t: &transport{
+			settings: &settings,
+			logger:   s.logger,
+			tx:       make([]byte, settings.MaxXmitFrag),
+			rx:       make([]byte, settings.MaxRecvFrag),
+		},
+		contexts: make(map[uint16]*Presentation),
+		security: make(map[uint32]*Security),
+		calls:    make(map[uint32]*serverCall),
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		// unblock the reader.
+		<-ctx.Done()
+		cc.Close()
+	}()
+
+	defer sc.close()
+
+	for {
+		if err := sc.serve(ctx); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrClosedPipe) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("dcerpc: server: %w", err)
+		}
+	}
+}
+
+// serverConn is the server side of the single connection.
+type serverConn struct {
+	srv *Server
+	cc  RawConn
+	// The packet codec.
+	t *transport
+	// The presentation contexts by context identifier.
+	contexts map[uint16]*Presentation
+	// The security contexts by auth context identifier.
+	security map[uint32]*Security
+	// The last established security context (used for the requests
+	// without security trailer).
+	lastSecurity *Security
+	// The calls in progress (the request fragments are being received).
+	calls map[uint32]*serverCall
+	// The negotiated header signing.
+	signHeader bool
+}
+
+// serverCall is the call in progress.
+type serverCall struct {
+	op           *serverOperation
+	body         *Body
+	presentation *Presentation
+	security     *Security
+}
+
+// serverOperation adapts the server handle to the operation used by the
+// packet body: the request is unmarshaled (and the server handle is
+// invoked) with UnmarshalNDRResponse and the response is marshaled with
+// MarshalNDRRequest.
+type serverOperation struct {
+	opNum  int
+	handle ServerHandle
+	resp   Operation
+	err    error
+	// notExecuted is true if the operation is not implemented by the
+	// server handle.
+	notExecuted bool
+	// done is closed when the server handle returns.
+	done chan struct{}
+}
+
+func (o *serverOperation) OpNum() int     { return o.opNum }
+func (o *serverOperation) OpName() string { return "" }
+
+func (o *serverOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	return o.resp.MarshalNDRResponse(ctx, w)
+}
+
+func (o *serverOperation) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	return ErrNotImplemented
+}
+
+func (o *serverOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return ErrNotImplemented
+}
+
+func (o *serverOperation) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	defer close(o.done)
+	if o.resp, o.err = o.handle(ctx, o.opNum, r); o.err == nil && o.resp == nil {
+		o.err, o.notExecuted = dcerpc_errors.OperationRangeError, true
+	}
+	return nil
+}
+
+// isDone function returns true if the server handle has returned.
+func (o *serverOperation) isDone() bool {
+	select {
+	case <-o.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (sc *serverConn) close() {
+	for _, call := range sc.calls {
+		call.body.Close()
+	}
+	sc.cc.Close()
+}
+
+// readBuffer function reads the fragment into the receive buffer.
+func (sc *serverConn) readBuffer(ctx context.Context) (Header, error) {
+
+	var hdr Header
+
+	p := sc.t.rx
+
+	if _, err := io.ReadFull(sc.cc, p[:HeaderSize]); err != nil {
+		return hdr, err
+	}
+
+	if err := hdr.ReadFrom(ctx, sc.t.Codec(p[:HeaderSize], sc.t.settings.DataRepresentation)); err != nil {
+		return hdr, err
+	}
+
+	if int(hdr.FragLength) > len(p) || int(hdr.FragLength) < HeaderSize {
+		return hdr, ErrPacketTooLong
+	}
+
+	if _, err := io.ReadFull(sc.cc, p[HeaderSize:hdr.FragLength]); err != nil {
+		return hdr, err
+	}
+
+	return hdr, nil
+}
+
+// writePacket function encodes, wraps and writes the packet.
+func (sc *serverConn) writePacket(ctx context.Context, pkt *Packet, sec *Security) error {
+
+	if err := sc.t.EncodePacket(ctx, pkt, sc.t.tx); err != nil {
+		return fmt.Errorf("encode packet: %w", err)
+	}
+
+	if raw := newSecurityPacket(pkt.Header, sc.t.tx); sec.CanWrap(ctx, raw) {
+		if err := sec.Wrap(ctx, raw); err != nil {
+			return fmt.Errorf("wrap packet: %w", err)
+		}
+	}
+
+	sc.t.logger.Debug().EmbedObject(pkt.Header).EmbedObject(pkt.PDU).Msg("server: write packet")
+
+	if _, err := sc.cc.Write(sc.t.tx[:pkt.Header.FragLength]); err != nil {
+		return fmt.Errorf("write buffer: %w", err)
+	}
+
+	return nil
+}
+
+// peekSecurityTrailer function returns the security trailer of the fragment
+// in the receive buffer.
+func (sc *serverConn) peekSecurityTrailer(ctx context.Context, hdr Header) (SecurityTrailer, bool) {
+
+	var trailer SecurityTrailer
+
+	if hdr.AuthLength == 0 {
+		return trailer, false
+	}
+
+	off := int(hdr.FragLength) - int(hdr.AuthLength) - SecurityTrailerSize
+	if off < HeaderSize {
+		return trailer, false
+	}
+
+	if err := trailer.ReadFrom(ctx, sc.t.Codec(sc.t.rx[off:hdr.FragLength], hdr.PacketDRep)); err != nil {
+		return trailer, false
+	}
+
+	return trailer, true
+}
+
+// serve function reads and processes the single fragment.
+func (sc *serverConn) serve(ctx context.Context) error {
+
+	hdr, err := sc.readBuffer(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch hdr.PacketType {
+	case PacketTypeBind, PacketTypeAlterContext:
+		return sc.bind(ctx, hdr)
+	case PacketTypeAuth3:
+		return sc.auth3(ctx, hdr)
+	case PacketTypeRequest:
+		return sc.request(ctx, hdr)
+	case PacketTypeOrphaned, PacketTypeCancel:
+		return nil
+	}
+
+	return fmt.Errorf("unexpected packet type: %s", hdr.PacketType)
+}
+
+// accept function accepts the security context token from the bind,
+// alter-context or auth3 packet.
+func (sc *serverConn) accept(ctx context.Context, hdr Header, pkt *Packet) (*Security, []byte, error) {
+
+	trailer, ok := sc.peekSecurityTrailer(ctx, hdr)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	sec, ok := sc.security[trailer.AuthContextID]
+	if !ok {
+		sec = NewServerSecurity(ctx, trailer, sc.srv.securityOptions...)
+		sec.SignHeader = sc.signHeader
+		sc.security[trailer.AuthContextID] = sec
+	}
+
+	b, err := sec.Accept(ctx, pkt.AuthData)
+	if err != nil {
+		return sec, nil, err
+	}
+
+	if sec.Established() {
+		sc.lastSecurity = sec
+	}
+
+	return sec, b, nil
+}
+
+// bind function processes the bind and alter-context packets.
+func (sc *serverConn) bind(ctx context.Context, hdr Header) error {
+
+	pkt, err := sc.t.DecodePacket(ctx, &Packet{}, sc.t.rx)
+	if err != nil {
+		return fmt.Errorf("decode packet: %w", err)
+	}
+
+	var contextList []*Context
+
+	switch pdu := pkt.PDU.(type) {
+	case *Bind:
+		// negotiate the fragment sizes.
+		if sz := int(pdu.MaxRecvFrag); sz >= MinimumXmitSize && sz < sc.t.settings.MaxXmitFrag {
+			sc.t.settings.MaxXmitFrag = sz
+		}
+		if sz := int(pdu.MaxXmitFrag); sz >= MinimumXmitSize && sz < sc.t.settings.MaxRecvFrag {
+			sc.t.settings.MaxRecvFrag = sz
+		}
+		if sc.t.settings.GroupID = int(pdu.AssocGroupID); sc.t.settings.GroupID == 0 {
+			sc.t.settings.GroupID = int(sc.srv.groupID.Add(1))
+		}
+		sc.signHeader = hdr.PacketFlags.IsSet(PacketFlagSupportHeaderSign)
+		contextList = pdu.ContextList
+	case *AlterContext:
+		contextList = pdu.ContextList
+	}
+
+	results := make([]*Result, 0, len(contextList))
+	for _, c := range contextList {
+		results = append(results, sc.negotiate(c, hdr.PacketType == PacketTypeBind))
+	}
+
+	flags := PacketFlagFirstFrag | PacketFlagLastFrag
+	if hdr.PacketType == PacketTypeBind {
+		flags |= hdr.PacketFlags & (PacketFlagConcMPX | PacketFlagSupportHeaderSign)
+	}
+
+	resp := &Packet{Header: Header{PacketFlags: flags, CallID: hdr.CallID}}
+
+	sec, token, err := sc.accept(ctx, hdr, pkt)
+	if err != nil {
+		sc.t.logger.Error().Err(err).Msg("server: accept security context")
+		if hdr.PacketType == PacketTypeBind {
+			resp.PDU = &BindNak{ProviderRejectReason: AuthTypeNotRecognized}
+			return sc.writePacket(ctx, resp, nil)
+		}
+		return sc.fault(ctx, hdr.CallID, 0, nil, dcerpc_errors.UnsupportedAuthnLevel, true)
+	}
+
+	if sec != nil && len(token) > 0 {
+		resp.SecurityTrailer, resp.AuthData = sec.SecurityTrailer(), token
+	}
+
+	if hdr.PacketType == PacketTypeBind {
+		resp.PDU = &BindAck{
+			MaxXmitFrag:  uint16(sc.t.settings.MaxXmitFrag),
+			MaxRecvFrag:  uint16(sc.t.settings.MaxRecvFrag),
+			AssocGroupID: uint32(sc.t.settings.GroupID),
+			PortSpec:     "135",
+			ResultList:   results,
+		}
+	} else {
+		resp.PDU = &AlterContextResponse{
+			MaxXmitFrag:  uint16(sc.t.settings.MaxXmitFrag),
+			MaxRecvFrag:  uint16(sc.t.settings.MaxRecvFrag),
+			AssocGroupID: uint32(sc.t.settings.GroupID),
+			ResultList:   results,
+		}
+	}
+
+	return sc.writePacket(ctx, resp, nil)
+}
+
+// negotiate function negotiates the presentation context.
+func (sc *serverConn) negotiate(c *Context, bind bool) *Result {
+
+	noSyntax := &SyntaxID{IfUUID: &uuid.UUID{}}
+
+	if bind && len(c.TransferSyntaxes) > 0 && isBindFeatureSyntax(c.TransferSyntaxes[0]) {
+		return &Result{DefResult: NegotiateAck, ProviderReason: SecurityContextMultiplexing, TransferSyntax: noSyntax}
+	}
+
+	if _, ok := sc.srv.handle(c.AbstractSyntax); !ok {
+		return &Result{DefResult: ProviderRejection, ProviderReason: AbstractSyntaxNotSupported, TransferSyntax: noSyntax}
+	}
+
+	for _, syntax := range c.TransferSyntaxes {
+		if sc.srv.acceptTransferSyntax(c.AbstractSyntax, syntax) {
+			sc.contexts[c.ContextID] = &Presentation{id: c.ContextID, AbstractSyntax: c.AbstractSyntax, TransferSyntax: syntax}
+			return &Result{DefResult: Acceptance, TransferSyntax: syntax}
+		}
+	}
+
+	return &Result{DefResult: ProviderRejection, ProviderReason: ProposedTransferSyntaxesNotSupported, TransferSyntax: noSyntax}
+}
+
+// isBindFeatureSyntax function returns true if the syntax is the bind-time
+// feature negotiation syntax.
+func isBindFeatureSyntax(syntax *SyntaxID) bool {
+	return syntax != nil && syntax.IfUUID != nil &&
+		syntax.IfUUID.TimeLow == BindFeature.TimeLow &&
+		syntax.IfUUID.TimeMid == BindFeature.TimeMid &&
+		syntax.IfUUID.TimeHiAndVersion == BindFeature.TimeHiAndVersion
+}
+
+// auth3 function processes the auth3 packet (no response is sent).
+func (sc *serverConn) auth3(ctx context.Context, hdr Header) error {
+
+	pkt, err := sc.t.DecodePacket(ctx, &Packet{}, sc.t.rx)
+	if err != nil {
+		return fmt.Errorf("decode packet: %w", err)
+	}
+
+	if _, _, err := sc.accept(ctx, hdr, pkt); err != nil {
+		return fmt.Errorf("auth3: %w", err)
+	}
+
+	return nil
+}
+
+// request function processes the request fragment and sends the response
+// when the last fragment is received.
+func (sc *serverConn) request(ctx context.Context, hdr Header) error {
+
+	call, ok := sc.calls[hdr.CallID]
+	if !ok {
+
+		// peek the request header to find the presentation context.
+		req := &Request{}
+		if hdr.PacketFlags&PacketFlagObjectUUID != 0 {
+			req.ObjectUUID = &uuid.UUID{}
+		}
+
+		r := sc.t.Codec(sc.t.rx[HeaderSize:hdr.FragLength], hdr.PacketDRep)
+		if err := req.ReadFrom(ctx, r); err != nil {
+			return fmt.Errorf("read request: %w", err)
+		}
+
+		p, ok := sc.contexts[req.ContextID]
+		if !ok {
+			return sc.fault(ctx, hdr.CallID, req.ContextID, nil, dcerpc_errors.InvalidPresentationContextID, true)
+		}
+
+		h, _ := sc.srv.handle(p.AbstractSyntax)
+
+		call = &serverCall{
+			op:           &serverOperation{opNum: int(req.OpNum), handle: h, done: make(chan struct{})},
+			presentation: p,
+			security:     sc.lastSecurity,
+		}
+
+		if trailer, ok := sc.peekSecurityTrailer(ctx, hdr); ok {
+			if call.security, ok = sc.security[trailer.AuthContextID]; !ok || !call.security.Established() {
+				return sc.fault(ctx, hdr.CallID, req.ContextID, nil, dcerpc_errors.UnsupportedAuthnLevel, true)
+			}
+		}
+
+		call.body = NewBody(ctx, call.op, p, false)
+
+		sc.calls[hdr.CallID] = call
+	}
+
+	// verify the signature or decrypt the fragment.
+	if raw := newSecurityPacket(hdr, sc.t.rx); call.security.CanWrap(ctx, raw) {
+		if err := call.security.Unwrap(ctx, raw, func(context.Context) {}); err != nil {
+			delete(sc.calls, hdr.CallID)
+			call.body.Close()
+			return sc.fault(ctx, hdr.CallID, call.presentation.ID(), call.security, dcerpc_errors.InvalidChecksum, true)
+		}
+	}
+
+	// the decode error is ignored if the server handle has already returned
+	// (for example, the operation is not implemented and the stub is not
+	// read).
+	if _, err := sc.t.DecodePacket(ctx, &Packet{Body: call.body}, sc.t.rx); err != nil && !call.op.isDone() {
+		delete(sc.calls, hdr.CallID)
+		call.body.Close()
+		sc.t.logger.Error().Err(err).Msg("server: decode request")
+		return sc.fault(ctx, hdr.CallID, call.presentation.ID(), call.security, dcerpc_errors.ProtocolError, true)
+	}
+
+	if !hdr.PacketFlags.IsSet(PacketFlagLastFrag) {
+		return nil
+	}
+
+	delete(sc.calls, hdr.CallID)
+	call.body.Close()
+	// wait for the server handle to complete.
+	<-call.op.done
+
+	if call.op.err != nil {
+		return sc.fault(ctx, hdr.CallID, call.presentation.ID(), call.security, call.op.err, call.op.notExecuted)
+	}
+
+	return sc.response(ctx, hdr.CallID, call)
+}
+
+// response function writes the response fragments.
+func (sc *serverConn) response(ctx context.Context, callID uint32, call *serverCall) error {
+
+	pkt := &Packet{
+		Header: Header{
+			PacketFlags: PacketFlagFirstFrag,
+			CallID:      callID,
+		},
+		PDU: &Response{
+			ContextID: call.presentation.ID(),
+		},
+	}
+
+	if call.security != nil {
+		pkt.SecurityTrailer = call.security.SecurityTrailer()
+	}
+
+	body := NewBody(ctx, call.op, call.presentation, true)
+	defer body.Close()
+
+	for pkt.Body = body; !pkt.IsLastFrag(); {
+		pkt.AuthData = make([]byte, call.security.AuthLength(ctx, pkt))
+		if err := sc.writePacket(ctx, pkt, call.security); err != nil {
+			return err
+		}
+		pkt.Header.PacketFlags &= ^PacketFlagFirstFrag
+	}
+
+	return nil
+}
+
+// fault function writes the fault packet for the call. The did-not-execute
+// flag is set only if the call has not been dispatched to the server handle
+// (or the operation is not implemented), the fault for the error returned by
+// the server handle does not claim that the call was not executed. The fault
+// is signed or sealed with the call security context (if any).
+func (sc *serverConn) fault(ctx context.Context, callID uint32, contextID uint16, sec *Security, err error, didNotExecute bool) error {
+
+	status := uint32(dcerpc_errors.UnspecifiedReject.Code)
+
+	var rpcErr *dcerpc_errors.RPCError
+	switch {
+	case errors.As(err, &rpcErr):
+		status = rpcErr.Code
+	case errors.Is(err, ErrNotImplemented):
+		status = dcerpc_errors.OperationRangeError.Code
+	}
+
+	sc.t.logger.Debug().Err(err).Uint32("status", status).Msg("server: fault")
+
+	pkt := &Packet{
+		Header: Header{
+			PacketFlags: PacketFlagFirstFrag | PacketFlagLastFrag,
+			CallID:      callID,
+		},
+		PDU: &Fault{ContextID: contextID, Status: status},
+	}
+
+	if didNotExecute {
+		pkt.Header.PacketFlags |= PacketFlagDidNotExecute
+	}
+
+	if sec.Established() {
+		pkt.SecurityTrailer = sec.SecurityTrailer()
+		pkt.AuthData = make([]byte, sec.AuthLength(ctx, pkt))
+	}
+
+	return sc.writePacket(ctx, pkt, sec)
+}
@@ -0,0 +1,316 @@
+package dcerpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/capture"
+	dcerpc_errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+	"github.com/oiweiwei/go-msrpc/ssp"
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+	"github.com/oiweiwei/go-msrpc/ssp/ntlm"
+)
+
+var testEchoSyntax = &SyntaxID{IfUUID: uuid.MustParse("5b0d4a5e-7c57-4c2b-9a1e-0e8f0d6a3c11"), IfVersionMajor: 1}
+
+// testEchoOperation is the request and the response of the echo call.
+type testEchoOperation struct {
+	Data []byte
+}
+
+func (*testEchoOperation) OpNum() int     { return 0 }
+func (*testEchoOperation) OpName() string { return "/test/v1/Echo" }
+
+func (o *testEchoOperation) marshal(ctx context.Context, w ndr.Writer) error {
+	if err := w.WriteData(uint32(len(o.Data))); err != nil {
+		return err
+	}
+	_, err := w.Write(o.Data)
+	return err
+}
+
+func (o *testEchoOperation) unmarshal(ctx context.Context, r ndr.Reader) error {
+	var sz uint32
+	if err := r.ReadData(&sz); err != nil {
+		return err
+	}
+	o.Data = make([]byte, sz)
+	_, err := io.ReadFull(r, o.Data)
+	return err
+}
+
+func (o *testEchoOperation) MarshalNDRRequest(ctx context.Context, w ndr.Writer) error {
+	return o.marshal(ctx, w)
+}
+
+func (o *testEchoOperation) UnmarshalNDRRequest(ctx context.Context, r ndr.Reader) error {
+	return o.unmarshal(ctx, r)
+}
+
+func (o *testEchoOperation) MarshalNDRResponse(ctx context.Context, w ndr.Writer) error {
+	return o.marshal(ctx, w)
+}
+
+func (o *testEchoOperation) UnmarshalNDRResponse(ctx context.Context, r ndr.Reader) error {
+	return o.unmarshal(ctx, r)
+}
+
+// testUnknownOperation is the operation not implemented by the server.
+type testUnknownOperation struct{ testEchoOperation }
+
+func (*testUnknownOperation) OpNum() int { return 1 }
+
+func testEchoServerHandle(ctx context.Context, opNum int, r ndr.Reader) (Operation, error) {
+	if opNum != 0 {
+		return nil, nil
+	}
+	op := &testEchoOperation{}
+	if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// testReadConn is the connection that keeps the bytes read by the client.
+type testReadConn struct {
+	net.Conn
+	rx *bytes.Buffer
+}
+
+func (c *testReadConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.rx.Write(b[:n])
+	return n, err
+}
+
+// testReadDialer dials the server connections that keep the bytes read by
+// the client.
+type testReadDialer struct {
+	srv *Server
+	rx  *bytes.Buffer
+}
+
+func (d *testReadDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	cc, err := d.srv.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	return &testReadConn{Conn: cc, rx: d.rx}, nil
+}
+
+// testFaultAuthLength function returns the authentication verifier length
+// of the first fault pdu in the stream of fragments.
+func testFaultAuthLength(b []byte) (int, bool) {
+	for len(b) >= HeaderSize {
+		if PacketType(b[2]) == PacketTypeFault {
+			return int(binary.LittleEndian.Uint16(b[10:])), true
+		}
+		b = b[binary.LittleEndian.Uint16(b[8:]):]
+	}
+	return 0, false
+}
+
+func TestServer(t *testing.T) {
+
+	cred := credential.NewFromPassword("Domain\\User", "Password")
+
+	db := credential.NewLocalDatabase()
+	db.Add(cred)
+
+	cfg := ntlm.NewConfig()
+	cfg.NetBIOSComputerName, cfg.NetBIOSDomainName = "Server", "Domain"
+
+	srv := NewServer(
+		WithFragmentSize(MinimumXmitSize),
+		WithMechanism(ssp.NTLM, cfg),
+		WithCredentialDatabase(gssapi.NewCredentialDatabase(db)))
+	defer srv.Close()
+
+	srv.RegisterServer(testEchoServerHandle, WithAbstractSyntax(testEchoSyntax))
+
+	for _, tc := range []struct {
+		name string
+		opts []Option
+		// the fault is signed or sealed.
+		verifier bool
+	}{
+		{"insecure", []Option{WithInsecure()}, false},
+		{"ndr64", []Option{WithInsecure(), WithNDR64()}, false},
+		{"sign", []Option{WithSign(), WithMechanism(ssp.NTLM), WithCredentials(cred)}, true},
+		{"seal", []Option{WithSeal(), WithMechanism(ssp.NTLM), WithCredentials(cred)}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			d := &testReadDialer{srv: srv, rx: &bytes.Buffer{}}
+
+			conn, err := Dial(ctx, "ncacn_ip_tcp:127.0.0.1[135]", WithDialer(d), WithFragmentSize(MinimumXmitSize))
+			if err != nil {
+				t.Fatalf("dial: %v", err)
+			}
+			defer conn.Close(ctx)
+
+			cc, err := conn.Bind(ctx, append(tc.opts, WithAbstractSyntax(testEchoSyntax))...)
+			if err != nil {
+				t.Fatalf("bind: %v", err)
+			}
+
+			// the payload spans multiple fragments.
+			data := bytes.Repeat([]byte("0123456789abcdef"), 1024)
+
+			op := &testEchoOperation{Data: data}
+			if err := cc.Invoke(ctx, op); err != nil {
+				t.Fatalf("invoke: %v", err)
+			}
+
+			if !bytes.Equal(op.Data, data) {
+				t.Errorf("invoke: echo mismatch: %d bytes", len(op.Data))
+			}
+
+			if err := cc.Invoke(ctx, &testUnknownOperation{}); !errors.Is(err, dcerpc_errors.OperationRangeError) {
+				t.Errorf("invoke: expected operation range error, got %v", err)
+			}
+
+			conn.Close(ctx)
+
+			// the fault is verified by the client with the call security
+			// context.
+			if n, ok := testFaultAuthLength(d.rx.Bytes()); !ok || (n > 0) != tc.verifier {
+				t.Errorf("fault: unexpected verifier length %d", n)
+			}
+		})
+	}
+}
+
+func TestServerUnknownInterface(t *testing.T) {
+
+	srv := NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	conn, err := srv.Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	// the presentation context is rejected by the server.
+	cc, err := conn.Bind(ctx, WithInsecure(), WithAbstractSyntax(testEchoSyntax))
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if err := cc.Invoke(ctx, &testEchoOperation{}); err == nil {
+		t.Errorf("invoke: expected error for the unknown interface")
+	}
+}
+
+// testFailOperation is the operation that fails in the server handle.
+type testFailOperation struct{ testEchoOperation }
+
+func (*testFailOperation) OpNum() int { return 2 }
+
+func TestServerFaultDidNotExecute(t *testing.T) {
+
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.RegisterServer(func(ctx context.Context, opNum int, r ndr.Reader) (Operation, error) {
+		if opNum != 2 {
+			return testEchoServerHandle(ctx, opNum, r)
+		}
+		op := &testEchoOperation{}
+		if err := op.UnmarshalNDRRequest(ctx, r); err != nil {
+			return nil, err
+		}
+		return op, dcerpc_errors.NCAInvalidTag
+	}, WithAbstractSyntax(testEchoSyntax))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, tc := range []struct {
+		name          string
+		op            Operation
+		didNotExecute bool
+	}{
+		{"not_implemented", &testUnknownOperation{}, true},
+		{"handler_error", &testFailOperation{}, false},
+	} {
+
+		var pcap bytes.Buffer
+
+		c, err := capture.New(&pcap)
+		if err != nil {
+			t.Fatalf("%s: capture: %v", tc.name, err)
+		}
+
+		conn, err := srv.Dial(ctx, WithCapture(c))
+		if err != nil {
+			t.Fatalf("%s: dial: %v", tc.name, err)
+		}
+
+		cc, err := conn.Bind(ctx, WithInsecure(), WithAbstractSyntax(testEchoSyntax))
+		if err != nil {
+			t.Fatalf("%s: bind: %v", tc.name, err)
+		}
+
+		if err := cc.Invoke(ctx, tc.op); err == nil {
+			t.Errorf("%s: invoke: expected fault", tc.name)
+		}
+
+		conn.Close(ctx)
+		c.Close()
+
+		// the fault pdu (version 5.0, type 3) with the first and last
+		// fragment flags and the did-not-execute flag.
+		flags := PacketFlagFirstFrag | PacketFlagLastFrag
+		if tc.didNotExecute {
+			flags |= PacketFlagDidNotExecute
+		}
+
+		if !bytes.Contains(pcap.Bytes(), []byte{0x05, 0x00, byte(PacketTypeFault), byte(flags)}) {
+			t.Errorf("%s: fault: expected flags 0x%02x", tc.name, uint8(flags))
+		}
+	}
+}
+
+func TestServerCloseDial(t *testing.T) {
+
+	srv := NewServer()
+
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			cc, err := srv.DialContext(ctx, "tcp", "127.0.0.1:135")
+			if err != nil {
+				return
+			}
+			cc.Close()
+		}
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	srv.Close()
+	<-done
+
+	if _, err := srv.DialContext(ctx, "tcp", "127.0.0.1:135"); !errors.Is(err, ErrClosed) {
+		t.Errorf("dial: expected closed error, got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package dcerpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
+)
+
+var authTypes = map[AuthType]string{
+	AuthTypeNone:         "none",
+	AuthTypeGSSNegotiate: "spnego",
+	AuthTypeWinNT:        "ntlm",
+	AuthTypeGSSChannel:   "schannel",
+	AuthTypeKerberos:     "krb5",
+	AuthTypeNetLogon:     "netlogon",
+	AuthTypeDefault:      "default",
+}
+
+var authLevels = map[AuthLevel]string{
+	AuthLevelDefault:      "default",
+	AuthLevelNone:         "none",
+	AuthLevelConnect:      "connect",
+	AuthLevelCall:         "call",
+	AuthLevelPkt:          "pkt",
+	AuthLevelPktIntegrity: "pkt_integrity",
+	AuthLevelPktPrivacy:   "pkt_privacy",
+}
+
+// syntaxString function returns the syntax identifier string
+// representation for the trace event.
+func syntaxString(s *SyntaxID) string {
+	if s == nil || s.IfUUID == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s v%d.%d", s.IfUUID, s.IfVersionMajor, s.IfVersionMinor)
+}
+
+// traceOptions function sets the bind/alter-context event parameters
+// from the parsed options.
+func traceOptions(ev *trace.Event, o *option) {
+
+	if len(o.Presentations) > 0 {
+		ev.AbstractSyntax = syntaxString(o.Presentations[0].AbstractSyntax)
+	}
+
+	if o.Security != nil {
+		if ev.AuthType = authTypes[o.Security.Type]; ev.AuthType == "" {
+			ev.AuthType = fmt.Sprintf("0x%02x", uint8(o.Security.Type))
+		}
+		ev.AuthLevel = authLevels[o.Security.Level]
+	}
+}
+
+// traceFeatures function sets the negotiated parameters for the
+// bind/alter-context event.
+func (c *transport) traceFeatures(ev *trace.Event, o *option) {
+
+	if len(o.Presentations) > 0 {
+		ev.TransferSyntax = syntaxString(o.Presentations[0].TransferSyntax)
+	}
+
+	ev.AssocGroupID = uint32(c.settings.GroupID)
+
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"multiplexing", c.settings.Multiplexing},
+		{"header_sign", o.Security != nil && o.Security.SignHeader},
+		{"keep_conn_open_on_orphaned", c.settings.KeepConnOpenOnOrphaned},
+		{"security_context_multiplexing", c.settings.SecurityContextMultiplexing},
+	} {
+		if f.set {
+			ev.Features = append(ev.Features, f.name)
+		}
+	}
+}
+
+// startAssociation function starts the association event that ends when
+// the transport is shut down.
+func (c *transport) startAssociation(ctx context.Context, bind *trace.Event) {
+
+	ev := &trace.Event{
+		Kind:           trace.KindAssociation,
+		Protocol:       bind.Protocol,
+		Address:        bind.Address,
+		AbstractSyntax: bind.AbstractSyntax,
+		AssocGroupID:   bind.AssocGroupID,
+	}
+
+	_, c.endAssociation = trace.Start(context.WithoutCancel(ctx), c.settings.Observer, ev)
+}
@@ -0,0 +1,168 @@
+// Package trace implements the connection and call observation hooks for
+// the dcerpc and smb2 packages.
+//
+// The Observer is called at the beginning and at the end of each traced
+// event (dial, SMB session setup, tree connect, bind, call). Use SetObserver
+// to observe all connections (see dcerpc/otelrpc package for OpenTelemetry
+// integration), or dcerpc.WithObserver option to observe the single
+// connection.
+package trace
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Kind is the event kind.
+type Kind int
+
+const (
+	// The transport connection establishment.
+	KindDial Kind = iota + 1
+	// The SMB2 session setup.
+	KindSMBSession
+	// The SMB2 tree connect.
+	KindSMBTreeConnect
+	// The SMB2 named pipe open.
+	KindSMBOpen
+	// The bind (with the security context establishment).
+	KindBind
+	// The alter context (the new presentation or security context on the
+	// already bound transport).
+	KindAlterContext
+	// The operation call.
+	KindCall
+	// The association (the bound transport), starts after the bind and ends
+	// when the transport is closed.
+	KindAssociation
+)
+
+var kinds = map[Kind]string{
+	KindDial:           "dial",
+	KindSMBSession:     "smb2.session_setup",
+	KindSMBTreeConnect: "smb2.tree_connect",
+	KindSMBOpen:        "smb2.open",
+	KindBind:           "bind",
+	KindAlterContext:   "alter_context",
+	KindCall:           "call",
+	KindAssociation:    "association",
+}
+
+func (k Kind) String() string {
+	if s, ok := kinds[k]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// Event is the traced event. The event fields are filled in by the
+// instrumented code, the fields that are known at the end of the event (like
+// the negotiated features or the byte counts) are set before End is called.
+type Event struct {
+	// The event kind.
+	Kind Kind
+	// The protocol sequence (ncacn_np, ncacn_ip_tcp).
+	Protocol string
+	// The server address.
+	Address string
+	// The string binding.
+	Binding string
+	// The SMB2 share name.
+	Share string
+	// The SMB2 named pipe name.
+	Pipe string
+	// The negotiated SMB2 dialect.
+	Dialect uint16
+	// The number of retries (for example the named pipe open retries or the
+	// bindings tried before the successful one).
+	Retries int
+	// The abstract syntax ("12345778-1234-abcd-ef00-0123456789ac v1.0").
+	AbstractSyntax string
+	// The transfer syntax.
+	TransferSyntax string
+	// The authentication type (ntlm, krb5, ...).
+	AuthType string
+	// The authentication level (connect, pkt_integrity, ...).
+	AuthLevel string
+	// The association group identifier.
+	AssocGroupID uint32
+	// The negotiated features (multiplexing, header_sign, ...).
+	Features []string
+	// The operation number.
+	OpNum int
+	// The operation name ("/srvsvc/v3/NetrShareEnum").
+	OpName string
+	// The object UUID.
+	Object string
+	// The number of the request fragments.
+	FragmentsOut int
+	// The number of the response fragments.
+	FragmentsIn int
+	// The number of bytes sent.
+	BytesOut int
+	// The number of bytes received.
+	BytesIn int
+	// The fault status (zero if no fault PDU was received).
+	FaultStatus uint32
+}
+
+// Observer observes the events.
+type Observer interface {
+	// Start function is called at the beginning of the event, the returned
+	// context is used for the event and the nested events.
+	Start(ctx context.Context, ev *Event) context.Context
+	// End function is called at the end of the event with the context
+	// returned by Start and the event error.
+	End(ctx context.Context, ev *Event, err error)
+}
+
+type observer struct{ Observer }
+
+var global atomic.Pointer[observer]
+
+// SetObserver function sets the observer for all connections that do not
+// have the observer set explicitly. Nil disables the observation.
+func SetObserver(o Observer) {
+	if o == nil {
+		global.Store(nil)
+		return
+	}
+	global.Store(&observer{o})
+}
+
+// Default function returns the global observer or nil.
+func Default() Observer {
+	if o := global.Load(); o != nil {
+		return o.Observer
+	}
+	return nil
+}
+
+type eventKey struct{}
+
+// Start function starts the event with the observer (or the default observer
+// if nil) and returns the context and the function to end the event. If no
+// observer is set, the returned function is no-op. The event is stored in the
+// context (see FromContext).
+func Start(ctx context.Context, o Observer, ev *Event) (context.Context, func(error)) {
+
+	if o == nil {
+		if o = Default(); o == nil {
+			return ctx, func(error) {}
+		}
+	}
+
+	ctx = o.Start(context.WithValue(ctx, eventKey{}, ev), ev)
+
+	return ctx, func(err error) { o.End(ctx, ev, err) }
+}
+
+// FromContext function returns the current event of the kind from the
+// context.
+func FromContext(ctx context.Context, kind Kind) (*Event, bool) {
+	ev, ok := ctx.Value(eventKey{}).(*Event)
+	if !ok || ev.Kind != kind {
+		return nil, false
+	}
+	return ev, true
+}
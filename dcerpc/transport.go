@@ -9,6 +9,7 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
 	"github.com/oiweiwei/go-msrpc/smb2"
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 )
@@ -53,6 +54,11 @@ type transport struct {
 	closeWait *sync.WaitGroup
 	// The transport connection.
 	conn *conn
+	// The transport binding and server address (for the trace events).
+	binding StringBinding
+	address string
+	// The association event end function.
+	endAssociation func(error)
 }
 
 func (t *transport) IsBinded() bool {
@@ -143,7 +149,7 @@ func (c *transport) ExportSMBSecurity(o *Security) {
 		return
 	}
 
-	if pipe, ok := c.cc.RawConn.(*smb2.NamedPipe); ok {
+	if pipe, ok := unwrapConn(c.cc.RawConn).(*smb2.NamedPipe); ok {
 		o.SetAttribute(gssapi.AttributeSMBApplicationKey, pipe.ApplicationKey())
 		o.SetAttribute(gssapi.AttributeSMBSessionKey, pipe.SessionKey())
 		if o.Level > AuthLevelConnect {
@@ -156,9 +162,51 @@ func (c *transport) ExportSMBSecurity(o *Security) {
 	}
 }
 
+// exportKeys function writes the keys used by the security context and
+// the SMB2 session (for named pipes) to the capture keytab (if the capture
+// is configured).
+func (c *transport) exportKeys(o *Security) {
+
+	if c.settings.Capture == nil {
+		return
+	}
+
+	keys := o.ExportedKeys()
+
+	if pipe, ok := unwrapConn(c.cc.RawConn).(*smb2.NamedPipe); ok {
+		keys = append(keys, pipe.ExportedKeys()...)
+	}
+
+	if err := c.settings.Capture.AddKeys(keys...); err != nil {
+		c.logger.Warn().Err(err).Msg("export keys")
+	}
+}
+
 // AlterContext function establishes new presentation or security (or both) context(s).
 func (c *transport) AlterContext(ctx context.Context, opts ...Option) (Conn, error) {
 
+	ev := c.traceEvent(trace.KindAlterContext)
+
+	ctx, end := trace.Start(ctx, c.settings.Observer, ev)
+
+	conn, err := c.alterContext(ctx, ev, opts...)
+	end(err)
+
+	return conn, err
+}
+
+// traceEvent function returns the new event for the transport.
+func (c *transport) traceEvent(kind trace.Kind) *trace.Event {
+	return &trace.Event{
+		Kind:     kind,
+		Protocol: c.binding.ProtocolSequence.String(),
+		Address:  c.address,
+		Binding:  c.binding.String(),
+	}
+}
+
+func (c *transport) alterContext(ctx context.Context, ev *trace.Event, opts ...Option) (Conn, error) {
+
 	if err := c.HasErr(); err != nil {
 		return nil, fmt.Errorf("alter context: %w", err)
 	}
@@ -171,6 +219,8 @@ func (c *transport) AlterContext(ctx context.Context, opts ...Option) (Conn, err
 		return nil, fmt.Errorf("alter context: parse options: %w", err)
 	}
 
+	traceOptions(ev, o)
+
 	c.ExportSMBSecurity(o.Security)
 
 	call, err := c.makeCall(ctx, noCopy{})
@@ -275,6 +325,11 @@ func (c *transport) AlterContext(ctx context.Context, opts ...Option) (Conn, err
 		c.settings.SecurityContextCount++
 	}
 
+	c.exportKeys(o.Security)
+	c.recordSecurity(ctx, o.Security)
+
+	c.traceFeatures(ev, o)
+
 	return c.makeConn(o), nil
 }
 
@@ -334,6 +389,7 @@ func (c *transport) makeConn(o *option) *clientConn {
 			subs:         conns,
 			logger:       o.Logger,
 			errorMappers: o.ErrorMappers,
+			interceptors: o.Interceptors,
 		}
 	}
 
@@ -354,6 +410,20 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		return c.AlterContext(ctx, opts...)
 	}
 
+	ev := c.traceEvent(trace.KindBind)
+
+	bindCtx, end := trace.Start(ctx, c.settings.Observer, ev)
+
+	conn, err := c.bind(bindCtx, ev, opts...)
+	if end(err); err == nil {
+		c.startAssociation(ctx, ev)
+	}
+
+	return conn, err
+}
+
+func (c *transport) bind(ctx context.Context, ev *trace.Event, opts ...Option) (Conn, error) {
+
 	c.callMu.Lock()
 	defer c.callMu.Unlock()
 
@@ -362,6 +432,8 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		return nil, fmt.Errorf("bind: parse options: %w", err)
 	}
 
+	traceOptions(ev, o)
+
 	c.ExportSMBSecurity(o.Security)
 
 	c.logger = o.Logger
@@ -505,6 +577,11 @@ func (c *transport) Bind(ctx context.Context, opts ...Option) (Conn, error) {
 		c.settings.SecurityContextCount++
 	}
 
+	c.exportKeys(o.Security)
+	c.recordSecurity(ctx, o.Security)
+
+	c.traceFeatures(ev, o)
+
 	ctx, c.close = context.WithCancel(ctx)
 	c.closeWait = new(sync.WaitGroup)
 
@@ -553,6 +630,8 @@ func (t *transport) writePacket(ctx context.Context, call Call, pkt *Packet) err
 		return fmt.Errorf("encode packet: %w", err)
 	}
 
+	t.recordFragment(ctx, true, pkt.Header, t.tx)
+
 	if t.IsBinded() {
 		// use call to access the buffer.
 		if err := call.WriteBuffer(ctx, pkt.Header, t.tx); err != nil {
@@ -586,22 +665,27 @@ func (t *transport) ReadPacket(ctx context.Context, call Call, pkt *Packet) (*Pa
 // readPacket.
 func (t *transport) readPacket(ctx context.Context, call Call, pkt *Packet) (*Packet, error) {
 
-	var err error
+	var (
+		hdr Header
+		err error
+	)
 
 	if t.IsBinded() {
 		// use call to access the readers channel.
-		if _, err := call.ReadBuffer(ctx, t.rx); err != nil {
+		if hdr, err = call.ReadBuffer(ctx, t.rx); err != nil {
 			return nil, fmt.Errorf("read buffer: %w", err)
 		}
 		// unlock the buffer for the next read.
 		defer call.Ready(ctx)
 	} else {
 		// read the data directly from the connection.
-		if _, err := t.ReadBuffer(ctx, t.rx); err != nil {
+		if hdr, err = t.ReadBuffer(ctx, t.rx); err != nil {
 			return nil, fmt.Errorf("read buffer: %w", err)
 		}
 	}
 
+	t.recordFragment(ctx, false, hdr, t.rx)
+
 	// decode the retrieved packet.
 	if pkt, err = t.DecodePacket(ctx, pkt, t.rx); err != nil {
 		return nil, fmt.Errorf("decode packet: %w", err)
@@ -618,6 +702,13 @@ func (t *transport) shutdown(ctx context.Context) error {
 		return nil
 	}
 
+	t.mu.Lock()
+	if t.endAssociation != nil {
+		defer t.endAssociation(t.err)
+		t.endAssociation = nil
+	}
+	t.mu.Unlock()
+
 	t.logger.Debug().Msg("closing sender/receiver loops")
 	// close sender/receiver.
 	t.close()
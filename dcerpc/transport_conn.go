@@ -108,6 +108,28 @@ func (c *call) WriteBuffer(ctx context.Context, hdr Header, p []byte) error {
 	}
 }
 
+// recordFragment function passes the plaintext fragment to the raw
+// connection if it implements the FragmentRecorder interface.
+func (c *transport) recordFragment(ctx context.Context, out bool, hdr Header, p []byte) {
+	if c.cc == nil {
+		return
+	}
+	if rec, ok := lookupConn[FragmentRecorder](c.cc.RawConn); ok {
+		rec.RecordFragment(ctx, out, p[:hdr.FragLength])
+	}
+}
+
+// recordSecurity function passes the established security context to the
+// raw connection if it implements the SecurityRecorder interface.
+func (c *transport) recordSecurity(ctx context.Context, o *Security) {
+	if c.cc == nil || !o.Established() {
+		return
+	}
+	if rec, ok := lookupConn[SecurityRecorder](c.cc.RawConn); ok {
+		rec.RecordSecurity(ctx, o)
+	}
+}
+
 // WriteBuffer function writes the data `p` to the wire.
 func (c *transport) WriteBuffer(ctx context.Context, hdr Header, p []byte) error {
 
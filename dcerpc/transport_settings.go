@@ -8,6 +8,8 @@ import (
 	"github.com/oiweiwei/go-smb2.fork"
 	"github.com/rs/zerolog"
 
+	"github.com/oiweiwei/go-msrpc/dcerpc/capture"
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
 	"github.com/oiweiwei/go-msrpc/ndr"
 )
 
@@ -67,6 +69,10 @@ type Transport struct {
 	NoReuseTransport bool
 	// DNS resolver.
 	DNSResolver DNSResolver
+	// The event observer (see trace package).
+	Observer trace.Observer
+	// The traffic capture (see capture package).
+	Capture *capture.Capture
 }
 
 // The transport connection option.
@@ -103,6 +109,13 @@ func WithDialer(dialer Dialer) ConnectOption {
 	return func(o *Transport) { o.Dialer = dialer }
 }
 
+// WithCapture option sets the capture that records the TCP and SMB2
+// traffic in pcapng format and exports the keys of the established
+// security contexts to the capture keytab.
+func WithCapture(c *capture.Capture) ConnectOption {
+	return func(o *Transport) { o.Capture = c }
+}
+
 // WithTimeout option sets the networking timeout.
 func WithTimeout(timeout time.Duration) ConnectOption {
 	return func(o *Transport) { o.Timeout = timeout }
@@ -147,6 +160,13 @@ func WithEndpointMapper(m EndpointMapper) ConnectOption {
 	return func(o *Transport) { o.EndpointMapper = m }
 }
 
+// WithObserver option sets the observer for the connection events (dial,
+// bind, alter context, calls). If not set, the global observer is used (see
+// trace.SetObserver).
+func WithObserver(o trace.Observer) ConnectOption {
+	return func(opt *Transport) { opt.Observer = o }
+}
+
 // NewTransport function returns the default transport configuration.
 func NewTransport() Transport {
 	return Transport{
@@ -104,6 +104,25 @@ func (u *UUID) MarshalJSON() ([]byte, error) {
 	return append([]byte{'"'}, append([]byte(u.String()), '"')...), nil
 }
 
+func (u *UUID) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	if len(b) < 2 || b[0] != '"' || b[len(b)-1] != '"' {
+		return fmt.Errorf("uuid: unmarshal: invalid string %s", b)
+	}
+
+	v, err := Parse(string(b[1 : len(b)-1]))
+	if err != nil {
+		return err
+	}
+
+	*u = *v
+	return nil
+}
+
 func IsUUID(c rune) bool {
 	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
 }
@@ -7,6 +7,8 @@ import (
 )
 
 // Claims function returns the parsed claims set from the ClaimsSetMetadata.
+// The compressed claims set is decompressed using the Compression option of
+// the matching format, or the built-in compression (see DefaultCompression).
 func (o *ClaimsSetMetadata) Claims(opts ...any) (*ClaimsSet, error) {
 
 	var (
@@ -16,11 +18,26 @@ func (o *ClaimsSetMetadata) Claims(opts ...any) (*ClaimsSet, error) {
 	)
 
 	if o.CompressionFormat != ClaimsCompressionFormatNone {
+
+		compression := DefaultCompression(o.CompressionFormat)
 		for _, opt := range opts {
 			if opt, ok := opt.(Compression); ok && opt.Format() == o.CompressionFormat {
-				if raw, err = opt.Decompress(o.ClaimsSet); err != nil {
-					return nil, fmt.Errorf("claims set decompression failed: %w", err)
-				}
+				compression = opt
+			}
+		}
+
+		if o.UncompressedClaimsSetSize > MaxClaimsSetSize {
+			return nil, fmt.Errorf("claims set size is too large: %d", o.UncompressedClaimsSetSize)
+		}
+
+		if compression != nil {
+			if sized, ok := compression.(SizedCompression); ok {
+				raw, err = sized.DecompressSize(o.ClaimsSet, int(o.UncompressedClaimsSetSize))
+			} else {
+				raw, err = compression.Decompress(o.ClaimsSet)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("claims set decompression failed: %w", err)
 			}
 		}
 	} else {
@@ -58,7 +75,9 @@ func BuildClaimsSetMetadata(claims *ClaimsSet, opts ...any) (*ClaimsSetMetadata,
 		err error
 	)
 
-	if raw, err = ndr.MarshalWithTypeSerializationV1(claims); err != nil {
+	// the claims set is serialized as the PCLAIMS_SET pointer, the same way
+	// it is parsed by the Claims function.
+	if raw, err = ndr.MarshalWithTypeSerializationV1(ndr.MarshalerPointer(claims)); err != nil {
 		return nil, err
 	}
 
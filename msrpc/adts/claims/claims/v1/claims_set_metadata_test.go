@@ -0,0 +1,49 @@
+package claims
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestBuildClaimsSetMetadata(t *testing.T) {
+
+	// the type serialization of the PCLAIMS_SET: the common and private
+	// headers, the top-level pointer referent, the CLAIMS_SET with the single
+	// CLAIMS_ARRAY and the CLAIM_ENTRY "a" of the string value "b".
+	vector, _ := hex.DecodeString("" +
+		"01100800cccccccc6800000000000000" +
+		"01000000" +
+		"010000000900000000000000000000000000000001000000" +
+		"010000000100000025000000010000002d00000003000300010000003900000002000000000000000200000061000000" +
+		"01000000510000000200000000000000020000006200000000000000")
+
+	cls := &ClaimsSet{
+		ClaimsArrays: []*ClaimsArray{{
+			ClaimsSourceType: ClaimsSourceTypeAD,
+			ClaimEntries: []*ClaimEntry{{
+				ID:     "a",
+				Type:   ClaimTypeString,
+				Values: &ClaimEntry_Values{Value: &ClaimEntry_Values_ClaimEntryString{ValueCount: 1, StringValues: []string{"b"}}},
+			}},
+		}},
+	}
+
+	meta, err := BuildClaimsSetMetadata(cls)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	if !bytes.Equal(meta.ClaimsSet, vector) || int(meta.UncompressedClaimsSetSize) != len(vector) {
+		t.Fatalf("build: unexpected claims set %x", meta.ClaimsSet)
+	}
+
+	parsed, err := (&ClaimsSetMetadata{ClaimsSet: vector}).Claims()
+	if err != nil {
+		t.Fatalf("claims: %v", err)
+	}
+
+	if v, ok := parsed.ClaimsMaps()[0].Claims["a"]; !ok || v != "b" {
+		t.Errorf("claims: unexpected claims set %+v", parsed.ClaimsMaps()[0])
+	}
+}
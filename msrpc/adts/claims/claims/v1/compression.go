@@ -0,0 +1,80 @@
+package claims
+
+import (
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/compress/xca"
+)
+
+// MaxClaimsSetSize is the maximum uncompressed claims set size, the larger
+// sizes are rejected before the claims set is decompressed.
+const MaxClaimsSetSize = 1 << 20
+
+var (
+	// LZNT1 is the claims set compression using the LZNT1 algorithm.
+	LZNT1 Compression = lznt1{}
+	// XPress is the claims set compression using the Plain LZ77 algorithm.
+	XPress Compression = xpress{}
+	// XPressHuff is the claims set compression using the LZ77+Huffman algorithm.
+	XPressHuff Compression = xpressHuff{}
+)
+
+// SizedCompression is the Compression that uses the uncompressed claims set
+// size to decompress the claims set.
+type SizedCompression interface {
+	Compression
+	// DecompressSize decompresses a byte slice of the known uncompressed size.
+	DecompressSize([]byte, int) ([]byte, error)
+}
+
+// DefaultCompression function returns the built-in compression for the format,
+// or nil if the format is not supported.
+func DefaultCompression(format ClaimsCompressionFormat) Compression {
+	switch format {
+	case ClaimsCompressionFormatLZNT1:
+		return LZNT1
+	case ClaimsCompressionFormatXPress:
+		return XPress
+	case ClaimsCompressionFormatXPressHuff:
+		return XPressHuff
+	}
+	return nil
+}
+
+type lznt1 struct{}
+
+func (lznt1) Compress(b []byte) ([]byte, error)   { return xca.CompressLZNT1(b) }
+func (lznt1) Decompress(b []byte) ([]byte, error) { return xca.DecompressLZNT1(b, MaxClaimsSetSize) }
+func (lznt1) Format() ClaimsCompressionFormat     { return ClaimsCompressionFormatLZNT1 }
+
+func (lznt1) DecompressSize(b []byte, size int) ([]byte, error) {
+	out, err := xca.DecompressLZNT1(b, size)
+	if err == nil && len(out) != size {
+		return nil, fmt.Errorf("lznt1: size mismatch: %d != %d", len(out), size)
+	}
+	return out, err
+}
+
+type xpress struct{}
+
+func (xpress) Compress(b []byte) ([]byte, error)   { return xca.CompressLZ77(b) }
+func (xpress) Decompress(b []byte) ([]byte, error) { return xca.DecompressLZ77(b, MaxClaimsSetSize) }
+func (xpress) Format() ClaimsCompressionFormat     { return ClaimsCompressionFormatXPress }
+
+func (xpress) DecompressSize(b []byte, size int) ([]byte, error) {
+	out, err := xca.DecompressLZ77(b, size)
+	if err == nil && len(out) != size {
+		return nil, fmt.Errorf("xpress: size mismatch: %d != %d", len(out), size)
+	}
+	return out, err
+}
+
+type xpressHuff struct{}
+
+func (xpressHuff) Compress(b []byte) ([]byte, error)   { return xca.CompressLZ77Huffman(b) }
+func (xpressHuff) Decompress(b []byte) ([]byte, error) { return xca.DecompressLZ77Huffman(b, -1) }
+func (xpressHuff) Format() ClaimsCompressionFormat     { return ClaimsCompressionFormatXPressHuff }
+
+func (xpressHuff) DecompressSize(b []byte, size int) ([]byte, error) {
+	return xca.DecompressLZ77Huffman(b, size)
+}
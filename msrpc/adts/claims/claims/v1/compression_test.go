@@ -0,0 +1,65 @@
+package claims
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCompression(t *testing.T) {
+
+	cls := ClaimsSetFromClaimsMaps(&ClaimsMap{
+		SourceType: ClaimsSourceTypeAD,
+		Claims:     map[string]any{"ad://ext/department": []string{"engineering", "engineering", "engineering"}},
+	})
+
+	plain, err := BuildClaimsSetMetadata(cls)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	for _, compression := range []Compression{LZNT1, XPress, XPressHuff} {
+
+		meta, err := BuildClaimsSetMetadata(cls, compression)
+		if err != nil {
+			t.Fatalf("%v: build: %v", compression.Format(), err)
+		}
+
+		if meta.CompressionFormat != compression.Format() {
+			t.Fatalf("%v: unexpected format %v", compression.Format(), meta.CompressionFormat)
+		}
+
+		raw, err := compression.Decompress(meta.ClaimsSet)
+		if sized, ok := compression.(SizedCompression); ok {
+			raw, err = sized.DecompressSize(meta.ClaimsSet, int(meta.UncompressedClaimsSetSize))
+		}
+		if err != nil {
+			t.Fatalf("%v: decompress: %v", compression.Format(), err)
+		}
+
+		if !bytes.Equal(raw, plain.ClaimsSet) || int(meta.UncompressedClaimsSetSize) != len(raw) {
+			t.Errorf("%v: unexpected claims set % x", compression.Format(), raw)
+		}
+	}
+}
+
+func TestCompressionBomb(t *testing.T) {
+
+	// the literal "a" and the match of 0x10000000 bytes at the offset 1.
+	bomb := []byte{
+		0x00, 0x00, 0x00, 0x40, 0x61, 0x07, 0x00, 0x0f, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x10,
+	}
+
+	for name, meta := range map[string]*ClaimsSetMetadata{
+		"declared size": {CompressionFormat: ClaimsCompressionFormatXPress, UncompressedClaimsSetSize: 64, ClaimsSet: bomb},
+		"maximum size":  {CompressionFormat: ClaimsCompressionFormatXPressHuff, UncompressedClaimsSetSize: 0xFFFFFFFF, ClaimsSet: bomb},
+	} {
+		if _, err := meta.Claims(); err == nil {
+			t.Errorf("%s: claims: expected error", name)
+		}
+	}
+
+	if _, err := XPress.Decompress(bomb); err == nil {
+		t.Errorf("decompress: expected error")
+	}
+}
@@ -0,0 +1,311 @@
+package binxml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is the structured event decoded from the event XML.
+type Event struct {
+	// The event system properties.
+	System *EventSystem `xml:"System" json:"system"`
+	// The event data.
+	EventData *EventData `xml:"EventData" json:"event_data,omitempty"`
+	// The event user data.
+	UserData *EventUserData `xml:"UserData" json:"user_data,omitempty"`
+}
+
+// The event levels.
+const (
+	LevelLogAlways   = 0
+	LevelCritical    = 1
+	LevelError       = 2
+	LevelWarning     = 3
+	LevelInformation = 4
+	LevelVerbose     = 5
+)
+
+// LevelName function returns the standard name of the event level.
+func LevelName(level uint8) string {
+	switch level {
+	case LevelLogAlways:
+		return "LogAlways"
+	case LevelCritical:
+		return "Critical"
+	case LevelError:
+		return "Error"
+	case LevelWarning:
+		return "Warning"
+	case LevelInformation:
+		return "Information"
+	case LevelVerbose:
+		return "Verbose"
+	}
+	return strconv.Itoa(int(level))
+}
+
+// Data function returns the named event data and user data items. The
+// unnamed items are keyed by their index.
+func (o *Event) Data() map[string]string {
+	ret := make(map[string]string)
+	if o.EventData != nil {
+		for k, v := range o.EventData.Map() {
+			ret[k] = v
+		}
+	}
+	if o.UserData != nil {
+		for k, v := range o.UserData.Map() {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+// Values function returns the event data or user data item values in the
+// order of appearance, the values are used as the message inserts.
+func (o *Event) Values() []string {
+	var items []*EventDataItem
+	switch {
+	case o.EventData != nil:
+		items = o.EventData.Data
+	case o.UserData != nil:
+		items = o.UserData.Data
+	}
+	ret := make([]string, len(items))
+	for i := range items {
+		ret[i] = items[i].Value
+	}
+	return ret
+}
+
+// EventSystem is the <System> element of the event.
+type EventSystem struct {
+	// The event provider.
+	Provider EventProvider `xml:"Provider" json:"provider"`
+	// The event identifier.
+	EventID uint32 `xml:"EventID" json:"event_id"`
+	// The event version.
+	Version uint8 `xml:"Version" json:"version"`
+	// The event level.
+	Level uint8 `xml:"Level" json:"level"`
+	// The event task.
+	Task uint16 `xml:"Task" json:"task"`
+	// The event opcode.
+	Opcode uint8 `xml:"Opcode" json:"opcode"`
+	// The event keywords.
+	Keywords EventKeywords `xml:"Keywords" json:"keywords"`
+	// The event creation time.
+	TimeCreated EventTime `xml:"TimeCreated" json:"time_created"`
+	// The event record identifier.
+	EventRecordID uint64 `xml:"EventRecordID" json:"event_record_id"`
+	// The channel name.
+	Channel string `xml:"Channel" json:"channel"`
+	// The computer name.
+	Computer string `xml:"Computer" json:"computer"`
+	// The activity correlation.
+	Correlation EventCorrelation `xml:"Correlation" json:"correlation"`
+	// The process and thread that logged the event.
+	Execution EventExecution `xml:"Execution" json:"execution"`
+	// The user security identifier.
+	Security EventSecurity `xml:"Security" json:"security"`
+}
+
+// EventCorrelation is the <Correlation> element of the event.
+type EventCorrelation struct {
+	// The activity identifier.
+	ActivityID string `xml:"ActivityID,attr" json:"activity_id,omitempty"`
+	// The related activity identifier.
+	RelatedActivityID string `xml:"RelatedActivityID,attr" json:"related_activity_id,omitempty"`
+}
+
+// EventExecution is the <Execution> element of the event.
+type EventExecution struct {
+	// The process identifier.
+	ProcessID uint32 `xml:"ProcessID,attr" json:"process_id"`
+	// The thread identifier.
+	ThreadID uint32 `xml:"ThreadID,attr" json:"thread_id"`
+}
+
+// EventSecurity is the <Security> element of the event.
+type EventSecurity struct {
+	// The user SID.
+	UserID string `xml:"UserID,attr" json:"user_id,omitempty"`
+}
+
+// EventProvider is the <Provider> element of the event.
+type EventProvider struct {
+	// The provider name.
+	Name string `xml:"Name,attr" json:"name"`
+	// The provider GUID.
+	GUID string `xml:"Guid,attr" json:"guid,omitempty"`
+	// The event source name (classic providers).
+	EventSourceName string `xml:"EventSourceName,attr" json:"event_source_name,omitempty"`
+}
+
+// EventTime is the <TimeCreated> element of the event.
+type EventTime struct {
+	// The system time.
+	SystemTime time.Time `xml:"SystemTime,attr" json:"system_time"`
+}
+
+// EventData is the <EventData> element of the event.
+type EventData struct {
+	// The event data items.
+	Data []*EventDataItem `xml:"Data" json:"data,omitempty"`
+	// The binary event data (hex-encoded).
+	Binary string `xml:"Binary" json:"binary,omitempty"`
+}
+
+// Map function returns the event data items, the unnamed items are keyed by
+// their index.
+func (o *EventData) Map() map[string]string {
+	return dataItemsMap(o.Data)
+}
+
+// EventUserData is the <UserData> element of the event. The user data is the
+// single provider-defined element, its child elements are the data items.
+type EventUserData struct {
+	// The user data element name.
+	Name string `json:"name"`
+	// The user data items.
+	Data []*EventDataItem `json:"data,omitempty"`
+}
+
+// Map function returns the user data items.
+func (o *EventUserData) Map() map[string]string {
+	return dataItemsMap(o.Data)
+}
+
+// UnmarshalXML function decodes the user data element. The nested elements
+// of the data items are flattened into the item value.
+func (o *EventUserData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+
+	var (
+		item  *EventDataItem
+		depth int
+	)
+
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			switch depth++; depth {
+			case 1:
+				o.Name = tok.Name.Local
+			case 2:
+				item = &EventDataItem{Name: tok.Name.Local}
+				o.Data = append(o.Data, item)
+			}
+		case xml.CharData:
+			if item != nil {
+				item.Value += string(tok)
+			}
+		case xml.EndElement:
+			if depth--; depth < 0 {
+				return nil
+			}
+			if depth == 1 {
+				item = nil
+			}
+		}
+	}
+}
+
+func dataItemsMap(items []*EventDataItem) map[string]string {
+	ret := make(map[string]string, len(items))
+	for i, item := range items {
+		if item.Name == "" {
+			ret[strconv.Itoa(i)] = item.Value
+			continue
+		}
+		ret[item.Name] = item.Value
+	}
+	return ret
+}
+
+// EventDataItem is the <Data> element of the event data.
+type EventDataItem struct {
+	// The item name, empty for the unnamed items.
+	Name string `xml:"Name,attr" json:"name,omitempty"`
+	// The item value.
+	Value string `xml:",chardata" json:"value"`
+}
+
+// EventKeywords is the hex-encoded (0x...) event keywords mask.
+type EventKeywords uint64
+
+// UnmarshalText function parses the hex-encoded integer.
+func (o *EventKeywords) UnmarshalText(b []byte) error {
+	s := strings.TrimSpace(string(b))
+	if s == "" {
+		*o = 0
+		return nil
+	}
+	v, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(s), "0x"), 16, 64)
+	if err != nil {
+		return fmt.Errorf("binxml: parse hex: %w", err)
+	}
+	*o = EventKeywords(v)
+	return nil
+}
+
+// MarshalText function returns the hex-encoded integer.
+func (o EventKeywords) MarshalText() ([]byte, error) {
+	return []byte("0x" + strconv.FormatUint(uint64(o), 16)), nil
+}
+
+// ParseEvent function parses the event XML.
+func ParseEvent(s string) (*Event, error) {
+	var ev Event
+	if err := xml.Unmarshal([]byte(s), &ev); err != nil {
+		return nil, fmt.Errorf("binxml: parse event: %w", err)
+	}
+	return &ev, nil
+}
+
+// Event function returns the structured event decoded from the result set
+// document.
+func (o *ResultSet) Event() (*Event, error) {
+	if o == nil || o.Document == nil {
+		return nil, fmt.Errorf("binxml: result set has no event")
+	}
+	r := NewRenderer()
+	r.EscapeText = true
+	return ParseEvent(r.Render(o.Document))
+}
+
+// XML function returns the bookmark XML for the query channels, the channels
+// must be in the order of the query (see the LogRecordNumbers).
+func (o *Bookmark) XML(channels []string) string {
+
+	var sb strings.Builder
+
+	sb.WriteString("<BookmarkList")
+	if o.ReadDirection != 0 {
+		sb.WriteString(" Direction='backward'")
+	}
+	sb.WriteString(">")
+
+	for i, id := range o.LogRecordNumbers {
+		if i >= len(channels) {
+			break
+		}
+		sb.WriteString("<Bookmark Channel='")
+		xml.EscapeText(&sb, []byte(channels[i]))
+		sb.WriteString("' RecordId='" + strconv.FormatUint(id, 10) + "'")
+		if uint32(i) == o.CurrentChannel {
+			sb.WriteString(" IsCurrent='true'")
+		}
+		sb.WriteString("/>")
+	}
+
+	sb.WriteString("</BookmarkList>")
+
+	return sb.String()
+}
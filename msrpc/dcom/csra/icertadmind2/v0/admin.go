@@ -0,0 +1,457 @@
+package icertadmind2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/csra"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/csra/icertadmind/v0"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/wcce"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp/filetime"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/hresult"
+)
+
+// The revocation reasons (CRL_REASON_*).
+const (
+	RevokeReasonUnspecified          uint32 = 0x00000000
+	RevokeReasonKeyCompromise        uint32 = 0x00000001
+	RevokeReasonCACompromise         uint32 = 0x00000002
+	RevokeReasonAffiliationChanged   uint32 = 0x00000003
+	RevokeReasonSuperseded           uint32 = 0x00000004
+	RevokeReasonCessationOfOperation uint32 = 0x00000005
+	RevokeReasonCertificateHold      uint32 = 0x00000006
+	// The certificate is released from hold.
+	RevokeReasonUnrevoke uint32 = 0xFFFFFFFF
+)
+
+// The CRL publishing flags (CA_CRL_*).
+const (
+	PublishCRLBase      uint32 = 0x00000001
+	PublishCRLDelta     uint32 = 0x00000002
+	PublishCRLRepublish uint32 = 0x00000010
+)
+
+// Admin is the CA administration client.
+type Admin struct {
+	// The certificate admin client.
+	Client CertAdminD2Client
+	// The COM version.
+	Version *dcom.COMVersion
+	// The CA name.
+	Authority string
+}
+
+// NewAdmin function returns the CA administration client.
+func NewAdmin(cli CertAdminD2Client, ver *dcom.COMVersion, authority string) *Admin {
+	return &Admin{Client: cli, Version: ver, Authority: authority}
+}
+
+func (o *Admin) this() *dcom.ORPCThis {
+	return &dcom.ORPCThis{Version: o.Version}
+}
+
+// Columns function returns the table column definitions.
+func (o *Admin) Columns(ctx context.Context, table uint32) ([]*csra.Column, error) {
+
+	var ret []*csra.Column
+
+	for {
+		resp, err := o.Client.EnumViewColumnTable(ctx, &EnumViewColumnTableRequest{
+			This:        o.this(),
+			Authority:   o.Authority,
+			Table:       table,
+			Column:      uint32(len(ret)),
+			ColumnCount: csra.DefaultPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("icertadmind2: enum view column table: %w", err)
+		}
+
+		cols, err := resp.ColumnInfo.Columns(int(resp.ColumnOutCount))
+		if err != nil {
+			return nil, fmt.Errorf("icertadmind2: parse columns: %w", err)
+		}
+
+		if ret = append(ret, cols...); resp.ColumnOutCount < csra.DefaultPageSize {
+			return ret, nil
+		}
+	}
+}
+
+// Query function returns the iterator over the query result rows. The rows
+// are fetched page by page, the view is closed when the iteration stops.
+func (o *Admin) Query(ctx context.Context, q *csra.Query) iter.Seq2[*csra.Record, error] {
+
+	return func(yield func(*csra.Record, error) bool) {
+
+		columns, err := o.Columns(ctx, q.Table)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		restrictions, out, err := q.Prepare(columns)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		index := make(map[uint32]*csra.Column, len(columns))
+		for _, col := range columns {
+			index[col.Index] = col
+		}
+
+		count := q.PageSize
+		if count == 0 {
+			count = csra.DefaultPageSize
+		}
+
+		admin := o.Client.CertAdminD()
+
+		resp, err := admin.OpenView(ctx, &icertadmind.OpenViewRequest{
+			This:                 o.this(),
+			Authority:            o.Authority,
+			CertViewRestrictions: restrictions,
+			ColumnsOut:           out,
+			ID:                   1,
+			Count:                count,
+		})
+		if resp == nil {
+			yield(nil, fmt.Errorf("icertadmind2: open view: %w", err))
+			return
+		}
+
+		defer admin.CloseView(context.WithoutCancel(ctx), &icertadmind.CloseViewRequest{
+			This:      o.this(),
+			Authority: o.Authority,
+		})
+
+		fetched, rows, status := resp.Fetched, resp.ResultRows, err
+
+		for next := uint32(1); ; {
+
+			// S_FALSE indicates that fewer rows than requested were returned.
+			if status != nil && !errors.Is(status, hresult.ErrorArithmeticOverflow) {
+				yield(nil, fmt.Errorf("icertadmind2: view: %w", status))
+				return
+			}
+
+			if rows == nil {
+				return
+			}
+
+			page, more, err := rows.Rows()
+			if err != nil {
+				yield(nil, fmt.Errorf("icertadmind2: parse rows: %w", err))
+				return
+			}
+
+			for _, row := range page {
+				rec, err := row.Record(index)
+				if !yield(rec, err) || err != nil {
+					return
+				}
+			}
+
+			if status != nil || !more || fetched < count {
+				return
+			}
+
+			next += fetched
+
+			resp, err := admin.EnumView(ctx, &icertadmind.EnumViewRequest{
+				This:      o.this(),
+				Authority: o.Authority,
+				ID:        next,
+				Count:     count,
+			})
+			if resp == nil {
+				yield(nil, fmt.Errorf("icertadmind2: enum view: %w", err))
+				return
+			}
+
+			fetched, rows, status = resp.Fetched, resp.ResultRows, err
+		}
+	}
+}
+
+// Revoke function revokes the certificate with the serial number (the
+// hexadecimal string). The zero time means the revocation is effective now.
+func (o *Admin) Revoke(ctx context.Context, serialNumber string, reason uint32, at time.Time) error {
+
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	if _, err := o.Client.CertAdminD().RevokeCertificate(ctx, &icertadmind.RevokeCertificateRequest{
+		This:         o.this(),
+		Authority:    o.Authority,
+		SerialNumber: serialNumber,
+		Reason:       reason,
+		FileTime:     filetime.FromTime(at),
+	}); err != nil {
+		return fmt.Errorf("icertadmind2: revoke certificate: %w", err)
+	}
+
+	return nil
+}
+
+// Unrevoke function releases the certificate from hold.
+func (o *Admin) Unrevoke(ctx context.Context, serialNumber string) error {
+	return o.Revoke(ctx, serialNumber, RevokeReasonUnrevoke, time.Time{})
+}
+
+// Resubmit function resubmits the pending request to the policy module and
+// returns the request disposition (wcce.Disposition*).
+func (o *Admin) Resubmit(ctx context.Context, requestID uint32) (uint32, error) {
+
+	resp, err := o.Client.CertAdminD().ResubmitRequest(ctx, &icertadmind.ResubmitRequestRequest{
+		This:      o.this(),
+		Authority: o.Authority,
+		RequestID: requestID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("icertadmind2: resubmit request: %w", err)
+	}
+
+	return resp.Disposition, nil
+}
+
+// Deny function denies the pending request.
+func (o *Admin) Deny(ctx context.Context, requestID uint32) error {
+
+	if _, err := o.Client.CertAdminD().DenyRequest(ctx, &icertadmind.DenyRequestRequest{
+		This:      o.this(),
+		Authority: o.Authority,
+		RequestID: requestID,
+	}); err != nil {
+		return fmt.Errorf("icertadmind2: deny request: %w", err)
+	}
+
+	return nil
+}
+
+// PublishCRLs function publishes the CRLs (PublishCRL* flags). The zero
+// next update time means the CA computes it from the CRL period.
+func (o *Admin) PublishCRLs(ctx context.Context, flags uint32, nextUpdate time.Time) error {
+
+	ft := &dtyp.Filetime{}
+	if !nextUpdate.IsZero() {
+		ft = filetime.FromTime(nextUpdate)
+	}
+
+	if _, err := o.Client.PublishCRLs(ctx, &PublishCRLsRequest{
+		This:      o.this(),
+		Authority: o.Authority,
+		Flags:     flags,
+		FileTime:  ft,
+	}); err != nil {
+		return fmt.Errorf("icertadmind2: publish crls: %w", err)
+	}
+
+	return nil
+}
+
+// ConfigEntry function returns the CA configuration entry value (the
+// registry value under the configuration node path). The integer values are
+// returned as int32, the strings as string, otherwise the variant union
+// value is returned.
+func (o *Admin) ConfigEntry(ctx context.Context, nodePath, entry string) (any, error) {
+
+	resp, err := o.Client.GetConfigEntry(ctx, &GetConfigEntryRequest{
+		This:      o.this(),
+		Authority: o.Authority,
+		NodePath:  nodePath,
+		Entry:     entry,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("icertadmind2: get config entry %q: %w", entry, err)
+	}
+
+	if resp.Variant == nil {
+		return nil, nil
+	}
+
+	switch v := resp.Variant.VarUnion.GetValue().(type) {
+	case *oaut.String:
+		if v == nil {
+			return "", nil
+		}
+		return v.Data, nil
+	default:
+		return v, nil
+	}
+}
+
+// SetConfigEntry function sets the CA configuration entry value. The value
+// must be the integer, string or *oaut.Variant.
+func (o *Admin) SetConfigEntry(ctx context.Context, nodePath, entry string, value any) error {
+
+	v, err := newVariant(value)
+	if err != nil {
+		return fmt.Errorf("icertadmind2: set config entry %q: %w", entry, err)
+	}
+
+	if _, err := o.Client.SetConfigEntry(ctx, &SetConfigEntryRequest{
+		This:      o.this(),
+		Authority: o.Authority,
+		NodePath:  nodePath,
+		Entry:     entry,
+		Variant:   v,
+	}); err != nil {
+		return fmt.Errorf("icertadmind2: set config entry %q: %w", entry, err)
+	}
+
+	return nil
+}
+
+func newVariant(value any) (*oaut.Variant, error) {
+
+	switch v := value.(type) {
+	case *oaut.Variant:
+		return v, nil
+	case int:
+		return newVariant(int32(v))
+	case uint32:
+		return newVariant(int32(v))
+	case int32:
+		return &oaut.Variant{
+			Size:     3,
+			VT:       uint16(oaut.VarEnumI4),
+			VarUnion: &oaut.Variant_VarUnion{Value: &oaut.Variant_VarUnion_Long{Long: v}},
+		}, nil
+	case string:
+		return &oaut.Variant{
+			Size:     uint32(5 + (len(v)*2+7)/8),
+			VT:       uint16(oaut.VarEnumString),
+			VarUnion: &oaut.Variant_VarUnion{Value: &oaut.Variant_VarUnion_BSTR{BSTR: &oaut.String{Data: v}}},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type %T", value)
+}
+
+// Security function returns the self-relative CA security descriptor. The
+// result can be parsed using dtyp.SecurityDescriptor.Parse.
+func (o *Admin) Security(ctx context.Context) ([]byte, error) {
+
+	resp, err := o.Client.GetCASecurity(ctx, &GetCASecurityRequest{
+		This:      o.this(),
+		Authority: o.Authority,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("icertadmind2: get ca security: %w", err)
+	}
+
+	return blobBytes(resp.SecurityDescriptor), nil
+}
+
+// SetSecurity function sets the self-relative CA security descriptor.
+func (o *Admin) SetSecurity(ctx context.Context, sd []byte) error {
+
+	if _, err := o.Client.SetCASecurity(ctx, &SetCASecurityRequest{
+		This:               o.this(),
+		Authority:          o.Authority,
+		SecurityDescriptor: &csra.CertTransportBlob{Length: uint32(len(sd)), Buffer: sd},
+	}); err != nil {
+		return fmt.Errorf("icertadmind2: set ca security: %w", err)
+	}
+
+	return nil
+}
+
+// OfficerRights function returns whether the officer rights are enabled and
+// the self-relative officer rights security descriptor.
+func (o *Admin) OfficerRights(ctx context.Context) (bool, []byte, error) {
+
+	resp, err := o.Client.GetOfficerRights(ctx, &GetOfficerRightsRequest{
+		This:      o.this(),
+		Authority: o.Authority,
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("icertadmind2: get officer rights: %w", err)
+	}
+
+	return resp.Enabled, blobBytes(resp.SecurityDescriptor), nil
+}
+
+// SetOfficerRights function enables or disables the officer rights and sets
+// the officer rights security descriptor.
+func (o *Admin) SetOfficerRights(ctx context.Context, enable bool, sd []byte) error {
+
+	if _, err := o.Client.SetOfficerRights(ctx, &SetOfficerRightsRequest{
+		This:               o.this(),
+		Authority:          o.Authority,
+		Enable:             enable,
+		SecurityDescriptor: &csra.CertTransportBlob{Length: uint32(len(sd)), Buffer: sd},
+	}); err != nil {
+		return fmt.Errorf("icertadmind2: set officer rights: %w", err)
+	}
+
+	return nil
+}
+
+// Templates function returns the templates published to the CA.
+func (o *Admin) Templates(ctx context.Context) ([]*wcce.CATemplate, error) {
+
+	resp, err := o.Client.GetCAProperty(ctx, &GetCAPropertyRequest{
+		This:          o.this(),
+		Authority:     o.Authority,
+		PropertyID:    wcce.PropertyTemplates,
+		PropertyIndex: 0,
+		PropertyType:  wcce.PropertyTypeString,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("icertadmind2: get ca templates: %w", err)
+	}
+
+	s, err := wcce.DecodePropertyString(blobBytes(resp.PropertyValue))
+	if err != nil {
+		return nil, err
+	}
+
+	return wcce.ParseCATemplates(s), nil
+}
+
+// SetTemplates function sets the templates published to the CA.
+func (o *Admin) SetTemplates(ctx context.Context, templates []*wcce.CATemplate) error {
+
+	var sb strings.Builder
+	for _, tmpl := range templates {
+		sb.WriteString(tmpl.Name + "\n" + tmpl.OID + "\n")
+	}
+
+	b, err := utf16le.Encode(sb.String() + "\x00")
+	if err != nil {
+		return fmt.Errorf("icertadmind2: set ca templates: %w", err)
+	}
+
+	if _, err := o.Client.SetCAProperty(ctx, &SetCAPropertyRequest{
+		This:          o.this(),
+		Authority:     o.Authority,
+		PropertyID:    wcce.PropertyTemplates,
+		PropertyIndex: 0,
+		PropertyType:  wcce.PropertyTypeString,
+		PropertyValue: &csra.CertTransportBlob{Length: uint32(len(b)), Buffer: b},
+	}); err != nil {
+		return fmt.Errorf("icertadmind2: set ca templates: %w", err)
+	}
+
+	return nil
+}
+
+func blobBytes(blob *csra.CertTransportBlob) []byte {
+	if blob == nil {
+		return nil
+	}
+	return blob.Buffer
+}
@@ -0,0 +1,233 @@
+package icertadmind2
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/csra"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/csra/icertadmind/v0"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/oaut"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/hresult"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+var testColumns = []*csra.Column{
+	{Type: csra.ColumnTypeSignedInteger, Index: 0x1, Name: csra.ColumnRequestID},
+	{Type: csra.ColumnTypeSignedInteger, Index: 0x2, Name: csra.ColumnDisposition},
+	{Type: csra.ColumnTypeString, Index: 0x3, Name: csra.ColumnCertificateTemplate},
+	{Type: csra.ColumnTypeTimestamp, Index: 0x4, Name: csra.ColumnNotAfter},
+}
+
+type testAdminClient struct {
+	CertAdminD2Client
+	admin   *testAdminDClient
+	entries map[string]*oaut.Variant
+}
+
+type testAdminDClient struct {
+	icertadmind.CertAdminDClient
+	// the rows (request identifier and template).
+	rows         []string
+	restrictions []*csra.CertViewRestriction
+	fetches      []uint32
+	closed       bool
+	revoked      *icertadmind.RevokeCertificateRequest
+}
+
+func (c *testAdminClient) CertAdminD() icertadmind.CertAdminDClient {
+	return c.admin
+}
+
+func (c *testAdminClient) EnumViewColumnTable(ctx context.Context, in *EnumViewColumnTableRequest, opts ...dcerpc.CallOption) (*EnumViewColumnTableResponse, error) {
+
+	cols := testColumns[min(int(in.Column), len(testColumns)):]
+
+	// the column definitions followed by the names.
+	var b, names []byte
+	for _, col := range cols {
+		off := uint32(20*len(cols) + len(names))
+		b = binary.LittleEndian.AppendUint32(b, uint32(col.Type))
+		b = binary.LittleEndian.AppendUint32(b, col.Index)
+		b = binary.LittleEndian.AppendUint32(b, 0)
+		b = binary.LittleEndian.AppendUint32(b, off)
+		b = binary.LittleEndian.AppendUint32(b, off)
+		name, _ := utf16le.Encode(col.Name + "\x00")
+		names = append(names, name...)
+	}
+
+	b = append(b, names...)
+
+	return &EnumViewColumnTableResponse{
+		ColumnOutCount: uint32(len(cols)),
+		ColumnInfo:     &csra.CertTransportBlob{Length: uint32(len(b)), Buffer: b},
+	}, nil
+}
+
+func (c *testAdminClient) SetConfigEntry(ctx context.Context, in *SetConfigEntryRequest, opts ...dcerpc.CallOption) (*SetConfigEntryResponse, error) {
+	c.entries[in.Entry] = in.Variant
+	return &SetConfigEntryResponse{}, nil
+}
+
+func (c *testAdminClient) GetConfigEntry(ctx context.Context, in *GetConfigEntryRequest, opts ...dcerpc.CallOption) (*GetConfigEntryResponse, error) {
+	return &GetConfigEntryResponse{Variant: c.entries[in.Entry]}, nil
+}
+
+// testRow function returns the encoded result row.
+func testRow(id int32, template string) []byte {
+
+	tmpl, _ := utf16le.Encode(template + "\x00")
+	values := [][]byte{
+		binary.LittleEndian.AppendUint32(nil, uint32(id)),
+		tmpl,
+	}
+
+	b := make([]byte, 12+16*len(values))
+	binary.LittleEndian.PutUint32(b[0:], uint32(id))
+	binary.LittleEndian.PutUint32(b[4:], uint32(len(values)))
+
+	for i, v := range values {
+		col := b[12+16*i:]
+		binary.LittleEndian.PutUint32(col[0:], uint32(testColumns[i*2].Type))
+		binary.LittleEndian.PutUint32(col[4:], testColumns[i*2].Index)
+		binary.LittleEndian.PutUint32(col[8:], uint32(len(b)))
+		binary.LittleEndian.PutUint32(col[12:], uint32(len(v)))
+		b = append(b, v...)
+	}
+
+	binary.LittleEndian.PutUint32(b[8:], uint32(len(b)))
+
+	return b
+}
+
+func (c *testAdminDClient) page(id, count uint32) (uint32, *csra.CertTransportBlob, error) {
+
+	c.fetches = append(c.fetches, id)
+
+	var b []byte
+	rows := c.rows[min(int(id-1), len(c.rows)):]
+	for i := 0; i < len(rows) && i < int(count); i++ {
+		b = append(b, testRow(int32(int(id)+i), rows[i])...)
+	}
+
+	if len(rows) >= int(count) {
+		return count, &csra.CertTransportBlob{Buffer: b}, nil
+	}
+
+	// the end of the data.
+	b = binary.LittleEndian.AppendUint32(b, 0xFFFFFFFF)
+	b = binary.LittleEndian.AppendUint32(b, 0)
+	b = binary.LittleEndian.AppendUint32(b, 12)
+
+	return uint32(len(rows)), &csra.CertTransportBlob{Buffer: b}, fmt.Errorf("OpenView: %w", hresult.ErrorArithmeticOverflow)
+}
+
+func (c *testAdminDClient) OpenView(ctx context.Context, in *icertadmind.OpenViewRequest, opts ...dcerpc.CallOption) (*icertadmind.OpenViewResponse, error) {
+	c.restrictions = in.CertViewRestrictions
+	n, rows, err := c.page(in.ID, in.Count)
+	return &icertadmind.OpenViewResponse{Fetched: n, ResultRows: rows}, err
+}
+
+func (c *testAdminDClient) EnumView(ctx context.Context, in *icertadmind.EnumViewRequest, opts ...dcerpc.CallOption) (*icertadmind.EnumViewResponse, error) {
+	n, rows, err := c.page(in.ID, in.Count)
+	return &icertadmind.EnumViewResponse{Fetched: n, ResultRows: rows}, err
+}
+
+func (c *testAdminDClient) CloseView(ctx context.Context, in *icertadmind.CloseViewRequest, opts ...dcerpc.CallOption) (*icertadmind.CloseViewResponse, error) {
+	c.closed = true
+	return &icertadmind.CloseViewResponse{}, nil
+}
+
+func (c *testAdminDClient) RevokeCertificate(ctx context.Context, in *icertadmind.RevokeCertificateRequest, opts ...dcerpc.CallOption) (*icertadmind.RevokeCertificateResponse, error) {
+	c.revoked = in
+	return &icertadmind.RevokeCertificateResponse{}, nil
+}
+
+func TestAdminQuery(t *testing.T) {
+
+	cli := &testAdminClient{admin: &testAdminDClient{rows: []string{"User", "Machine", "User", "User", "WebServer"}}}
+	admin := NewAdmin(cli, nil, "Test CA")
+
+	q := csra.NewQuery(csra.TableRequest).
+		Select(csra.ColumnRequestID, csra.ColumnCertificateTemplate).
+		RequestID(csra.SeekGE, 1).
+		Template("User").
+		ExpiresBetween(time.Now(), time.Time{}).
+		OrderBy(csra.ColumnRequestID, csra.SortDescending).
+		Limit(2)
+
+	var ids []int32
+	for rec, err := range admin.Query(context.Background(), q) {
+		if err != nil {
+			t.Fatalf("query: %v", err)
+		}
+		ids = append(ids, rec.Values[csra.ColumnRequestID].(int32))
+		if rec.Values[csra.ColumnCertificateTemplate] != cli.admin.rows[rec.RowID-1] {
+			t.Errorf("query: row %d: unexpected template %v", rec.RowID, rec.Values)
+		}
+	}
+
+	if fmt.Sprint(ids) != "[1 2 3 4 5]" || fmt.Sprint(cli.admin.fetches) != "[1 3 5]" || !cli.admin.closed {
+		t.Fatalf("query: unexpected paging: ids %v, fetches %v", ids, cli.admin.fetches)
+	}
+
+	r := cli.admin.restrictions
+	if len(r) != 3 || r[0].ColumnIndex != 0x1 || r[0].SortOrder != int32(csra.SortDescending) || r[2].ValueLength != 8 {
+		t.Fatalf("query: unexpected restrictions %+v", r)
+	}
+
+	if tmpl, _ := utf16le.Encode("User\x00"); string(r[1].Value) != string(tmpl) || r[1].SeekOperator != int32(csra.SeekEQ) {
+		t.Errorf("query: unexpected template restriction %+v", r[1])
+	}
+
+	// the iteration stops early.
+	cli.admin.fetches, cli.admin.closed = nil, false
+
+	for range admin.Query(context.Background(), csra.NewQuery(csra.TableRequest).Limit(2)) {
+		break
+	}
+
+	if len(cli.admin.fetches) != 1 || !cli.admin.closed {
+		t.Errorf("query: view is not closed after break")
+	}
+
+	if _, _, err := csra.NewQuery(csra.TableRequest).Where("Unknown", csra.SeekEQ, 1).Prepare(testColumns); err == nil {
+		t.Errorf("prepare: expected unknown column error")
+	}
+}
+
+func TestAdminOperations(t *testing.T) {
+
+	ctx := context.Background()
+
+	cli := &testAdminClient{admin: &testAdminDClient{}, entries: map[string]*oaut.Variant{}}
+	admin := NewAdmin(cli, nil, "Test CA")
+
+	if err := admin.Unrevoke(ctx, "1a00000001"); err != nil {
+		t.Fatalf("unrevoke: %v", err)
+	}
+
+	if cli.admin.revoked.Reason != RevokeReasonUnrevoke || cli.admin.revoked.SerialNumber != "1a00000001" {
+		t.Errorf("unrevoke: unexpected request %+v", cli.admin.revoked)
+	}
+
+	for entry, value := range map[string]any{"CRLPeriodUnits": 2, "CRLPeriod": "Weeks"} {
+		if err := admin.SetConfigEntry(ctx, "", entry, value); err != nil {
+			t.Fatalf("set config entry: %v", err)
+		}
+		v, err := admin.ConfigEntry(ctx, "", entry)
+		if err != nil {
+			t.Fatalf("config entry: %v", err)
+		}
+		if fmt.Sprint(v) != fmt.Sprint(value) {
+			t.Errorf("config entry %s: expected %v, got %v", entry, value, v)
+		}
+	}
+
+	if err := admin.SetConfigEntry(ctx, "", "Invalid", 1.5); err == nil {
+		t.Errorf("set config entry: expected unsupported type error")
+	}
+}
@@ -0,0 +1,318 @@
+package csra
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp/filetime"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+// The database tables (CVRC_TABLE_*).
+const (
+	TableRequest   uint32 = 0x00000000
+	TableExtension uint32 = 0x00003000
+	TableAttribute uint32 = 0x00004000
+	TableCRL       uint32 = 0x00005000
+)
+
+// The request table disposition values (DB_DISP_*).
+const (
+	DBDispositionActive      uint32 = 8
+	DBDispositionPending     uint32 = 9
+	DBDispositionForeign     uint32 = 12
+	DBDispositionCACert      uint32 = 15
+	DBDispositionCACertChain uint32 = 16
+	DBDispositionKRACert     uint32 = 17
+	DBDispositionIssued      uint32 = 20
+	DBDispositionRevoked     uint32 = 21
+	DBDispositionError       uint32 = 30
+	DBDispositionDenied      uint32 = 31
+)
+
+// The request table column names.
+const (
+	ColumnRequestID           = "Request.RequestID"
+	ColumnDisposition         = "Request.Disposition"
+	ColumnDispositionMessage  = "Request.DispositionMessage"
+	ColumnSubmittedWhen       = "Request.SubmittedWhen"
+	ColumnResolvedWhen        = "Request.ResolvedWhen"
+	ColumnRevokedWhen         = "Request.RevokedWhen"
+	ColumnRevokedReason       = "Request.RevokedReason"
+	ColumnRequesterName       = "Request.RequesterName"
+	ColumnSerialNumber        = "SerialNumber"
+	ColumnCertificateTemplate = "CertificateTemplate"
+	ColumnCommonName          = "CommonName"
+	ColumnNotBefore           = "NotBefore"
+	ColumnNotAfter            = "NotAfter"
+	ColumnRawCertificate      = "RawCertificate"
+)
+
+// SeekOperator is the restriction operator (CVR_SEEK_*).
+type SeekOperator int32
+
+const (
+	SeekEQ SeekOperator = 0x00000001
+	SeekLT SeekOperator = 0x00000002
+	SeekLE SeekOperator = 0x00000004
+	SeekGE SeekOperator = 0x00000008
+	SeekGT SeekOperator = 0x00000010
+)
+
+// SortOrder is the restriction sort order (CVR_SORT_*).
+type SortOrder int32
+
+const (
+	SortNone       SortOrder = 0x00000000
+	SortAscending  SortOrder = 0x00000001
+	SortDescending SortOrder = 0x00000002
+)
+
+// DefaultPageSize is the number of rows fetched with a single OpenView or
+// EnumView call.
+const DefaultPageSize = 100
+
+// Restriction is the database view restriction on the named column.
+type Restriction struct {
+	// The column name.
+	Column string `json:"column"`
+	// The seek operator.
+	Operator SeekOperator `json:"operator"`
+	// The sort order.
+	Sort SortOrder `json:"sort,omitempty"`
+	// The value: the integer for the signed integer columns, time.Time for the
+	// timestamp columns, string or []byte. Nil matches all rows (used for
+	// sorting).
+	Value any `json:"value,omitempty"`
+}
+
+// Query is the database view query.
+type Query struct {
+	// The table (TableRequest, ...).
+	Table uint32 `json:"table"`
+	// The column names to return, all table columns if empty.
+	Columns []string `json:"columns,omitempty"`
+	// The restrictions.
+	Restrictions []*Restriction `json:"restrictions,omitempty"`
+	// The page size, DefaultPageSize if zero.
+	PageSize uint32 `json:"page_size,omitempty"`
+}
+
+// NewQuery function returns the query for the table.
+func NewQuery(table uint32) *Query {
+	return &Query{Table: table}
+}
+
+// Select function sets the columns to return.
+func (q *Query) Select(columns ...string) *Query {
+	q.Columns = append(q.Columns, columns...)
+	return q
+}
+
+// Where function adds the restriction on the column.
+func (q *Query) Where(column string, op SeekOperator, value any) *Query {
+	q.Restrictions = append(q.Restrictions, &Restriction{Column: column, Operator: op, Value: value})
+	return q
+}
+
+// OrderBy function sets the sort order for the column. The sort order is set
+// on the first restriction on the column, if any, otherwise the restriction
+// that matches all rows is added.
+func (q *Query) OrderBy(column string, order SortOrder) *Query {
+	for _, r := range q.Restrictions {
+		if strings.EqualFold(r.Column, column) {
+			r.Sort = order
+			return q
+		}
+	}
+	q.Restrictions = append(q.Restrictions, &Restriction{Column: column, Operator: SeekGE, Sort: order})
+	return q
+}
+
+// Limit function sets the page size.
+func (q *Query) Limit(n uint32) *Query {
+	q.PageSize = n
+	return q
+}
+
+// RequestID function adds the restriction on the request identifier.
+func (q *Query) RequestID(op SeekOperator, id uint32) *Query {
+	return q.Where(ColumnRequestID, op, int32(id))
+}
+
+// Disposition function adds the restriction on the request disposition
+// (DBDisposition*).
+func (q *Query) Disposition(disposition uint32) *Query {
+	return q.Where(ColumnDisposition, SeekEQ, int32(disposition))
+}
+
+// Template function adds the restriction on the certificate template (the
+// name for the v1 templates or the OID for the v2 templates).
+func (q *Query) Template(template string) *Query {
+	return q.Where(ColumnCertificateTemplate, SeekEQ, template)
+}
+
+// SubmittedBetween function adds the restriction on the request submission
+// time, zero time means no bound.
+func (q *Query) SubmittedBetween(from, to time.Time) *Query {
+	return q.between(ColumnSubmittedWhen, from, to)
+}
+
+// ExpiresBetween function adds the restriction on the certificate expiration
+// time, zero time means no bound.
+func (q *Query) ExpiresBetween(from, to time.Time) *Query {
+	return q.between(ColumnNotAfter, from, to)
+}
+
+func (q *Query) between(column string, from, to time.Time) *Query {
+	if !from.IsZero() {
+		q.Where(column, SeekGE, from)
+	}
+	if !to.IsZero() {
+		q.Where(column, SeekLT, to)
+	}
+	return q
+}
+
+// Prepare function resolves the query column names against the table columns
+// and returns the view restrictions and the output column indexes.
+func (q *Query) Prepare(columns []*Column) ([]*CertViewRestriction, []uint32, error) {
+
+	byName := make(map[string]*Column, len(columns))
+	for _, col := range columns {
+		byName[strings.ToLower(col.Name)] = col
+	}
+
+	lookup := func(name string) (*Column, error) {
+		col, ok := byName[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("csra: query: unknown column %q", name)
+		}
+		return col, nil
+	}
+
+	var out []uint32
+	if len(q.Columns) == 0 {
+		for _, col := range columns {
+			out = append(out, col.Index)
+		}
+	}
+
+	for _, name := range q.Columns {
+		col, err := lookup(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, col.Index)
+	}
+
+	var restrictions []*CertViewRestriction
+
+	for _, r := range q.Restrictions {
+		col, err := lookup(r.Column)
+		if err != nil {
+			return nil, nil, err
+		}
+		b, err := EncodeColumnValue(col.Type, r.Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("csra: query: column %q: %w", r.Column, err)
+		}
+		restrictions = append(restrictions, &CertViewRestriction{
+			ColumnIndex:  col.Index,
+			SeekOperator: int32(r.Operator),
+			SortOrder:    int32(r.Sort),
+			Value:        b,
+			ValueLength:  uint32(len(b)),
+		})
+	}
+
+	return restrictions, out, nil
+}
+
+// EncodeColumnValue function encodes the restriction value for the column
+// type. The nil value is encoded as the minimal value of the type.
+func EncodeColumnValue(colType ColumnType, v any) ([]byte, error) {
+
+	switch colType {
+	case ColumnTypeSignedInteger:
+		var i int64
+		switch v := v.(type) {
+		case nil:
+			i = -1 << 31
+		case int:
+			i = int64(v)
+		case int32:
+			i = int64(v)
+		case uint32:
+			i = int64(int32(v))
+		case int64:
+			i = v
+		default:
+			return nil, fmt.Errorf("unexpected integer value %T", v)
+		}
+		return binary.LittleEndian.AppendUint32(nil, uint32(i)), nil
+	case ColumnTypeTimestamp:
+		switch v := v.(type) {
+		case nil:
+			return make([]byte, 8), nil
+		case time.Time:
+			ft := filetime.FromTime(v)
+			return binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(nil, ft.LowDateTime), ft.HighDateTime), nil
+		default:
+			return nil, fmt.Errorf("unexpected timestamp value %T", v)
+		}
+	case ColumnTypeString:
+		switch v := v.(type) {
+		case nil:
+			return []byte{0, 0}, nil
+		case string:
+			return utf16le.Encode(v + "\x00")
+		default:
+			return nil, fmt.Errorf("unexpected string value %T", v)
+		}
+	case ColumnTypeBinary:
+		switch v := v.(type) {
+		case nil:
+			return []byte{}, nil
+		case []byte:
+			return v, nil
+		default:
+			return nil, fmt.Errorf("unexpected binary value %T", v)
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected column type %d", colType)
+}
+
+// Record is the database row with the decoded column values.
+type Record struct {
+	// The row identifier.
+	RowID uint32 `json:"row_id"`
+	// The column name to the value.
+	Values map[string]any `json:"values"`
+}
+
+// Record function returns the row values keyed by the column names. The
+// columns must contain the column definitions for the row column indexes.
+func (o *Row) Record(columns map[uint32]*Column) (*Record, error) {
+
+	ret := &Record{RowID: o.ID, Values: make(map[string]any, len(o.Columns))}
+
+	for _, col := range o.Columns {
+		def, ok := columns[col.Index]
+		if !ok {
+			return nil, fmt.Errorf("csra: row %d: unknown column %d", o.ID, col.Index)
+		}
+		v, err := col.Value()
+		if err != nil {
+			return nil, fmt.Errorf("csra: row %d: column %q: %w", o.ID, def.Name, err)
+		}
+		if v != nil {
+			ret.Values[def.Name] = v
+		}
+	}
+
+	return ret, nil
+}
@@ -6,12 +6,18 @@ import (
 
 func (o *IPID) MarshalJSON() ([]byte, error) { return o.GUID().MarshalJSON() }
 
+func (o *IPID) UnmarshalJSON(b []byte) error { return o.GUID().UnmarshalJSON(b) }
+
 func (o *IID) MarshalJSON() ([]byte, error) { return o.GUID().MarshalJSON() }
 
+func (o *IID) UnmarshalJSON(b []byte) error { return o.GUID().UnmarshalJSON(b) }
+
 func (o *IID) String() string { return o.GUID().String() }
 
 func (o *ClassID) MarshalJSON() ([]byte, error) { return o.GUID().MarshalJSON() }
 
+func (o *ClassID) UnmarshalJSON(b []byte) error { return o.GUID().UnmarshalJSON(b) }
+
 func (o *ClassID) String() string { return o.GUID().String() }
 
 func (o *IPID) UUID() *uuid.UUID { return o.GUID().UUID() }
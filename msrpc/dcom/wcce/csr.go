@@ -0,0 +1,350 @@
+package wcce
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"net/url"
+	"sort"
+	"unicode/utf16"
+)
+
+var (
+	// szOID_ENROLL_CERTTYPE_EXTENSION (the v1 template name).
+	oidCertificateTemplateName = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2}
+	// szOID_CERTIFICATE_TEMPLATE (the v2 template OID).
+	oidCertificateTemplate = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 21, 7}
+	// szOID_NTDS_CA_SECURITY_EXT (the requester SID).
+	oidNTDSCASecurityExt = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 25, 2}
+	oidNTDSObjectSID     = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 25, 2, 1}
+	// szOID_NT_PRINCIPAL_NAME (the UPN other name).
+	oidNTPrincipalName = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+	// szOID_ENROLLMENT_NAME_VALUE_PAIR.
+	oidEnrollmentNameValuePair = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 13, 2, 1}
+
+	oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidRSAEncryption = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+)
+
+// DefaultKeySize is the RSA key size used when the CSR key is not set.
+const DefaultKeySize = 2048
+
+// CSR is the PKCS#10 certificate signing request template.
+type CSR struct {
+	// The subject name.
+	Subject pkix.Name
+	// The DNS names (subject alternative name).
+	DNSNames []string
+	// The user principal names (subject alternative name).
+	UPNs []string
+	// The e-mail addresses (subject alternative name).
+	EmailAddresses []string
+	// The URIs (subject alternative name).
+	URIs []*url.URL
+	// The requester SID (szOID_NTDS_CA_SECURITY_EXT extension), optional.
+	SID string
+	// The v1 certificate template name (szOID_ENROLL_CERTTYPE_EXTENSION), optional.
+	TemplateName string
+	// The v2 certificate template OID (szOID_CERTIFICATE_TEMPLATE), optional.
+	TemplateOID asn1.ObjectIdentifier
+	// The v2 certificate template version.
+	TemplateMajorVersion, TemplateMinorVersion int
+	// The extra extensions.
+	ExtraExtensions []pkix.Extension
+	// The private key, the RSA key of KeySize bits is generated if not set.
+	Key crypto.Signer
+	// The RSA key size, DefaultKeySize if not set.
+	KeySize int
+}
+
+// Create function returns the DER-encoded CSR and the private key.
+func (o *CSR) Create() ([]byte, crypto.Signer, error) {
+
+	key := o.Key
+	if key == nil {
+		size := o.KeySize
+		if size == 0 {
+			size = DefaultKeySize
+		}
+		rsaKey, err := rsa.GenerateKey(rand.Reader, size)
+		if err != nil {
+			return nil, nil, fmt.Errorf("wcce: generate key: %w", err)
+		}
+		key = rsaKey
+	}
+
+	exts, err := o.extensions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:         o.Subject,
+		ExtraExtensions: exts,
+	}, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wcce: create certificate request: %w", err)
+	}
+
+	return csr, key, nil
+}
+
+func (o *CSR) extensions() ([]pkix.Extension, error) {
+
+	var exts []pkix.Extension
+
+	if len(o.DNSNames)+len(o.UPNs)+len(o.EmailAddresses)+len(o.URIs) > 0 {
+		ext, err := marshalSubjectAltName(o.DNSNames, o.UPNs, o.EmailAddresses, o.URIs)
+		if err != nil {
+			return nil, err
+		}
+		exts = append(exts, ext)
+	}
+
+	if o.TemplateName != "" {
+		b, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagBMPString, Bytes: bmpString(o.TemplateName)})
+		if err != nil {
+			return nil, fmt.Errorf("wcce: marshal template name: %w", err)
+		}
+		exts = append(exts, pkix.Extension{Id: oidCertificateTemplateName, Value: b})
+	}
+
+	if len(o.TemplateOID) > 0 {
+		b, err := asn1.Marshal(struct {
+			ID    asn1.ObjectIdentifier
+			Major int
+			Minor int
+		}{o.TemplateOID, o.TemplateMajorVersion, o.TemplateMinorVersion})
+		if err != nil {
+			return nil, fmt.Errorf("wcce: marshal template: %w", err)
+		}
+		exts = append(exts, pkix.Extension{Id: oidCertificateTemplate, Value: b})
+	}
+
+	if o.SID != "" {
+		b, err := asn1.Marshal([]asn1.RawValue{otherName(oidNTDSObjectSID, asn1.RawValue{Tag: asn1.TagOctetString, Bytes: []byte(o.SID)})})
+		if err != nil {
+			return nil, fmt.Errorf("wcce: marshal sid: %w", err)
+		}
+		exts = append(exts, pkix.Extension{Id: oidNTDSCASecurityExt, Value: b})
+	}
+
+	return append(exts, o.ExtraExtensions...), nil
+}
+
+// marshalSubjectAltName function returns the subject alternative name
+// extension with the UPN other names.
+func marshalSubjectAltName(dnsNames, upns, emails []string, uris []*url.URL) (pkix.Extension, error) {
+
+	var names []asn1.RawValue
+
+	for _, upn := range upns {
+		utf8, err := asn1.MarshalWithParams(upn, "utf8")
+		if err != nil {
+			return pkix.Extension{}, fmt.Errorf("wcce: marshal upn: %w", err)
+		}
+		names = append(names, otherName(oidNTPrincipalName, asn1.RawValue{FullBytes: utf8}))
+	}
+
+	for _, email := range emails {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 1, Bytes: []byte(email)})
+	}
+
+	for _, name := range dnsNames {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte(name)})
+	}
+
+	for _, uri := range uris {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 6, Bytes: []byte(uri.String())})
+	}
+
+	b, err := asn1.Marshal(names)
+	if err != nil {
+		return pkix.Extension{}, fmt.Errorf("wcce: marshal subject alt name: %w", err)
+	}
+
+	return pkix.Extension{Id: oidSubjectAltName, Value: b}, nil
+}
+
+// otherName function returns the otherName general name ([0] IMPLICIT
+// SEQUENCE { type-id, [0] EXPLICIT value }).
+func otherName(id asn1.ObjectIdentifier, value asn1.RawValue) asn1.RawValue {
+	oid, _ := asn1.Marshal(id)
+	v := mustMarshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: mustMarshal(value)})
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: append(oid, v...)}
+}
+
+func mustMarshal(v asn1.RawValue) []byte {
+	b, _ := asn1.Marshal(v)
+	return b
+}
+
+// bmpString function returns the UTF-16BE encoded string.
+func bmpString(s string) []byte {
+	u := utf16.Encode([]rune(s))
+	b := make([]byte, 0, len(u)*2)
+	for _, c := range u {
+		b = append(b, byte(c>>8), byte(c))
+	}
+	return b
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber asn1.RawValue
+}
+
+type signerInfo struct {
+	Version            int
+	SID                issuerAndSerialNumber
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+}
+
+type encapsulatedContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	SignerInfos      asn1.RawValue
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"optional,tag:0"`
+}
+
+// OnBehalfOf function wraps the CSR into the CMS signed data signed by the
+// enrollment agent certificate. The requester name (DOMAIN\user) is set as
+// the signed enrollment name-value pair attribute. The result is submitted
+// with the RequesterName set by the CA from the signature.
+func OnBehalfOf(csr []byte, requester string, agent *x509.Certificate, agentKey crypto.Signer) ([]byte, error) {
+
+	rsaKey, ok := agentKey.Public().(*rsa.PublicKey)
+	if !ok || rsaKey == nil {
+		return nil, fmt.Errorf("wcce: on behalf of: only rsa enrollment agent keys are supported")
+	}
+
+	digest := sha256.Sum256(csr)
+
+	pair, err := asn1.Marshal(struct {
+		Name  asn1.RawValue
+		Value asn1.RawValue
+	}{
+		asn1.RawValue{Tag: asn1.TagBMPString, Bytes: bmpString("requestername")},
+		asn1.RawValue{Tag: asn1.TagBMPString, Bytes: bmpString(requester)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wcce: on behalf of: marshal requester name: %w", err)
+	}
+
+	contentType, _ := asn1.Marshal(oidData)
+	messageDigest, _ := asn1.Marshal(digest[:])
+
+	var attrs [][]byte
+	for _, attr := range []attribute{
+		{oidContentType, derSet(contentType)},
+		{oidMessageDigest, derSet(messageDigest)},
+		{oidEnrollmentNameValuePair, derSet(pair)},
+	} {
+		b, err := asn1.Marshal(attr)
+		if err != nil {
+			return nil, fmt.Errorf("wcce: on behalf of: marshal attribute: %w", err)
+		}
+		attrs = append(attrs, b)
+	}
+
+	signedAttrs := derSet(attrs...)
+
+	// the signature is calculated over the DER SET OF the attributes.
+	h := sha256.Sum256(mustMarshal(signedAttrs))
+	sig, err := agentKey.Sign(rand.Reader, h[:], crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("wcce: on behalf of: sign: %w", err)
+	}
+
+	// the signed attributes are [0] IMPLICIT.
+	signedAttrs.Class, signedAttrs.Tag = asn1.ClassContextSpecific, 0
+
+	sha256Alg := pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}
+
+	si, err := asn1.Marshal(signerInfo{
+		Version: 1,
+		SID: issuerAndSerialNumber{
+			Issuer:       asn1.RawValue{FullBytes: agent.RawIssuer},
+			SerialNumber: asn1.RawValue{FullBytes: mustMarshalInt(agent)},
+		},
+		DigestAlgorithm:    sha256Alg,
+		SignedAttrs:        signedAttrs,
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1.NullRawValue},
+		Signature:          sig,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wcce: on behalf of: marshal signer info: %w", err)
+	}
+
+	algs, _ := asn1.Marshal(sha256Alg)
+	content, _ := asn1.Marshal(csr)
+
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: derSet(algs),
+		EncapContentInfo: encapsulatedContentInfo{ContentType: oidData, Content: explicit(content)},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: agent.Raw},
+		SignerInfos:      derSet(si),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wcce: on behalf of: marshal signed data: %w", err)
+	}
+
+	b, err := asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: explicit(sd)})
+	if err != nil {
+		return nil, fmt.Errorf("wcce: on behalf of: marshal content info: %w", err)
+	}
+
+	return b, nil
+}
+
+func mustMarshalInt(cert *x509.Certificate) []byte {
+	b, _ := asn1.Marshal(cert.SerialNumber)
+	return b
+}
+
+// explicit function returns the [0] EXPLICIT encoded element.
+func explicit(b []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: b}
+}
+
+// derSet function returns the DER SET OF the encoded elements (sorted).
+func derSet(elems ...[]byte) asn1.RawValue {
+	elems = append([][]byte(nil), elems...)
+	sort.Slice(elems, func(i, j int) bool { return string(elems[i]) < string(elems[j]) })
+	var b []byte
+	for _, elem := range elems {
+		b = append(b, elem...)
+	}
+	return asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: b}
+}
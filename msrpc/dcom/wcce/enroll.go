@@ -0,0 +1,539 @@
+package wcce
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+// The request dispositions (CR_DISP_*).
+const (
+	DispositionIncomplete      uint32 = 0x00000000
+	DispositionError           uint32 = 0x00000001
+	DispositionDenied          uint32 = 0x00000002
+	DispositionIssued          uint32 = 0x00000003
+	DispositionIssuedOutOfBand uint32 = 0x00000004
+	DispositionUnderSubmission uint32 = 0x00000005
+	DispositionRevoked         uint32 = 0x00000006
+)
+
+// The request flags (CR_IN_*).
+const (
+	RequestBase64Header uint32 = 0x00000000
+	RequestBase64       uint32 = 0x00000001
+	RequestBinary       uint32 = 0x00000002
+	RequestFormatAny    uint32 = 0x00000000
+	RequestPKCS10       uint32 = 0x00000100
+	RequestKeygen       uint32 = 0x00000200
+	RequestPKCS7        uint32 = 0x00000300
+	RequestCMC          uint32 = 0x00000400
+	RequestFullResponse uint32 = 0x00040000
+	RequestRPC          uint32 = 0x00020000
+)
+
+// The CA property identifiers (CR_PROP_*).
+const (
+	PropertyFileVersion       int32 = 0x00000001
+	PropertyProductVersion    int32 = 0x00000002
+	PropertyExitCount         int32 = 0x00000003
+	PropertyExitDescription   int32 = 0x00000004
+	PropertyPolicyDescription int32 = 0x00000005
+	PropertyCAName            int32 = 0x00000006
+	PropertySanitizedCAName   int32 = 0x00000007
+	PropertySharedFolder      int32 = 0x00000008
+	PropertyParentCA          int32 = 0x00000009
+	PropertyCAType            int32 = 0x0000000A
+	PropertyCASigCertCount    int32 = 0x0000000B
+	PropertyCASigCert         int32 = 0x0000000C
+	PropertyCASigCertChain    int32 = 0x0000000D
+	PropertyCAXchgCertCount   int32 = 0x0000000E
+	PropertyCAXchgCert        int32 = 0x0000000F
+	PropertyCAXchgCertChain   int32 = 0x00000010
+	PropertyBaseCRL           int32 = 0x00000011
+	PropertyDeltaCRL          int32 = 0x00000012
+	PropertyCACertState       int32 = 0x00000013
+	PropertyCRLState          int32 = 0x00000014
+	PropertyCAPropIDMax       int32 = 0x00000015
+	PropertyDNSName           int32 = 0x00000016
+	PropertyKRACertUsedCount  int32 = 0x00000018
+	PropertyKRACertCount      int32 = 0x00000019
+	PropertyKRACert           int32 = 0x0000001A
+	PropertyKRACertState      int32 = 0x0000001B
+	PropertyAdvancedServer    int32 = 0x0000001C
+	PropertyTemplates         int32 = 0x0000001D
+	PropertyCACertVersion     int32 = 0x00000027
+	PropertyCertCDPURLs       int32 = 0x00000029
+	PropertyCertAIAURLs       int32 = 0x0000002A
+	PropertyCertAIAOCSPURLs   int32 = 0x0000002B
+	PropertyLocaleName        int32 = 0x0000002C
+)
+
+// The CA property types (PROPTYPE_*).
+const (
+	PropertyTypeLong   int32 = 0x00000001
+	PropertyTypeDate   int32 = 0x00000002
+	PropertyTypeBinary int32 = 0x00000003
+	PropertyTypeString int32 = 0x00000004
+)
+
+// SubmitRequest is the raw certificate request (ICertPassage::CertServerRequest,
+// ICertRequestD::Request and ICertRequestD2::Request2).
+type SubmitRequest struct {
+	// The request flags (CR_IN_*).
+	Flags uint32
+	// The request identifier to retrieve the pending request, zero for the
+	// new request.
+	RequestID uint32
+	// The request attributes ("name:value" pairs separated by the new line).
+	Attributes string
+	// The request (PKCS#10, PKCS#7 or CMC), empty to retrieve the pending request.
+	Request []byte
+}
+
+// SubmitResponse is the raw certificate response.
+type SubmitResponse struct {
+	// The request identifier.
+	RequestID uint32
+	// The request disposition (CR_DISP_*) or the HRESULT error.
+	Disposition uint32
+	// The PKCS#7 certificate chain or the CMC full response.
+	CertChain []byte
+	// The DER-encoded issued certificate.
+	EncodedCert []byte
+	// The disposition message.
+	DispositionMessage string
+}
+
+// Submitter submits the certificate requests to the CA.
+type Submitter interface {
+	// Submit function submits the request to the authority (the CA name).
+	Submit(context.Context, string, *SubmitRequest) (*SubmitResponse, error)
+}
+
+// CAPropertyReader reads the CA properties (ICertRequestD2::GetCAProperty).
+type CAPropertyReader interface {
+	// CAProperty function returns the raw property value.
+	CAProperty(ctx context.Context, authority string, id, index, typ int32) ([]byte, error)
+}
+
+// Enrollment is the certificate enrollment client.
+type Enrollment struct {
+	// The request submitter (see icertpassage.NewSubmitter and
+	// icertrequestd2.NewSubmitter).
+	Submitter Submitter
+	// The CA name.
+	Authority string
+}
+
+// NewEnrollment function returns the certificate enrollment client.
+func NewEnrollment(submitter Submitter, authority string) *Enrollment {
+	return &Enrollment{Submitter: submitter, Authority: authority}
+}
+
+// EnrollRequest is the certificate enrollment request.
+type EnrollRequest struct {
+	// The certificate template name.
+	Template string
+	// The CSR template, used if Request is empty.
+	CSR *CSR
+	// The DER-encoded request (PKCS#10 or the signed request, see OnBehalfOf).
+	Request []byte
+	// The extra request attributes (for example "SAN" or "RequesterName").
+	Attributes map[string]string
+}
+
+// EnrollResponse is the certificate enrollment result.
+type EnrollResponse struct {
+	// The request identifier, used to retrieve the pending certificate.
+	RequestID uint32
+	// The request disposition (CR_DISP_*).
+	Disposition uint32
+	// The disposition message.
+	Message string
+	// The issued certificate.
+	Certificate *x509.Certificate
+	// The certificate chain (the issued certificate first).
+	Chain []*x509.Certificate
+	// The private key, if the request was generated from the CSR template.
+	Key crypto.Signer
+}
+
+// Pending function returns true if the request is pending the CA manager
+// approval, the certificate can be retrieved later with Retrieve.
+func (o *EnrollResponse) Pending() bool {
+	return o.Disposition == DispositionUnderSubmission
+}
+
+// RequestError is the error returned when the request is denied or failed.
+type RequestError struct {
+	// The request identifier.
+	RequestID uint32
+	// The request disposition (CR_DISP_*) or the HRESULT error.
+	Disposition uint32
+	// The disposition message.
+	Message string
+}
+
+func (e *RequestError) Error() string {
+	var s string
+	switch e.Disposition {
+	case DispositionDenied:
+		s = "denied"
+	case DispositionError:
+		s = "failed"
+	case DispositionRevoked:
+		s = "revoked"
+	case DispositionIncomplete:
+		s = "incomplete"
+	default:
+		s = fmt.Sprintf("failed with 0x%08x", e.Disposition)
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("wcce: request %d %s: %s", e.RequestID, s, e.Message)
+	}
+	return fmt.Sprintf("wcce: request %d %s", e.RequestID, s)
+}
+
+// Enroll function submits the certificate request for the template. The
+// pending request is not an error (see EnrollResponse.Pending), the denied
+// or failed request is returned as RequestError.
+func (o *Enrollment) Enroll(ctx context.Context, req *EnrollRequest) (*EnrollResponse, error) {
+
+	var (
+		csr = req.Request
+		key crypto.Signer
+		err error
+	)
+
+	if len(csr) == 0 {
+		if req.CSR == nil {
+			return nil, fmt.Errorf("wcce: enroll: request or csr template is required")
+		}
+		if csr, key, err = req.CSR.Create(); err != nil {
+			return nil, err
+		}
+	}
+
+	attrs := make([]string, 0, len(req.Attributes)+1)
+	if req.Template != "" {
+		attrs = append(attrs, "CertificateTemplate:"+req.Template)
+	}
+	for _, k := range slices.Sorted(maps.Keys(req.Attributes)) {
+		attrs = append(attrs, k+":"+req.Attributes[k])
+	}
+
+	resp, err := o.submit(ctx, &SubmitRequest{
+		Flags:      RequestBinary | RequestFormatAny,
+		Attributes: strings.Join(attrs, "\n"),
+		Request:    csr,
+	})
+	if resp != nil {
+		resp.Key = key
+	}
+
+	return resp, err
+}
+
+// Retrieve function retrieves the certificate for the pending request.
+func (o *Enrollment) Retrieve(ctx context.Context, requestID uint32) (*EnrollResponse, error) {
+	return o.submit(ctx, &SubmitRequest{Flags: RequestBinary, RequestID: requestID})
+}
+
+func (o *Enrollment) submit(ctx context.Context, req *SubmitRequest) (*EnrollResponse, error) {
+
+	resp, err := o.Submitter.Submit(ctx, o.Authority, req)
+	if err != nil && resp == nil {
+		return nil, fmt.Errorf("wcce: submit request: %w", err)
+	}
+
+	ret := &EnrollResponse{
+		RequestID:   resp.RequestID,
+		Disposition: resp.Disposition,
+		Message:     strings.TrimRight(resp.DispositionMessage, "\x00\r\n"),
+	}
+
+	switch resp.Disposition {
+	case DispositionUnderSubmission:
+		return ret, nil
+	case DispositionIssued, DispositionIssuedOutOfBand:
+	default:
+		derr := &RequestError{RequestID: ret.RequestID, Disposition: ret.Disposition, Message: ret.Message}
+		if err != nil {
+			return ret, fmt.Errorf("%w: %w", derr, err)
+		}
+		return ret, derr
+	}
+
+	if len(resp.EncodedCert) > 0 {
+		if ret.Certificate, err = x509.ParseCertificate(resp.EncodedCert); err != nil {
+			return nil, fmt.Errorf("wcce: parse certificate: %w", err)
+		}
+	}
+
+	if len(resp.CertChain) > 0 {
+		certs, err := ParsePKCS7Certificates(resp.CertChain)
+		if err != nil {
+			return nil, err
+		}
+		ret.Chain = orderChain(ret.Certificate, certs)
+	}
+
+	if ret.Certificate == nil && len(ret.Chain) > 0 {
+		ret.Certificate = ret.Chain[0]
+	}
+
+	return ret, nil
+}
+
+// orderChain function returns the chain starting with the leaf certificate.
+func orderChain(leaf *x509.Certificate, certs []*x509.Certificate) []*x509.Certificate {
+
+	if leaf == nil {
+		// the leaf is the certificate that did not issue any other.
+		for _, cert := range certs {
+			issuer := false
+			for _, other := range certs {
+				if other != cert && string(other.RawIssuer) == string(cert.RawSubject) {
+					issuer = true
+				}
+			}
+			if !issuer {
+				leaf = cert
+				break
+			}
+		}
+	}
+
+	if leaf == nil {
+		return certs
+	}
+
+	chain := []*x509.Certificate{leaf}
+	for cur := leaf; len(chain) <= len(certs); {
+		var next *x509.Certificate
+		for _, cert := range certs {
+			if string(cert.RawSubject) == string(cur.RawIssuer) && !cert.Equal(cur) {
+				next = cert
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		chain, cur = append(chain, next), next
+	}
+
+	return chain
+}
+
+// ParsePKCS7Certificates function returns the certificates of the PKCS#7
+// (CMS) signed data, such as the certificate chain or the CMC full response.
+func ParsePKCS7Certificates(b []byte) ([]*x509.Certificate, error) {
+
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(b, &ci); err != nil {
+		return nil, fmt.Errorf("wcce: parse pkcs7: %w", err)
+	}
+
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("wcce: parse pkcs7: unexpected content type %s", ci.ContentType)
+	}
+
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("wcce: parse pkcs7: signed data: %w", err)
+	}
+
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("wcce: parse pkcs7: certificates: %w", err)
+	}
+
+	return certs, nil
+}
+
+// CAProperties is the CA information.
+type CAProperties struct {
+	// The CA name.
+	Name string `json:"name"`
+	// The sanitized CA name.
+	SanitizedName string `json:"sanitized_name"`
+	// The CA server DNS name.
+	DNSName string `json:"dns_name"`
+	// The CA type (ENUM_ENTERPRISE_ROOTCA = 0, ...).
+	Type uint32 `json:"type"`
+	// The CA file version.
+	FileVersion string `json:"file_version,omitempty"`
+	// The CA product version.
+	ProductVersion string `json:"product_version,omitempty"`
+	// The CA signature certificates (the renewals in order).
+	SignatureCerts []*x509.Certificate `json:"-"`
+	// The CA exchange certificate.
+	ExchangeCert *x509.Certificate `json:"-"`
+	// The templates published to the CA.
+	Templates []*CATemplate `json:"templates,omitempty"`
+	// The CDP URLs.
+	CDPURLs []string `json:"cdp_urls,omitempty"`
+	// The AIA URLs.
+	AIAURLs []string `json:"aia_urls,omitempty"`
+	// The OCSP URLs.
+	OCSPURLs []string `json:"ocsp_urls,omitempty"`
+}
+
+// CATemplate is the certificate template published to the CA.
+type CATemplate struct {
+	// The template name.
+	Name string `json:"name"`
+	// The template OID (empty for the v1 templates).
+	OID string `json:"oid,omitempty"`
+}
+
+// CAProperties function returns the CA information, the submitter must
+// implement the CAPropertyReader (ICertRequestD2).
+func (o *Enrollment) CAProperties(ctx context.Context) (*CAProperties, error) {
+	r, ok := o.Submitter.(CAPropertyReader)
+	if !ok {
+		return nil, fmt.Errorf("wcce: ca properties: %T does not support ca properties", o.Submitter)
+	}
+	return ReadCAProperties(ctx, r, o.Authority)
+}
+
+// ReadCAProperties function reads and decodes the CA properties. The optional
+// properties that are not supported by the CA are left empty.
+func ReadCAProperties(ctx context.Context, r CAPropertyReader, authority string) (*CAProperties, error) {
+
+	ret := &CAProperties{}
+
+	var err error
+
+	if ret.Name, err = caString(ctx, r, authority, PropertyCAName, 0); err != nil {
+		return nil, err
+	}
+
+	ret.SanitizedName, _ = caString(ctx, r, authority, PropertySanitizedCAName, 0)
+	ret.DNSName, _ = caString(ctx, r, authority, PropertyDNSName, 0)
+	ret.FileVersion, _ = caString(ctx, r, authority, PropertyFileVersion, 0)
+	ret.ProductVersion, _ = caString(ctx, r, authority, PropertyProductVersion, 0)
+
+	if typ, err := caLong(ctx, r, authority, PropertyCAType, 0); err == nil {
+		ret.Type = typ
+	}
+
+	if n, err := caLong(ctx, r, authority, PropertyCASigCertCount, 0); err == nil {
+		for i := int32(0); i < int32(n); i++ {
+			b, err := r.CAProperty(ctx, authority, PropertyCASigCert, i, PropertyTypeBinary)
+			if err != nil {
+				return nil, fmt.Errorf("wcce: ca signature certificate %d: %w", i, err)
+			}
+			cert, err := x509.ParseCertificate(b)
+			if err != nil {
+				return nil, fmt.Errorf("wcce: ca signature certificate %d: %w", i, err)
+			}
+			ret.SignatureCerts = append(ret.SignatureCerts, cert)
+		}
+	}
+
+	if b, err := r.CAProperty(ctx, authority, PropertyCAXchgCert, 0, PropertyTypeBinary); err == nil {
+		ret.ExchangeCert, _ = x509.ParseCertificate(b)
+	}
+
+	if s, err := caString(ctx, r, authority, PropertyTemplates, 0); err == nil {
+		ret.Templates = ParseCATemplates(s)
+	}
+
+	ret.CDPURLs, _ = caURLs(ctx, r, authority, PropertyCertCDPURLs)
+	ret.AIAURLs, _ = caURLs(ctx, r, authority, PropertyCertAIAURLs)
+	ret.OCSPURLs, _ = caURLs(ctx, r, authority, PropertyCertAIAOCSPURLs)
+
+	return ret, nil
+}
+
+// ParseCATemplates function parses the CR_PROP_TEMPLATES value: the template
+// names and OIDs separated by the new line.
+func ParseCATemplates(s string) []*CATemplate {
+
+	lines := strings.Split(strings.TrimRight(s, "\x00\n"), "\n")
+
+	var ret []*CATemplate
+	for i := 0; i+1 < len(lines); i += 2 {
+		ret = append(ret, &CATemplate{Name: lines[i], OID: lines[i+1]})
+	}
+
+	return ret
+}
+
+func caString(ctx context.Context, r CAPropertyReader, authority string, id, index int32) (string, error) {
+	b, err := r.CAProperty(ctx, authority, id, index, PropertyTypeString)
+	if err != nil {
+		return "", fmt.Errorf("wcce: ca property %d: %w", id, err)
+	}
+	return DecodePropertyString(b)
+}
+
+func caLong(ctx context.Context, r CAPropertyReader, authority string, id, index int32) (uint32, error) {
+	b, err := r.CAProperty(ctx, authority, id, index, PropertyTypeLong)
+	if err != nil {
+		return 0, fmt.Errorf("wcce: ca property %d: %w", id, err)
+	}
+	if len(b) < 4 {
+		return 0, fmt.Errorf("wcce: ca property %d: invalid long value", id)
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+// caURLs function returns the URLs, the value is the multi-line string with
+// the "flags:url" entries.
+func caURLs(ctx context.Context, r CAPropertyReader, authority string, id int32) ([]string, error) {
+
+	s, err := caString(ctx, r, authority, id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			ret = append(ret, line)
+		}
+	}
+
+	return ret, nil
+}
+
+// DecodePropertyString function decodes the NUL-terminated UTF-16LE property value.
+func DecodePropertyString(b []byte) (string, error) {
+	s, err := utf16le.Decode(b)
+	if err != nil {
+		return "", fmt.Errorf("wcce: decode string: %w", err)
+	}
+	return strings.TrimRight(s, "\x00"), nil
+}
+
+// DecodePropertyDate function decodes the FILETIME property value.
+func DecodePropertyDate(b []byte) (time.Time, error) {
+	if len(b) < 8 {
+		return time.Time{}, errors.New("wcce: decode date: invalid value")
+	}
+	ft := &dtyp.Filetime{LowDateTime: binary.LittleEndian.Uint32(b), HighDateTime: binary.LittleEndian.Uint32(b[4:])}
+	return ft.AsTime(), nil
+}
+
+// EncodeAttributes function returns the NUL-terminated UTF-16LE request
+// attributes (ICertPassage).
+func EncodeAttributes(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return utf16le.Encode(s + "\x00")
+}
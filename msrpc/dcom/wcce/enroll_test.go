@@ -0,0 +1,311 @@
+package wcce
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+type testCA struct {
+	cert    *x509.Certificate
+	key     *rsa.PrivateKey
+	pending bool
+	// the request identifier to the request.
+	requests map[uint32]*SubmitRequest
+	// the property identifier to the value.
+	props map[int32][]byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	cert, _ := x509.ParseCertificate(b)
+
+	return &testCA{cert: cert, key: key, requests: map[uint32]*SubmitRequest{}}
+}
+
+func (ca *testCA) issue(csr []byte, serial int64) ([]byte, error) {
+
+	req, err := x509.ParseCertificateRequest(csr)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber:    big.NewInt(serial),
+		Subject:         req.Subject,
+		NotBefore:       time.Now().Add(-time.Hour),
+		NotAfter:        time.Now().Add(time.Hour),
+		ExtraExtensions: req.Extensions,
+	}, ca.cert, req.PublicKey, ca.key)
+}
+
+func (ca *testCA) Submit(ctx context.Context, authority string, req *SubmitRequest) (*SubmitResponse, error) {
+
+	if req.RequestID == 0 {
+		id := uint32(len(ca.requests) + 1)
+		ca.requests[id] = req
+		if ca.pending {
+			return &SubmitResponse{RequestID: id, Disposition: DispositionUnderSubmission, DispositionMessage: "Taken Under Submission"}, nil
+		}
+		req.RequestID = id
+	}
+
+	orig, ok := ca.requests[req.RequestID]
+	if !ok {
+		return &SubmitResponse{RequestID: req.RequestID, Disposition: DispositionError}, errors.New("not found")
+	}
+
+	if !strings.Contains(orig.Attributes, "CertificateTemplate:User") {
+		return &SubmitResponse{RequestID: req.RequestID, Disposition: DispositionDenied, DispositionMessage: "Denied by Policy Module"}, nil
+	}
+
+	cert, err := ca.issue(orig.Request, int64(req.RequestID)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubmitResponse{
+		RequestID:   req.RequestID,
+		Disposition: DispositionIssued,
+		CertChain:   testPKCS7(ca.cert.Raw, cert),
+		EncodedCert: cert,
+	}, nil
+}
+
+func (ca *testCA) CAProperty(ctx context.Context, authority string, id, index, typ int32) ([]byte, error) {
+	b, ok := ca.props[id]
+	if !ok {
+		return nil, errors.New("not supported")
+	}
+	return b, nil
+}
+
+// testPKCS7 function returns the certificates-only PKCS#7.
+func testPKCS7(certs ...[]byte) []byte {
+	var raw []byte
+	for _, cert := range certs {
+		raw = append(raw, cert...)
+	}
+	sd, _ := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: derSet(),
+		EncapContentInfo: encapsulatedContentInfo{ContentType: oidData},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: raw},
+		SignerInfos:      derSet(),
+	})
+	b, _ := asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: explicit(sd)})
+	return b
+}
+
+func TestCSR(t *testing.T) {
+
+	csr, key, err := (&CSR{
+		Subject:      pkix.Name{CommonName: "alice"},
+		DNSNames:     []string{"alice.example.com"},
+		UPNs:         []string{"alice@example.com"},
+		TemplateName: "User",
+		SID:          "S-1-5-21-1-2-3-1104",
+		KeySize:      1024,
+	}).Create()
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	req, err := x509.ParseCertificateRequest(csr)
+	if err != nil {
+		t.Fatalf("parse certificate request: %v", err)
+	}
+
+	if err := req.CheckSignature(); err != nil {
+		t.Fatalf("check signature: %v", err)
+	}
+
+	if !req.PublicKey.(*rsa.PublicKey).Equal(key.Public()) {
+		t.Errorf("create: public key mismatch")
+	}
+
+	if len(req.DNSNames) != 1 || req.DNSNames[0] != "alice.example.com" {
+		t.Errorf("create: unexpected dns names %v", req.DNSNames)
+	}
+
+	found := map[string]bool{}
+	for _, ext := range req.Extensions {
+		found[ext.Id.String()] = true
+	}
+
+	for _, oid := range []asn1.ObjectIdentifier{oidSubjectAltName, oidCertificateTemplateName, oidNTDSCASecurityExt} {
+		if !found[oid.String()] {
+			t.Errorf("create: extension %s is missing", oid)
+		}
+	}
+
+	if !strings.Contains(string(csr), "alice@example.com") {
+		t.Errorf("create: upn is missing")
+	}
+}
+
+func TestOnBehalfOf(t *testing.T) {
+
+	ca := newTestCA(t)
+
+	csr, _, err := (&CSR{Subject: pkix.Name{CommonName: "bob"}, KeySize: 1024}).Create()
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	b, err := OnBehalfOf(csr, `EXAMPLE\bob`, ca.cert, ca.key)
+	if err != nil {
+		t.Fatalf("on behalf of: %v", err)
+	}
+
+	certs, err := ParsePKCS7Certificates(b)
+	if err != nil {
+		t.Fatalf("parse pkcs7: %v", err)
+	}
+
+	if len(certs) != 1 || !certs[0].Equal(ca.cert) {
+		t.Errorf("on behalf of: unexpected certificates %v", certs)
+	}
+
+	if !strings.Contains(string(b), string(csr)) {
+		t.Errorf("on behalf of: csr is not encapsulated")
+	}
+}
+
+func TestEnroll(t *testing.T) {
+
+	ctx := context.Background()
+
+	ca := newTestCA(t)
+	enroll := NewEnrollment(ca, "Test CA")
+
+	resp, err := enroll.Enroll(ctx, &EnrollRequest{
+		Template: "User",
+		CSR:      &CSR{Subject: pkix.Name{CommonName: "alice"}, KeySize: 1024},
+	})
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+
+	if resp.Pending() || resp.Certificate == nil || resp.Key == nil {
+		t.Fatalf("enroll: unexpected response %+v", resp)
+	}
+
+	if len(resp.Chain) != 2 || !resp.Chain[0].Equal(resp.Certificate) || !resp.Chain[1].Equal(ca.cert) {
+		t.Errorf("enroll: unexpected chain %v", resp.Chain)
+	}
+
+	// the pending request is retrieved by the request identifier.
+	ca.pending = true
+
+	resp, err = enroll.Enroll(ctx, &EnrollRequest{
+		Template: "User",
+		CSR:      &CSR{Subject: pkix.Name{CommonName: "carol"}, KeySize: 1024},
+	})
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+
+	if !resp.Pending() || resp.Certificate != nil || resp.Message != "Taken Under Submission" {
+		t.Fatalf("enroll: expected pending, got %+v", resp)
+	}
+
+	retrieved, err := enroll.Retrieve(ctx, resp.RequestID)
+	if err != nil {
+		t.Fatalf("retrieve: %v", err)
+	}
+
+	if retrieved.Certificate == nil || retrieved.Certificate.Subject.CommonName != "carol" {
+		t.Errorf("retrieve: unexpected certificate %v", retrieved.Certificate)
+	}
+
+	// the denied request.
+	ca.pending = false
+
+	_, err = enroll.Enroll(ctx, &EnrollRequest{
+		Template: "Machine",
+		CSR:      &CSR{Subject: pkix.Name{CommonName: "dave"}, KeySize: 1024},
+	})
+
+	var rerr *RequestError
+	if !errors.As(err, &rerr) || rerr.Disposition != DispositionDenied {
+		t.Errorf("enroll: expected denied error, got %v", err)
+	}
+}
+
+func TestReadCAProperties(t *testing.T) {
+
+	ca := newTestCA(t)
+
+	str := func(s string) []byte {
+		b, _ := utf16le.Encode(s + "\x00")
+		return b
+	}
+
+	long := func(v uint32) []byte {
+		return binary.LittleEndian.AppendUint32(nil, v)
+	}
+
+	ca.props = map[int32][]byte{
+		PropertyCAName:          str("Test CA"),
+		PropertyDNSName:         str("ca.example.com"),
+		PropertyCAType:          long(0),
+		PropertyCASigCertCount:  long(1),
+		PropertyCASigCert:       ca.cert.Raw,
+		PropertyTemplates:       str("User\n\nWebServer\n1.3.6.1.4.1.311.21.8.1\n"),
+		PropertyCertCDPURLs:     str("ldap:///CN=Test CA,CN=CDP\nhttp://pki.example.com/test.crl\n"),
+		PropertyCertAIAOCSPURLs: str(""),
+	}
+
+	props, err := NewEnrollment(ca, "Test CA").CAProperties(context.Background())
+	if err != nil {
+		t.Fatalf("ca properties: %v", err)
+	}
+
+	if props.Name != "Test CA" || props.DNSName != "ca.example.com" || props.ExchangeCert != nil {
+		t.Errorf("ca properties: unexpected properties %+v", props)
+	}
+
+	if len(props.SignatureCerts) != 1 || !props.SignatureCerts[0].Equal(ca.cert) {
+		t.Errorf("ca properties: unexpected signature certificates")
+	}
+
+	if len(props.Templates) != 2 || props.Templates[1].Name != "WebServer" || props.Templates[1].OID != "1.3.6.1.4.1.311.21.8.1" {
+		t.Errorf("ca properties: unexpected templates %+v", props.Templates)
+	}
+
+	if len(props.CDPURLs) != 2 || len(props.OCSPURLs) != 0 {
+		t.Errorf("ca properties: unexpected urls %v %v", props.CDPURLs, props.OCSPURLs)
+	}
+}
@@ -0,0 +1,87 @@
+package icertrequestd2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom"
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/wcce"
+)
+
+// Submitter submits the certificate requests with Request2 and reads the CA
+// properties with GetCAProperty.
+type Submitter struct {
+	// The certificate request client.
+	Client CertRequestD2Client
+	// The COM version.
+	Version *dcom.COMVersion
+}
+
+// NewSubmitter function returns the certificate request submitter.
+func NewSubmitter(cli CertRequestD2Client, ver *dcom.COMVersion) *Submitter {
+	return &Submitter{Client: cli, Version: ver}
+}
+
+// NewEnrollment function returns the certificate enrollment client for the CA.
+func NewEnrollment(cli CertRequestD2Client, ver *dcom.COMVersion, authority string) *wcce.Enrollment {
+	return wcce.NewEnrollment(NewSubmitter(cli, ver), authority)
+}
+
+// Submit function submits the request to the CA. The response is returned
+// together with the error if the server returned the disposition.
+func (o *Submitter) Submit(ctx context.Context, authority string, req *wcce.SubmitRequest) (*wcce.SubmitResponse, error) {
+
+	resp, err := o.Client.Request2(ctx, &Request2Request{
+		This:       &dcom.ORPCThis{Version: o.Version},
+		Authority:  authority,
+		Flags:      req.Flags,
+		RequestID:  req.RequestID,
+		Attributes: req.Attributes,
+		Request:    &wcce.CertTransportBlob{Length: uint32(len(req.Request)), Buffer: req.Request},
+	})
+	if resp == nil {
+		return nil, err
+	}
+
+	ret := &wcce.SubmitResponse{
+		RequestID:   resp.RequestID,
+		Disposition: resp.Disposition,
+		CertChain:   blobBytes(resp.FullResponse),
+		EncodedCert: blobBytes(resp.EncodedCert),
+	}
+
+	if msg := blobBytes(resp.DispositionMessage); len(msg) > 0 {
+		ret.DispositionMessage, _ = wcce.DecodePropertyString(msg)
+	}
+
+	return ret, err
+}
+
+// CAProperty function returns the raw CA property value.
+func (o *Submitter) CAProperty(ctx context.Context, authority string, id, index, typ int32) ([]byte, error) {
+
+	resp, err := o.Client.GetCAProperty(ctx, &GetCAPropertyRequest{
+		This:          &dcom.ORPCThis{Version: o.Version},
+		Authority:     authority,
+		PropertyID:    id,
+		PropertyIndex: index,
+		PropertyType:  typ,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("icertrequestd2: get ca property %d: %w", id, err)
+	}
+
+	return blobBytes(resp.PropertyValue), nil
+}
+
+func blobBytes(blob *wcce.CertTransportBlob) []byte {
+	if blob == nil {
+		return nil
+	}
+	return blob.Buffer
+}
+
+var (
+	_ wcce.Submitter        = (*Submitter)(nil)
+	_ wcce.CAPropertyReader = (*Submitter)(nil)
+)
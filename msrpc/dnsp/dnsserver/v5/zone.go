@@ -0,0 +1,441 @@
+package dnsserver
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dnsp"
+)
+
+// CurrentClientVersion is the client version (DNS_RPC_CURRENT_CLIENT_VER) used
+// to select the latest structure versions.
+const CurrentClientVersion = 0x00070000
+
+// The zone types (DNS_ZONE_TYPE_*).
+const (
+	ZoneTypeCache          uint32 = 0x00000000
+	ZoneTypePrimary        uint32 = 0x00000001
+	ZoneTypeSecondary      uint32 = 0x00000002
+	ZoneTypeStub           uint32 = 0x00000003
+	ZoneTypeForwarder      uint32 = 0x00000004
+	ZoneTypeSecondaryCache uint32 = 0x00000005
+)
+
+// The zone dynamic update settings (ZONE_UPDATE_*).
+const (
+	ZoneUpdateOff      uint32 = 0x00000000
+	ZoneUpdateUnsecure uint32 = 0x00000001
+	ZoneUpdateSecure   uint32 = 0x00000002
+)
+
+const (
+	afInet  = 2
+	afInet6 = 23
+)
+
+// Server is the DNS server management facade. It uses the R_Dnssrv*2 methods
+// with the typed structures instead of the raw DNSSRV_RPC_UNION values.
+type Server struct {
+	// The DNS server client.
+	Client DNSServerClient
+	// The server name (optional).
+	ServerName string
+}
+
+// NewServer function returns the DNS server management facade.
+func NewServer(cli DNSServerClient, serverName string) *Server {
+	return &Server{Client: cli, ServerName: serverName}
+}
+
+// ZoneProperties is the zone configuration.
+type ZoneProperties struct {
+	// The zone name.
+	Name string `json:"name"`
+	// The zone type (ZoneTypePrimary, ...).
+	Type uint32 `json:"type"`
+	// The reverse lookup zone.
+	Reverse bool `json:"reverse"`
+	// The dynamic update setting (ZoneUpdateOff, ...).
+	AllowUpdate uint32 `json:"allow_update"`
+	// The zone is paused.
+	Paused bool `json:"paused"`
+	// The zone is shut down.
+	Shutdown bool `json:"shutdown"`
+	// The zone is created automatically.
+	AutoCreated bool `json:"auto_created"`
+	// The zone is stored in the directory.
+	DSIntegrated bool `json:"ds_integrated"`
+	// The zone file name.
+	DataFile string `json:"data_file,omitempty"`
+	// The directory partition FQDN.
+	DirectoryPartition string `json:"directory_partition,omitempty"`
+	// The zone master servers.
+	Masters []net.IP `json:"masters,omitempty"`
+	// The aging properties.
+	Aging *Aging `json:"aging"`
+	// The servers allowed to scavenge the zone.
+	ScavengeServers []net.IP `json:"scavenge_servers,omitempty"`
+	// The read-only zone.
+	ReadOnly bool `json:"read_only"`
+}
+
+// Aging is the zone aging configuration.
+type Aging struct {
+	// The aging is enabled.
+	Enabled bool `json:"enabled"`
+	// The no-refresh interval (the hour precision).
+	NoRefreshInterval time.Duration `json:"no_refresh_interval"`
+	// The refresh interval (the hour precision).
+	RefreshInterval time.Duration `json:"refresh_interval"`
+	// The time after which the zone can be scavenged (read-only).
+	AvailForScavengeTime time.Time `json:"avail_for_scavenge_time,omitempty"`
+}
+
+// Scavenging is the server scavenging and default aging configuration.
+type Scavenging struct {
+	// The scavenging interval, zero disables the scavenging (the hour precision).
+	Interval time.Duration `json:"interval"`
+	// The default aging state of the new zones.
+	DefaultAging bool `json:"default_aging"`
+	// The default no-refresh interval (the hour precision).
+	DefaultNoRefreshInterval time.Duration `json:"default_no_refresh_interval"`
+	// The default refresh interval (the hour precision).
+	DefaultRefreshInterval time.Duration `json:"default_refresh_interval"`
+	// The last scavenging time (read-only).
+	LastScavengeTime time.Time `json:"last_scavenge_time,omitempty"`
+}
+
+// Forwarders is the server forwarders configuration.
+type Forwarders struct {
+	// The forwarder addresses.
+	Addrs []net.IP `json:"addrs"`
+	// The forwarding timeout (the second precision).
+	Timeout time.Duration `json:"timeout"`
+	// Recurse after forwarding (use the root hints).
+	RecurseAfterForwarding bool `json:"recurse_after_forwarding"`
+}
+
+// Zones function returns the zones matching the filter (dnsp.ZoneRequestFilterAll
+// to return all zones) with their properties.
+func (o *Server) Zones(ctx context.Context, filter dnsp.ZoneRequestFilter) ([]*ZoneProperties, error) {
+
+	names, err := o.ZoneNames(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*ZoneProperties, 0, len(names))
+	for _, name := range names {
+		zone, err := o.Zone(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, zone)
+	}
+
+	return ret, nil
+}
+
+// ZoneNames function returns the names of the zones matching the filter.
+func (o *Server) ZoneNames(ctx context.Context, filter dnsp.ZoneRequestFilter) ([]string, error) {
+
+	resp, err := o.Client.ComplexOperation2(ctx, &ComplexOperation2Request{
+		ClientVersion: CurrentClientVersion,
+		ServerName:    o.ServerName,
+		Operation:     "EnumZones",
+		TypeIn:        uint32(dnsp.TypeIDDword),
+		DataIn:        &dnsp.Union{Value: &dnsp.Union_Dword{Dword: uint32(filter)}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dnsserver: enum zones: %w", err)
+	}
+
+	var names []string
+
+	switch list := resp.DataOut.GetValue().(type) {
+	case *dnsp.ZoneList:
+		for _, zone := range list.ZoneArray {
+			names = append(names, zone.ZoneName)
+		}
+	case *dnsp.ZoneListW2K:
+		for _, zone := range list.ZoneArray {
+			names = append(names, zone.ZoneName)
+		}
+	default:
+		return nil, fmt.Errorf("dnsserver: enum zones: unexpected type %d", resp.TypeOut)
+	}
+
+	return names, nil
+}
+
+// Zone function returns the zone properties.
+func (o *Server) Zone(ctx context.Context, zone string) (*ZoneProperties, error) {
+
+	resp, err := o.Client.Query2(ctx, &Query2Request{
+		ClientVersion: CurrentClientVersion,
+		ServerName:    o.ServerName,
+		Zone:          zone,
+		Operation:     "ZoneInfo",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dnsserver: query zone %s: %w", zone, err)
+	}
+
+	info, ok := resp.Data.GetValue().(*dnsp.ZoneInfo)
+	if !ok || info == nil {
+		return nil, fmt.Errorf("dnsserver: query zone %s: unexpected type %d", zone, resp.TypeID)
+	}
+
+	return &ZoneProperties{
+		Name:               info.ZoneName,
+		Type:               info.ZoneType,
+		Reverse:            info.Reverse != 0,
+		AllowUpdate:        info.AllowUpdate,
+		Paused:             info.Paused != 0,
+		Shutdown:           info.Shutdown != 0,
+		AutoCreated:        info.AutoCreated != 0,
+		DSIntegrated:       info.UseDatabase != 0,
+		DataFile:           info.DataFile,
+		DirectoryPartition: info.DPFQDN,
+		Masters:            AddrArrayIPs(info.Masters),
+		Aging: &Aging{
+			Enabled:              info.Aging != 0,
+			NoRefreshInterval:    hours(info.NoRefreshInterval),
+			RefreshInterval:      hours(info.RefreshInterval),
+			AvailForScavengeTime: hoursTime(info.AvailForScavengeTime),
+		},
+		ScavengeServers: AddrArrayIPs(info.ScavengeServers),
+		ReadOnly:        info.ReadOnlyZone,
+	}, nil
+}
+
+// SetZoneAging function updates the zone aging properties.
+func (o *Server) SetZoneAging(ctx context.Context, zone string, aging *Aging) error {
+	return o.resetDwordProperties(ctx, zone, []*dnsp.NameAndParam{
+		{NodeName: "NoRefreshInterval", Param: uint32(aging.NoRefreshInterval / time.Hour)},
+		{NodeName: "RefreshInterval", Param: uint32(aging.RefreshInterval / time.Hour)},
+		{NodeName: "Aging", Param: boolParam(aging.Enabled)},
+	})
+}
+
+// SetZoneAllowUpdate function updates the zone dynamic update setting
+// (ZoneUpdateOff, ...).
+func (o *Server) SetZoneAllowUpdate(ctx context.Context, zone string, allowUpdate uint32) error {
+	return o.resetDwordProperties(ctx, zone, []*dnsp.NameAndParam{
+		{NodeName: "AllowUpdate", Param: allowUpdate},
+	})
+}
+
+// SetZoneScavengeServers function sets the servers allowed to scavenge the
+// zone, the empty list allows all servers.
+func (o *Server) SetZoneScavengeServers(ctx context.Context, zone string, servers []net.IP) error {
+	return o.operation(ctx, zone, "ScavengeServers", dnsp.TypeIDAddrArray, &dnsp.Union{
+		Value: &dnsp.Union_AddrArray{AddrArray: NewAddrArray(servers)},
+	})
+}
+
+// ServerInfo function returns the server configuration.
+func (o *Server) ServerInfo(ctx context.Context) (*dnsp.ServerInfo, error) {
+
+	resp, err := o.Client.Query2(ctx, &Query2Request{
+		ClientVersion: CurrentClientVersion,
+		ServerName:    o.ServerName,
+		Operation:     "ServerInfo",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dnsserver: query server info: %w", err)
+	}
+
+	info, ok := resp.Data.GetValue().(*dnsp.ServerInfo)
+	if !ok || info == nil {
+		return nil, fmt.Errorf("dnsserver: query server info: unexpected type %d", resp.TypeID)
+	}
+
+	return info, nil
+}
+
+// Scavenging function returns the server scavenging configuration.
+func (o *Server) Scavenging(ctx context.Context) (*Scavenging, error) {
+
+	info, err := o.ServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Scavenging{
+		Interval:                 hours(info.ScavengingInterval),
+		DefaultAging:             info.DefaultAgingState,
+		DefaultNoRefreshInterval: hours(info.DefaultNoRefreshInterval),
+		DefaultRefreshInterval:   hours(info.DefaultRefreshInterval),
+		LastScavengeTime:         unixTime(info.LastScavengeTime),
+	}, nil
+}
+
+// SetScavenging function updates the server scavenging configuration.
+func (o *Server) SetScavenging(ctx context.Context, scavenging *Scavenging) error {
+	return o.resetDwordProperties(ctx, "", []*dnsp.NameAndParam{
+		{NodeName: "ScavengingInterval", Param: uint32(scavenging.Interval / time.Hour)},
+		{NodeName: "DefaultAgingState", Param: boolParam(scavenging.DefaultAging)},
+		{NodeName: "DefaultNoRefreshInterval", Param: uint32(scavenging.DefaultNoRefreshInterval / time.Hour)},
+		{NodeName: "DefaultRefreshInterval", Param: uint32(scavenging.DefaultRefreshInterval / time.Hour)},
+	})
+}
+
+// StartScavenging function starts the scavenging on the server.
+func (o *Server) StartScavenging(ctx context.Context) error {
+	return o.operation(ctx, "", "StartScavenging", dnsp.TypeIDNull, &dnsp.Union{Value: &dnsp.Union_Null{}})
+}
+
+// Forwarders function returns the server forwarders.
+func (o *Server) Forwarders(ctx context.Context) (*Forwarders, error) {
+
+	info, err := o.ServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Forwarders{
+		Addrs:                  AddrArrayIPs(info.Forwarders),
+		Timeout:                time.Duration(info.ForwardTimeout) * time.Second,
+		RecurseAfterForwarding: info.RecurseAfterForwarding,
+	}, nil
+}
+
+// SetForwarders function updates the server forwarders.
+func (o *Server) SetForwarders(ctx context.Context, fwd *Forwarders) error {
+	return o.operation(ctx, "", "Forwarders", dnsp.TypeIDForwarders, &dnsp.Union{
+		Value: &dnsp.Union_Forwarders{Forwarders: &dnsp.Forwarders{
+			RPCStructureVersion:    1,
+			RecurseAfterForwarding: boolParam(fwd.RecurseAfterForwarding),
+			ForwardTimeout:         uint32(fwd.Timeout / time.Second),
+			Forwarders:             NewAddrArray(fwd.Addrs),
+		}},
+	})
+}
+
+// resetDwordProperties function updates the server (zone is empty) or the zone
+// properties.
+func (o *Server) resetDwordProperties(ctx context.Context, zone string, params []*dnsp.NameAndParam) error {
+	for _, param := range params {
+		if err := o.operation2(ctx, zone, "ResetDwordProperty", dnsp.TypeIDNameAndParam, &dnsp.Union{
+			Value: &dnsp.Union_NameAndParam{NameAndParam: param},
+		}); err != nil {
+			return operationError(zone, "ResetDwordProperty "+param.NodeName, err)
+		}
+	}
+	return nil
+}
+
+func (o *Server) operation(ctx context.Context, zone, op string, typ dnsp.TypeID, data *dnsp.Union) error {
+	if err := o.operation2(ctx, zone, op, typ, data); err != nil {
+		return operationError(zone, op, err)
+	}
+	return nil
+}
+
+// operation2 function performs the R_DnssrvOperation2 call and returns the
+// unwrapped error.
+func (o *Server) operation2(ctx context.Context, zone, op string, typ dnsp.TypeID, data *dnsp.Union) error {
+	_, err := o.Client.Operation2(ctx, &Operation2Request{
+		ClientVersion: CurrentClientVersion,
+		ServerName:    o.ServerName,
+		Zone:          zone,
+		Operation:     op,
+		TypeID:        uint32(typ),
+		Data:          data,
+	})
+	return err
+}
+
+// operationError function returns the operation error with the zone and the
+// operation context.
+func operationError(zone, op string, err error) error {
+	if zone != "" {
+		return fmt.Errorf("dnsserver: zone %s: %s: %w", zone, op, err)
+	}
+	return fmt.Errorf("dnsserver: %s: %w", op, err)
+}
+
+// AddrArrayIPs function returns the IP addresses of the DNS_ADDR_ARRAY.
+func AddrArrayIPs(addrs *dnsp.AddrArray) []net.IP {
+
+	if addrs == nil {
+		return nil
+	}
+
+	var ret []net.IP
+	for _, addr := range addrs.AddrArray {
+		if addr == nil || len(addr.MaxSA) < 2 {
+			continue
+		}
+		switch sa := addr.MaxSA; binary.LittleEndian.Uint16(sa) {
+		case afInet:
+			if len(sa) >= 8 {
+				ret = append(ret, net.IP(append([]byte(nil), sa[4:8]...)))
+			}
+		case afInet6:
+			if len(sa) >= 24 {
+				ret = append(ret, net.IP(append([]byte(nil), sa[8:24]...)))
+			}
+		}
+	}
+
+	return ret
+}
+
+// NewAddrArray function returns the DNS_ADDR_ARRAY for the IP addresses.
+func NewAddrArray(ips []net.IP) *dnsp.AddrArray {
+
+	ret := &dnsp.AddrArray{
+		MaxCount:  uint32(len(ips)),
+		AddrCount: uint32(len(ips)),
+		Family:    afInet,
+		AddrArray: make([]*dnsp.Addr, 0, len(ips)),
+	}
+
+	for _, ip := range ips {
+		sa := make([]byte, 32)
+		addr := &dnsp.Addr{MaxSA: sa, DNSAddrUser: make([]uint32, 8)}
+		if ip4 := ip.To4(); ip4 != nil {
+			binary.LittleEndian.PutUint16(sa, afInet)
+			copy(sa[4:], ip4)
+			addr.DNSAddrUser[0] = 16
+		} else {
+			binary.LittleEndian.PutUint16(sa, afInet6)
+			copy(sa[8:], ip.To16())
+			addr.DNSAddrUser[0], ret.Family = 28, 0
+		}
+		ret.AddrArray = append(ret.AddrArray, addr)
+	}
+
+	return ret
+}
+
+func boolParam(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func hours(v uint32) time.Duration {
+	return time.Duration(v) * time.Hour
+}
+
+// hoursTime function converts the number of hours since 1601 to the time.
+func hoursTime(v uint32) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Date(1601, 1, 1, 0, 0, 0, 0, time.UTC).Add(hours(v))
+}
+
+func unixTime(v uint32) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(v), 0).UTC()
+}
@@ -0,0 +1,365 @@
+package dnsserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dnsp"
+	"github.com/oiweiwei/go-msrpc/msrpc/dnsp/record"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// The record enumeration view flags (DNS_RPC_VIEW_*).
+const (
+	ViewAuthorityData  uint32 = 0x00000001
+	ViewCacheData      uint32 = 0x00000002
+	ViewGlueData       uint32 = 0x00000004
+	ViewRootHintData   uint32 = 0x00000008
+	ViewAdditionalData uint32 = 0x00000010
+	ViewNoChildren     uint32 = 0x00010000
+	ViewOnlyChildren   uint32 = 0x00020000
+)
+
+// ZoneChange is the record change: the record is added (Delete is nil),
+// deleted (Add is nil) or replaced.
+type ZoneChange struct {
+	// The record to add.
+	Add dns.RR
+	// The record to delete.
+	Delete dns.RR
+}
+
+// String function returns the change in the diff format.
+func (o *ZoneChange) String() string {
+	var s []string
+	if o.Delete != nil {
+		s = append(s, "- "+o.Delete.String())
+	}
+	if o.Add != nil {
+		s = append(s, "+ "+o.Add.String())
+	}
+	return strings.Join(s, "\n")
+}
+
+// Records function returns all zone records, the node tree is walked with
+// R_DnssrvEnumRecords2, the record names are fully qualified.
+func (o *Server) Records(ctx context.Context, zone string) ([]dns.RR, error) {
+
+	var (
+		ret     []dns.RR
+		visited = make(map[string]bool)
+		origin  = dns.Fqdn(zone)
+		queue   = []string{origin}
+	)
+
+	for len(queue) > 0 {
+
+		name := queue[0]
+		queue = queue[1:]
+
+		nodes, err := o.nodes(ctx, zone, name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes {
+
+			fqdn := nodeName(node, name)
+			if visited[fqdn] {
+				continue
+			}
+
+			if len(node.DNSRecords) > 0 || fqdn == name {
+				visited[fqdn] = true
+				for _, rr := range node.RRs() {
+					rr.Header().Name = fqdn
+					ret = append(ret, rr)
+				}
+			}
+
+			if node.ChildCount > 0 && fqdn != name {
+				queue = append(queue, fqdn)
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+// nodes function returns the node and its children. The nodes are paged on
+// ERROR_MORE_DATA starting after the last returned child.
+func (o *Server) nodes(ctx context.Context, zone, name string) ([]*record.Node, error) {
+
+	var (
+		ret        []*record.Node
+		startChild string
+	)
+
+	node := name
+	if strings.EqualFold(name, dns.Fqdn(zone)) {
+		node = "@"
+	}
+
+	for {
+
+		resp, err := o.Client.EnumRecords2(ctx, &EnumRecords2Request{
+			ClientVersion: CurrentClientVersion,
+			ServerName:    o.ServerName,
+			Zone:          zone,
+			NodeName:      node,
+			StartChild:    startChild,
+			RecordType:    dns.TypeANY,
+			SelectFlag:    ViewAuthorityData | ViewGlueData,
+		})
+		if err != nil && (resp == nil || !errors.Is(err, win32.ErrorMoreData)) {
+			if errors.Is(err, win32.ErrorNoMoreItems) {
+				return ret, nil
+			}
+			return nil, fmt.Errorf("dnsserver: enum records %s: %w", name, err)
+		}
+
+		var nodes record.NodeList
+		if err := ndr.Unmarshal(resp.Buffer, &nodes, ndr.Opaque); err != nil {
+			return nil, fmt.Errorf("dnsserver: enum records %s: unmarshal nodes: %w", name, err)
+		}
+
+		ret = append(ret, nodes.DNSNodes...)
+
+		if err == nil || len(nodes.DNSNodes) == 0 {
+			return ret, nil
+		}
+
+		last := nodes.DNSNodes[len(nodes.DNSNodes)-1]
+		if last.DNSNodeName == nil || last.DNSNodeName.String() == startChild {
+			return nil, fmt.Errorf("dnsserver: enum records %s: paging does not advance", name)
+		}
+
+		startChild = last.DNSNodeName.String()
+	}
+}
+
+// nodeName function returns the fully qualified node name. The node name is
+// either empty (the node itself), fully qualified or relative to the parent.
+func nodeName(node *record.Node, parent string) string {
+
+	var name string
+	if node.DNSNodeName != nil {
+		name = strings.TrimRight(node.DNSNodeName.String(), "\x00")
+	}
+
+	switch {
+	case name == "" || name == "@":
+		return parent
+	case dns.IsFqdn(name):
+		return name
+	case parent == ".":
+		return name + "."
+	}
+
+	return name + "." + parent
+}
+
+// ExportZone function writes the zone records in the RFC 1035 zone file
+// format. The SOA record is written first, the other records are sorted
+// by the name and the type.
+func (o *Server) ExportZone(ctx context.Context, zone string, w io.Writer) error {
+
+	rrs, err := o.Records(ctx, zone)
+	if err != nil {
+		return err
+	}
+
+	return WriteZoneFile(w, zone, rrs)
+}
+
+// WriteZoneFile function writes the records in the RFC 1035 zone file format.
+func WriteZoneFile(w io.Writer, zone string, rrs []dns.RR) error {
+
+	rrs = append([]dns.RR(nil), rrs...)
+
+	sort.SliceStable(rrs, func(i, j int) bool {
+		if a, b := rrs[i].Header().Rrtype == dns.TypeSOA, rrs[j].Header().Rrtype == dns.TypeSOA; a != b {
+			return a
+		}
+		if a, b := dns.CanonicalName(rrs[i].Header().Name), dns.CanonicalName(rrs[j].Header().Name); a != b {
+			return compareNames(a, b) < 0
+		}
+		return rrs[i].Header().Rrtype < rrs[j].Header().Rrtype
+	})
+
+	if _, err := fmt.Fprintf(w, "$ORIGIN %s\n", dns.Fqdn(zone)); err != nil {
+		return fmt.Errorf("dnsserver: write zone file: %w", err)
+	}
+
+	for _, rr := range rrs {
+		if _, err := fmt.Fprintln(w, rr.String()); err != nil {
+			return fmt.Errorf("dnsserver: write zone file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// compareNames function compares the names in the canonical DNS order
+// (by the labels from the right).
+func compareNames(a, b string) int {
+
+	la, lb := dns.SplitDomainName(a), dns.SplitDomainName(b)
+
+	for i, j := len(la)-1, len(lb)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if c := strings.Compare(la[i], lb[j]); c != 0 {
+			return c
+		}
+	}
+
+	return len(la) - len(lb)
+}
+
+// ParseZoneFile function parses the RFC 1035 zone file, the relative names
+// are relative to the zone.
+func ParseZoneFile(r io.Reader, zone string) ([]dns.RR, error) {
+
+	zp := dns.NewZoneParser(r, dns.Fqdn(zone), "")
+
+	var rrs []dns.RR
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		rrs = append(rrs, rr)
+	}
+
+	if err := zp.Err(); err != nil {
+		return nil, fmt.Errorf("dnsserver: parse zone file: %w", err)
+	}
+
+	return rrs, nil
+}
+
+// DiffZone function returns the changes to turn the current records into the
+// desired records. The records that differ only in the TTL are replaced, the
+// SOA serial number is ignored (maintained by the server).
+func DiffZone(current, desired []dns.RR) []*ZoneChange {
+
+	cur := make(map[string]dns.RR, len(current))
+	for _, rr := range current {
+		cur[rrKey(rr)] = rr
+	}
+
+	var (
+		ret     []*ZoneChange
+		matched = make(map[string]bool, len(desired))
+	)
+
+	for _, rr := range desired {
+		key := rrKey(rr)
+		if matched[key] {
+			continue
+		}
+		matched[key] = true
+		old, ok := cur[key]
+		switch {
+		case !ok:
+			ret = append(ret, &ZoneChange{Add: rr})
+		case old.Header().Ttl != rr.Header().Ttl || !sameSOA(old, rr):
+			ret = append(ret, &ZoneChange{Add: rr, Delete: old})
+		}
+	}
+
+	for _, rr := range current {
+		if key := rrKey(rr); !matched[key] {
+			matched[key] = true
+			ret = append(ret, &ZoneChange{Delete: rr})
+		}
+	}
+
+	return ret
+}
+
+// rrKey function returns the record identity: the name, type and data. The
+// SOA record is identified by the name only.
+func rrKey(rr dns.RR) string {
+
+	hdr := *rr.Header()
+
+	if hdr.Rrtype == dns.TypeSOA {
+		return dns.CanonicalName(hdr.Name) + " SOA"
+	}
+
+	rr = dns.Copy(rr)
+	*rr.Header() = dns.RR_Header{Name: dns.CanonicalName(hdr.Name), Rrtype: hdr.Rrtype, Class: dns.ClassINET}
+
+	return strings.ToLower(rr.String())
+}
+
+func sameSOA(a, b dns.RR) bool {
+	sa, ok1 := a.(*dns.SOA)
+	sb, ok2 := b.(*dns.SOA)
+	if !ok1 || !ok2 {
+		return true
+	}
+	return strings.EqualFold(sa.Ns, sb.Ns) && strings.EqualFold(sa.Mbox, sb.Mbox) &&
+		sa.Refresh == sb.Refresh && sa.Retry == sb.Retry && sa.Expire == sb.Expire && sa.Minttl == sb.Minttl
+}
+
+// ApplyZone function applies the changes with R_DnssrvUpdateRecord2.
+func (o *Server) ApplyZone(ctx context.Context, zone string, changes []*ZoneChange) error {
+
+	for _, change := range changes {
+
+		req := &UpdateRecord2Request{
+			ClientVersion: CurrentClientVersion,
+			ServerName:    o.ServerName,
+			Zone:          zone,
+		}
+
+		var err error
+
+		if change.Add != nil {
+			req.NodeName = change.Add.Header().Name
+			if req.AddRecord, err = dnsp.NewRecordFromRR(change.Add); err != nil {
+				return fmt.Errorf("dnsserver: apply zone %s: %w", zone, err)
+			}
+		}
+
+		if change.Delete != nil {
+			req.NodeName = change.Delete.Header().Name
+			if req.DeleteRecord, err = dnsp.NewRecordFromRR(change.Delete); err != nil {
+				return fmt.Errorf("dnsserver: apply zone %s: %w", zone, err)
+			}
+		}
+
+		if _, err := o.Client.UpdateRecord2(ctx, req); err != nil {
+			return fmt.Errorf("dnsserver: apply zone %s: update record %s: %w", zone, req.NodeName, err)
+		}
+	}
+
+	return nil
+}
+
+// ImportZone function reads the zone file, computes the difference with the
+// zone records on the server and applies it. The applied changes are returned.
+func (o *Server) ImportZone(ctx context.Context, zone string, r io.Reader) ([]*ZoneChange, error) {
+
+	desired, err := ParseZoneFile(r, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := o.Records(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := DiffZone(current, desired)
+
+	if err := o.ApplyZone(ctx, zone, changes); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,141 @@
+package dnsserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dnsp/record"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+type testZoneClient struct {
+	DNSServerClient
+	// the node name to the node list pages.
+	pages   map[string][][]*record.Node
+	updates []*UpdateRecord2Request
+}
+
+func testNode(t *testing.T, name string, children uint32, rrs ...string) *record.Node {
+	node := &record.Node{DNSNodeName: record.NewNodeName(name), ChildCount: children}
+	for _, s := range rrs {
+		rr, err := dns.NewRR(s)
+		if err != nil {
+			t.Fatalf("new rr: %v", err)
+		}
+		rec, err := record.NewRecordFromRR(rr)
+		if err != nil {
+			t.Fatalf("new record: %v", err)
+		}
+		node.DNSRecords = append(node.DNSRecords, rec)
+	}
+	return node
+}
+
+func (c *testZoneClient) EnumRecords2(ctx context.Context, in *EnumRecords2Request, opts ...dcerpc.CallOption) (*EnumRecords2Response, error) {
+
+	pages := c.pages[in.NodeName]
+
+	page := 0
+	for i := range pages {
+		if last := pages[i][len(pages[i])-1]; last.DNSNodeName.String() == in.StartChild {
+			page = i + 1
+		}
+	}
+
+	b, err := ndr.Marshal(&record.NodeList{DNSNodes: pages[page]}, ndr.Opaque)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &EnumRecords2Response{Buffer: b, BufferLength: uint32(len(b))}
+	if page < len(pages)-1 {
+		return resp, fmt.Errorf("EnumRecords2: %w", win32.ErrorMoreData)
+	}
+
+	return resp, nil
+}
+
+func (c *testZoneClient) UpdateRecord2(ctx context.Context, in *UpdateRecord2Request, opts ...dcerpc.CallOption) (*UpdateRecord2Response, error) {
+	c.updates = append(c.updates, in)
+	return &UpdateRecord2Response{}, nil
+}
+
+func TestExportImportZone(t *testing.T) {
+
+	cli := &testZoneClient{pages: map[string][][]*record.Node{
+		"@": {
+			{
+				testNode(t, "", 2,
+					"example.com. 3600 IN SOA ns1.example.com. hostmaster.example.com. 10 900 600 86400 3600",
+					"example.com. 3600 IN NS ns1.example.com."),
+				testNode(t, "www", 0, "www 300 IN A 192.0.2.10"),
+			},
+			{
+				testNode(t, "lab", 1),
+			},
+		},
+		"lab.example.com.": {
+			{
+				testNode(t, "", 1),
+				testNode(t, "db", 0, "db 600 IN AAAA 2001:db8::1", `db 600 IN TXT "owner=dba"`),
+			},
+		},
+	}}
+
+	srv := NewServer(cli, "")
+
+	var sb strings.Builder
+	if err := srv.ExportZone(context.Background(), "example.com", &sb); err != nil {
+		t.Fatalf("export zone: %v", err)
+	}
+
+	rrs, err := ParseZoneFile(strings.NewReader(sb.String()), "example.com")
+	if err != nil {
+		t.Fatalf("parse zone file: %v\n%s", err, sb.String())
+	}
+
+	if len(rrs) != 5 || rrs[0].Header().Rrtype != dns.TypeSOA || rrs[len(rrs)-1].Header().Name != "www.example.com." {
+		t.Fatalf("export zone: unexpected zone file:\n%s", sb.String())
+	}
+
+	if !strings.Contains(sb.String(), "db.lab.example.com.\t600\tIN\tAAAA\t2001:db8::1") {
+		t.Errorf("export zone: child node records are missing:\n%s", sb.String())
+	}
+
+	zone := sb.String()
+	zone = strings.Replace(zone, "192.0.2.10", "192.0.2.11", 1)
+	zone = strings.Replace(zone, "hostmaster.example.com. 10 ", "hostmaster.example.com. 11 ", 1)
+	zone = strings.Replace(zone, "db.lab.example.com.\t600\tIN\tAAAA", "db.lab.example.com.\t60\tIN\tAAAA", 1)
+	zone += "mail 300 IN MX 10 mx.example.com.\n"
+
+	changes, err := srv.ImportZone(context.Background(), "example.com", strings.NewReader(zone))
+	if err != nil {
+		t.Fatalf("import zone: %v", err)
+	}
+
+	var diff []string
+	for _, change := range changes {
+		diff = append(diff, change.String())
+	}
+
+	// the A record is replaced (delete and add), the AAAA TTL is updated, the
+	// MX is added, the SOA serial change is ignored.
+	if len(changes) != 4 || len(cli.updates) != 4 {
+		t.Fatalf("import zone: unexpected changes:\n%s", strings.Join(diff, "\n"))
+	}
+
+	for _, upd := range cli.updates {
+		if upd.NodeName == "mail.example.com." && (upd.AddRecord == nil || upd.DeleteRecord != nil) {
+			t.Errorf("import zone: unexpected mx update %+v", upd)
+		}
+		if upd.NodeName == "db.lab.example.com." && (upd.AddRecord == nil || upd.DeleteRecord == nil || upd.AddRecord.TTLSeconds != 60) {
+			t.Errorf("import zone: unexpected aaaa update %+v", upd)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package drsuapi
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/compress/mszip"
+	"github.com/oiweiwei/go-msrpc/compress/xca"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// maxUncompressedLength is the maximum uncompressed length of the compressed
+// blob, the larger lengths are rejected before the output is allocated.
+const maxUncompressedLength = 256 << 20
+
+// Decompress function returns the decompressed byte stream. The compressed data
+// is the sequence of chunks, where each chunk is the uncompressed size (4 bytes),
+// the compressed size (4 bytes) and the compressed data. The chunk with equal
+// compressed and uncompressed sizes is stored as is.
+func (o *CompressedBlob) Decompress(alg CompAlgorithmType) ([]byte, error) {
+
+	if o == nil {
+		return nil, fmt.Errorf("drsuapi: compressed blob is nil")
+	}
+
+	if o.UncompressedLength > maxUncompressedLength {
+		return nil, fmt.Errorf("drsuapi: uncompressed length is too large: %d", o.UncompressedLength)
+	}
+
+	var (
+		out  = make([]byte, 0, o.UncompressedLength)
+		dict []byte
+		b    = o.CompressedData
+	)
+
+	for len(b) > 0 {
+
+		if len(b) < 8 {
+			return nil, fmt.Errorf("drsuapi: compressed chunk header is truncated")
+		}
+
+		plainSize, size := binary.LittleEndian.Uint32(b), binary.LittleEndian.Uint32(b[4:])
+		if b = b[8:]; uint64(len(b)) < uint64(size) {
+			return nil, fmt.Errorf("drsuapi: compressed chunk is truncated")
+		}
+
+		if uint64(plainSize) > uint64(int(o.UncompressedLength)-len(out)) {
+			return nil, fmt.Errorf("drsuapi: chunk exceeds the uncompressed length: %d", plainSize)
+		}
+
+		chunk := b[:size]
+		b = b[size:]
+
+		if plainSize != size {
+			var err error
+			switch alg {
+			case CompressionAlgorithmTypeMSZIP:
+				chunk, err = mszip.DecompressBlock(chunk, dict)
+			case CompressionAlgorithmTypeWIN2K3:
+				chunk, err = xca.DecompressLZ77(chunk, int(plainSize))
+			default:
+				err = fmt.Errorf("unsupported compression algorithm %v", alg)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("drsuapi: decompress chunk: %w", err)
+			}
+		}
+
+		if uint32(len(chunk)) != plainSize {
+			return nil, fmt.Errorf("drsuapi: decompress chunk: size mismatch: %d != %d", len(chunk), plainSize)
+		}
+
+		out, dict = append(out, chunk...), chunk
+	}
+
+	if uint32(len(out)) != o.UncompressedLength {
+		return nil, fmt.Errorf("drsuapi: decompress: size mismatch: %d != %d", len(out), o.UncompressedLength)
+	}
+
+	return out, nil
+}
+
+// Decompress function returns the uncompressed DRS_MSG_GETCHGREPLY_V1 reply.
+func (o *MessageGetNCChangesReplyV2) Decompress() (*MessageGetNCChangesReplyV1, error) {
+
+	b, err := o.CompressedV1.Decompress(CompressionAlgorithmTypeMSZIP)
+	if err != nil {
+		return nil, err
+	}
+
+	var reply MessageGetNCChangesReplyV1
+	if err := ndr.UnmarshalWithTypeSerializationV1(b, &reply); err != nil {
+		return nil, fmt.Errorf("drsuapi: unmarshal reply v1: %w", err)
+	}
+
+	return &reply, nil
+}
+
+// Decompress function returns the uncompressed DRS_MSG_GETCHGREPLY_V6 or
+// DRS_MSG_GETCHGREPLY_V9 reply according to the CompressedVersion.
+func (o *MessageGetNCChangesReplyV7) Decompress() (*MessageGetNCChangesReply, error) {
+
+	b, err := o.CompressedAny.Decompress(o.CompressionAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		reply = &MessageGetNCChangesReply{}
+		v     ndr.Unmarshaler
+	)
+
+	switch o.CompressedVersion {
+	case 1:
+		v1 := &MessageGetNCChangesReply_V1{V1: &MessageGetNCChangesReplyV1{}}
+		reply.Value, v = v1, v1.V1
+	case 6:
+		v6 := &MessageGetNCChangesReply_V6{V6: &MessageGetNCChangesReplyV6{}}
+		reply.Value, v = v6, v6.V6
+	case 9:
+		v9 := &MessageGetNCChangesReply_V9{V9: &MessageGetNCChangesReplyV9{}}
+		reply.Value, v = v9, v9.V9
+	default:
+		return nil, fmt.Errorf("drsuapi: unsupported compressed reply version %d", o.CompressedVersion)
+	}
+
+	if err := ndr.UnmarshalWithTypeSerializationV1(b, v); err != nil {
+		return nil, fmt.Errorf("drsuapi: unmarshal reply v%d: %w", o.CompressedVersion, err)
+	}
+
+	return reply, nil
+}
+
+// Decompress function returns the uncompressed reply. The V2 reply is returned
+// as V1 reply, the V7 reply is returned as V6 or V9 reply, other replies are
+// returned as is.
+func (o *MessageGetNCChangesReply) Decompress() (*MessageGetNCChangesReply, error) {
+
+	switch value := o.GetValue().(type) {
+	case *MessageGetNCChangesReplyV2:
+		v1, err := value.Decompress()
+		if err != nil {
+			return nil, err
+		}
+		return &MessageGetNCChangesReply{Value: &MessageGetNCChangesReply_V1{V1: v1}}, nil
+	case *MessageGetNCChangesReplyV7:
+		return value.Decompress()
+	}
+
+	return o, nil
+}
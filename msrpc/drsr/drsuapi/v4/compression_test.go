@@ -0,0 +1,50 @@
+package drsuapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/compress/xca"
+)
+
+// blob function returns the compressed blob of the chunks.
+func blob(length uint32, chunks ...[]byte) *CompressedBlob {
+
+	var b []byte
+	for i := 0; i < len(chunks); i += 2 {
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(chunks[i])))
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(chunks[i+1])))
+		b = append(b, chunks[i+1]...)
+	}
+
+	return &CompressedBlob{UncompressedLength: length, CompressedLength: uint32(len(b)), CompressedData: b}
+}
+
+func TestCompressedBlobDecompress(t *testing.T) {
+
+	plain := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog. "), 100)
+
+	cb, _ := xca.CompressLZ77(plain)
+
+	out, err := blob(uint32(len(plain)), plain, cb).Decompress(CompressionAlgorithmTypeWIN2K3)
+	if err != nil || !bytes.Equal(out, plain) {
+		t.Fatalf("decompress: %v", err)
+	}
+
+	// the literal "a" and the match of 0x10000000 bytes at the offset 1.
+	bomb := []byte{
+		0x00, 0x00, 0x00, 0x40, 0x61, 0x07, 0x00, 0x0f, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x10,
+	}
+
+	for name, o := range map[string]*CompressedBlob{
+		"chunk":  blob(4096, make([]byte, 4096), bomb),
+		"length": blob(0xFFFFFFFF, make([]byte, 4096), bomb),
+		"size":   blob(4096, make([]byte, 8192), bomb),
+	} {
+		if _, err := o.Decompress(CompressionAlgorithmTypeWIN2K3); err == nil {
+			t.Errorf("%s: decompress: expected error", name)
+		}
+	}
+}
@@ -13,6 +13,34 @@ func (ft *Filetime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ft.AsTime())
 }
 
+func (ft *Filetime) UnmarshalJSON(b []byte) error {
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "never" {
+		ft.LowDateTime, ft.HighDateTime = 0xFFFFFFFF, 0x7FFFFFFF
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return err
+	}
+
+	if t.IsZero() {
+		ft.LowDateTime, ft.HighDateTime = 0, 0
+		return nil
+	}
+
+	// the inverse of AsTime.
+	nsec := t.Unix()*10000000 + int64(t.Nanosecond())/100 + 116444736000000000
+	ft.HighDateTime, ft.LowDateTime = uint32(nsec>>32), uint32(nsec)
+	return nil
+}
+
 func (ft *Filetime) IsNever() bool {
 	return ft.LowDateTime == 0xFFFFFFFF && ft.HighDateTime == 0x7FFFFFFF
 }
@@ -30,9 +58,8 @@ func (ft *Filetime) AsTime() time.Time {
 	nsec := (int64(ft.HighDateTime) << 32) + int64(ft.LowDateTime)
 	// change starting time to the Epoch (00:00:00 UTC, January 1, 1970)
 	nsec -= 116444736000000000
-	// convert into nanoseconds
-	// nsec *= 100
-	return time.Unix(nsec/10000000, nsec%10000000).UTC()
+	// convert the remainder into nanoseconds
+	return time.Unix(nsec/10000000, nsec%10000000*100).UTC()
 }
 
 func (ft *Filetime) DecodeBinary(b []byte) error {
@@ -0,0 +1,44 @@
+package dtyp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestFiletime(t *testing.T) {
+
+	for _, tc := range []struct {
+		ft Filetime
+		t  string
+	}{
+		{Filetime{LowDateTime: 0x9B8F5D87, HighDateTime: 0x01DA7239}, "2024-03-09T15:51:10.9417351Z"},
+		{Filetime{LowDateTime: 0x256D4000, HighDateTime: 0x01BF53EB}, "2000-01-01T00:00:00Z"},
+		{Filetime{LowDateTime: 0xD53E8001, HighDateTime: 0x019DB1DE}, "1970-01-01T00:00:00.0000001Z"},
+		{Filetime{LowDateTime: 0x00000001, HighDateTime: 0x00000000}, "1601-01-01T00:00:00.0000001Z"},
+	} {
+
+		expected, err := time.Parse(time.RFC3339Nano, tc.t)
+		if err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+
+		if actual := tc.ft.AsTime(); !actual.Equal(expected) {
+			t.Errorf("as time: %08x%08x: expected %s, got %s", tc.ft.HighDateTime, tc.ft.LowDateTime, tc.t, actual.Format(time.RFC3339Nano))
+		}
+
+		b, err := json.Marshal(&tc.ft)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+
+		out := Filetime{}
+		if err := json.Unmarshal(b, &out); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+
+		if out != tc.ft {
+			t.Errorf("unmarshal: %s: expected %08x%08x, got %08x%08x", b, tc.ft.HighDateTime, tc.ft.LowDateTime, out.HighDateTime, out.LowDateTime)
+		}
+	}
+}
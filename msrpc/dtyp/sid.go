@@ -16,6 +16,14 @@ func (o *SID) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.String())
 }
 
+func (o *SID) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return o.Parse(s)
+}
+
 func (o *SID) Bytes() ([]byte, error) {
 	return ndr.Marshal(o, ndr.Opaque)
 }
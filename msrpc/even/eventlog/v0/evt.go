@@ -0,0 +1,148 @@
+package eventlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// The log file header flags.
+const (
+	LogFileHeaderDirty uint32 = 0x00000001
+	LogFileHeaderWrap  uint32 = 0x00000002
+	LogFileLogFull     uint32 = 0x00000004
+	LogFileArchiveSet  uint32 = 0x00000008
+)
+
+const (
+	logFileHeaderSize = 0x30
+	// eofRecordSize is the size of the ELF_EOF_RECORD.
+	eofRecordSize = 0x28
+	// skipDword is the padding at the end of the circular buffer.
+	skipDword = 0x00000027
+)
+
+// EVTHeader is the header of the .evt file (ELF_LOGFILE_HEADER).
+type EVTHeader struct {
+	// The major version (1).
+	MajorVersion uint32 `json:"major_version"`
+	// The minor version (1).
+	MinorVersion uint32 `json:"minor_version"`
+	// The offset of the oldest record.
+	StartOffset uint32 `json:"start_offset"`
+	// The offset of the end-of-file record.
+	EndOffset uint32 `json:"end_offset"`
+	// The number of the next record to be written.
+	CurrentRecordNumber uint32 `json:"current_record_number"`
+	// The number of the oldest record.
+	OldestRecordNumber uint32 `json:"oldest_record_number"`
+	// The maximum log size.
+	MaxSize uint32 `json:"max_size"`
+	// The log flags (LogFileHeaderDirty, ...).
+	Flags uint32 `json:"flags"`
+	// The retention value.
+	Retention uint32 `json:"retention"`
+}
+
+// EVT is the offline event log (.evt) file.
+type EVT struct {
+	// The file header.
+	Header *EVTHeader `json:"header"`
+	// The records from the oldest to the newest.
+	Records []*Record `json:"records"`
+}
+
+// ParseEVT function parses the .evt file. The log is the circular buffer,
+// the records are returned from the oldest to the newest. For the dirty logs
+// (not closed properly) the record offsets are taken from the end-of-file
+// record.
+func ParseEVT(b []byte) (*EVT, error) {
+
+	if len(b) < logFileHeaderSize {
+		return nil, fmt.Errorf("eventlog: evt: file is truncated: %d bytes", len(b))
+	}
+
+	le := binary.LittleEndian
+
+	if le.Uint32(b) != logFileHeaderSize || le.Uint32(b[44:]) != logFileHeaderSize {
+		return nil, fmt.Errorf("eventlog: evt: invalid header size %d", le.Uint32(b))
+	}
+
+	if le.Uint32(b[4:]) != recordSignature {
+		return nil, fmt.Errorf("eventlog: evt: invalid signature 0x%08x", le.Uint32(b[4:]))
+	}
+
+	hdr := &EVTHeader{
+		MajorVersion:        le.Uint32(b[8:]),
+		MinorVersion:        le.Uint32(b[12:]),
+		StartOffset:         le.Uint32(b[16:]),
+		EndOffset:           le.Uint32(b[20:]),
+		CurrentRecordNumber: le.Uint32(b[24:]),
+		OldestRecordNumber:  le.Uint32(b[28:]),
+		MaxSize:             le.Uint32(b[32:]),
+		Flags:               le.Uint32(b[36:]),
+		Retention:           le.Uint32(b[40:]),
+	}
+
+	if hdr.Flags&LogFileHeaderDirty != 0 {
+		// the header is updated only when the log is closed.
+		if eof := findEOFRecord(b); eof != nil {
+			hdr.StartOffset, hdr.EndOffset = le.Uint32(eof[20:]), le.Uint32(eof[24:])
+			hdr.CurrentRecordNumber, hdr.OldestRecordNumber = le.Uint32(eof[28:]), le.Uint32(eof[32:])
+		}
+	}
+
+	start, end := int(hdr.StartOffset), int(hdr.EndOffset)
+	if start < logFileHeaderSize || start > len(b) || end < logFileHeaderSize || end > len(b) {
+		return nil, fmt.Errorf("eventlog: evt: invalid record offsets %d-%d", start, end)
+	}
+
+	// linearize the circular buffer.
+	var buf []byte
+	if start <= end {
+		buf = b[start:end]
+	} else {
+		buf = append(append([]byte(nil), b[start:]...), b[logFileHeaderSize:end]...)
+	}
+
+	ret := &EVT{Header: hdr}
+
+	for len(buf) >= 4 && !isEOFRecord(buf) {
+
+		if le.Uint32(buf) == skipDword {
+			// the padding at the end of the buffer.
+			buf = buf[4:]
+			continue
+		}
+
+		rec, n, err := parseRecord(buf)
+		if err != nil {
+			return nil, fmt.Errorf("eventlog: evt: record %d: %w", len(ret.Records), err)
+		}
+
+		ret.Records, buf = append(ret.Records, rec), buf[n:]
+	}
+
+	return ret, nil
+}
+
+// findEOFRecord function returns the end-of-file record.
+func findEOFRecord(b []byte) []byte {
+
+	for off := logFileHeaderSize; off+eofRecordSize <= len(b); {
+
+		i := bytes.Index(b[off+4:], []byte{0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22})
+		if i < 0 {
+			return nil
+		}
+
+		off += i
+		if off+eofRecordSize <= len(b) && isEOFRecord(b[off:]) && binary.LittleEndian.Uint32(b[off:]) == eofRecordSize {
+			return b[off : off+eofRecordSize]
+		}
+
+		off += 4
+	}
+
+	return nil
+}
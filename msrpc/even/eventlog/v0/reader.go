@@ -0,0 +1,195 @@
+package eventlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/ntstatus"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+// The read flags (ElfrReadELW).
+const (
+	SequentialRead uint32 = 0x00000001
+	SeekRead       uint32 = 0x00000002
+	ForwardsRead   uint32 = 0x00000004
+	BackwardsRead  uint32 = 0x00000008
+)
+
+const defaultReadBufferSize = 0x10000
+
+// Reader is the event log reader. The records are read sequentially with
+// ElfrReadELW, the read buffer grows on STATUS_BUFFER_TOO_SMALL.
+type Reader struct {
+	// The event log client.
+	Client EventlogClient
+	// The event log handle.
+	Log *Handle
+	// Backwards is set to read the log in the reverse chronological order.
+	Backwards bool
+
+	records []*Record
+	size    uint32
+	seek    uint32
+	seeking bool
+}
+
+// NewReader function returns the reader for the opened log handle.
+func NewReader(cli EventlogClient, log *Handle) *Reader {
+	return &Reader{Client: cli, Log: log}
+}
+
+// OpenReader function opens the event log (for example "Application" or
+// "System") and returns the reader.
+func OpenReader(ctx context.Context, cli EventlogClient, source string) (*Reader, error) {
+
+	resp, err := cli.OpenEventLogW(ctx, &OpenEventLogWRequest{
+		ModuleName:    &dtyp.UnicodeString{Buffer: source + ndr.ZeroString},
+		RegModuleName: &dtyp.UnicodeString{},
+		MajorVersion:  1,
+		MinorVersion:  1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("eventlog: open event log %s: %w", source, err)
+	}
+
+	return NewReader(cli, resp.Log), nil
+}
+
+// OpenBackupReader function opens the backup event log file (the path on the
+// server) and returns the reader.
+func OpenBackupReader(ctx context.Context, cli EventlogClient, fileName string) (*Reader, error) {
+
+	resp, err := cli.OpenBackupEventLogW(ctx, &OpenBackupEventLogWRequest{
+		BackupFileName: &dtyp.UnicodeString{Buffer: fileName + ndr.ZeroString},
+		MajorVersion:   1,
+		MinorVersion:   1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("eventlog: open backup event log %s: %w", fileName, err)
+	}
+
+	return NewReader(cli, resp.Log), nil
+}
+
+// Close function closes the event log handle.
+func (o *Reader) Close(ctx context.Context) error {
+	if _, err := o.Client.CloseEventLog(ctx, &CloseEventLogRequest{Log: o.Log}); err != nil {
+		return fmt.Errorf("eventlog: close event log: %w", err)
+	}
+	return nil
+}
+
+// NumberOfRecords function returns the number of the records in the log.
+func (o *Reader) NumberOfRecords(ctx context.Context) (uint32, error) {
+	resp, err := o.Client.NumberOfRecords(ctx, &NumberOfRecordsRequest{Log: o.Log})
+	if err != nil {
+		return 0, fmt.Errorf("eventlog: number of records: %w", err)
+	}
+	return resp.NumberOfRecords, nil
+}
+
+// OldestRecord function returns the number of the oldest record in the log.
+func (o *Reader) OldestRecord(ctx context.Context) (uint32, error) {
+	resp, err := o.Client.OldestRecord(ctx, &OldestRecordRequest{Log: o.Log})
+	if err != nil {
+		return 0, fmt.Errorf("eventlog: oldest record: %w", err)
+	}
+	return resp.OldestRecordNumber, nil
+}
+
+// Seek function sets the record number to read next, the following reads
+// proceed sequentially from this record.
+func (o *Reader) Seek(recordNumber uint32) {
+	o.records, o.seek, o.seeking = nil, recordNumber, true
+}
+
+// Next function returns the next record, or io.EOF if there are no more records.
+func (o *Reader) Next(ctx context.Context) (*Record, error) {
+
+	for len(o.records) == 0 {
+		if err := o.read(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	rec := o.records[0]
+	o.records = o.records[1:]
+
+	return rec, nil
+}
+
+// Records function returns the iterator over the remaining records.
+func (o *Reader) Records(ctx context.Context) iter.Seq2[*Record, error] {
+	return func(yield func(*Record, error) bool) {
+		for {
+			rec, err := o.Next(ctx)
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield(nil, err)
+				}
+				return
+			}
+			if !yield(rec, nil) {
+				return
+			}
+		}
+	}
+}
+
+// read function reads the next batch of the records.
+func (o *Reader) read(ctx context.Context) error {
+
+	if o.size == 0 {
+		o.size = defaultReadBufferSize
+	}
+
+	flags := SequentialRead | ForwardsRead
+	if o.Backwards {
+		flags = SequentialRead | BackwardsRead
+	}
+
+	if o.seeking {
+		flags = flags&^SequentialRead | SeekRead
+	}
+
+	for {
+
+		resp, err := o.Client.ReadEventLogW(ctx, &ReadEventLogWRequest{
+			Log:                 o.Log,
+			ReadFlags:           flags,
+			RecordOffset:        o.seek,
+			NumberOfBytesToRead: o.size,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ntstatus.StatusEndOfFile):
+				return io.EOF
+			case resp != nil && errors.Is(err, ntstatus.StatusBufferTooSmall) && resp.MinNumberOfBytesNeeded > uint32(MaxBatchBuffer):
+				return fmt.Errorf("eventlog: read event log: record size %d exceeds the maximum buffer size %d", resp.MinNumberOfBytesNeeded, MaxBatchBuffer)
+			case resp != nil && errors.Is(err, ntstatus.StatusBufferTooSmall) && resp.MinNumberOfBytesNeeded > o.size:
+				o.size = resp.MinNumberOfBytesNeeded
+				continue
+			}
+			return fmt.Errorf("eventlog: read event log: %w", err)
+		}
+
+		if resp.NumberOfBytesRead == 0 {
+			return io.EOF
+		}
+
+		records, err := ParseRecords(resp.Buffer[:min(len(resp.Buffer), int(resp.NumberOfBytesRead))])
+		if err != nil {
+			return err
+		}
+
+		// the following reads are sequential.
+		o.records, o.seeking = records, false
+
+		return nil
+	}
+}
@@ -0,0 +1,188 @@
+package eventlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+// The event types.
+const (
+	EventTypeSuccess      uint16 = 0x0000
+	EventTypeError        uint16 = 0x0001
+	EventTypeWarning      uint16 = 0x0002
+	EventTypeInformation  uint16 = 0x0004
+	EventTypeAuditSuccess uint16 = 0x0008
+	EventTypeAuditFailure uint16 = 0x0010
+)
+
+const (
+	// recordSignature is the "LfLe" signature of the record and of the log
+	// file header.
+	recordSignature = 0x654C664C
+	// recordHeaderSize is the size of the fixed part of the EVENTLOGRECORD.
+	recordHeaderSize = 56
+)
+
+// Record is the event log record (EVENTLOGRECORD).
+type Record struct {
+	// The record number.
+	RecordNumber uint32 `json:"record_number"`
+	// The time the event was generated.
+	TimeGenerated time.Time `json:"time_generated"`
+	// The time the event was written to the log.
+	TimeWritten time.Time `json:"time_written"`
+	// The full event identifier (including the severity, customer and facility bits).
+	EventID uint32 `json:"event_id"`
+	// The event type (EventTypeError, EventTypeWarning, ...).
+	EventType uint16 `json:"event_type"`
+	// The event category.
+	EventCategory uint16 `json:"event_category"`
+	// The event source name.
+	SourceName string `json:"source_name"`
+	// The computer name.
+	ComputerName string `json:"computer_name"`
+	// The user SID.
+	UserSID *dtyp.SID `json:"user_sid,omitempty"`
+	// The insertion strings.
+	Strings []string `json:"strings,omitempty"`
+	// The event-specific binary data.
+	Data []byte `json:"data,omitempty"`
+}
+
+// Code function returns the event code (the low 16 bits of the event identifier),
+// which is displayed by the event viewer.
+func (o *Record) Code() uint16 {
+	return uint16(o.EventID)
+}
+
+// ParseRecords function parses the sequence of the EVENTLOGRECORD structures.
+func ParseRecords(b []byte) ([]*Record, error) {
+
+	var records []*Record
+
+	for len(b) > 0 {
+
+		rec, n, err := parseRecord(b)
+		if err != nil {
+			return nil, err
+		}
+
+		records, b = append(records, rec), b[n:]
+	}
+
+	return records, nil
+}
+
+// ParseRecord function parses the single EVENTLOGRECORD structure.
+func ParseRecord(b []byte) (*Record, error) {
+	rec, _, err := parseRecord(b)
+	return rec, err
+}
+
+// parseRecord function returns the record and the record length.
+func parseRecord(b []byte) (*Record, int, error) {
+
+	if len(b) < recordHeaderSize+4 {
+		return nil, 0, fmt.Errorf("eventlog: record is truncated: %d bytes", len(b))
+	}
+
+	le := binary.LittleEndian
+
+	n := int(le.Uint32(b))
+	if n < recordHeaderSize+4 || n > len(b) || n%4 != 0 {
+		return nil, 0, fmt.Errorf("eventlog: invalid record length %d", n)
+	}
+
+	if le.Uint32(b[4:]) != recordSignature {
+		return nil, 0, fmt.Errorf("eventlog: invalid record signature 0x%08x", le.Uint32(b[4:]))
+	}
+
+	if le.Uint32(b[n-4:]) != uint32(n) {
+		return nil, 0, fmt.Errorf("eventlog: record length mismatch: %d != %d", le.Uint32(b[n-4:]), n)
+	}
+
+	b = b[:n]
+
+	rec := &Record{
+		RecordNumber:  le.Uint32(b[8:]),
+		TimeGenerated: time.Unix(int64(le.Uint32(b[12:])), 0).UTC(),
+		TimeWritten:   time.Unix(int64(le.Uint32(b[16:])), 0).UTC(),
+		EventID:       le.Uint32(b[20:]),
+		EventType:     le.Uint16(b[24:]),
+		EventCategory: le.Uint16(b[28:]),
+	}
+
+	numStrings, stringOffset := int(le.Uint16(b[26:])), le.Uint32(b[36:])
+	sidLength, sidOffset := le.Uint32(b[40:]), le.Uint32(b[44:])
+	dataLength, dataOffset := le.Uint32(b[48:]), le.Uint32(b[52:])
+
+	var (
+		names = b[recordHeaderSize : n-4]
+		err   error
+	)
+
+	if rec.SourceName, names, err = readString(names); err != nil {
+		return nil, 0, fmt.Errorf("eventlog: record %d: source name: %w", rec.RecordNumber, err)
+	}
+
+	if rec.ComputerName, _, err = readString(names); err != nil {
+		return nil, 0, fmt.Errorf("eventlog: record %d: computer name: %w", rec.RecordNumber, err)
+	}
+
+	if sidLength > 0 {
+		if uint64(sidOffset)+uint64(sidLength) > uint64(n) {
+			return nil, 0, fmt.Errorf("eventlog: record %d: user sid is out of bounds", rec.RecordNumber)
+		}
+		rec.UserSID = &dtyp.SID{}
+		if err := rec.UserSID.DecodeBinary(b[sidOffset : sidOffset+sidLength]); err != nil {
+			return nil, 0, fmt.Errorf("eventlog: record %d: user sid: %w", rec.RecordNumber, err)
+		}
+	}
+
+	if numStrings > 0 {
+		if uint64(stringOffset) > uint64(n-4) {
+			return nil, 0, fmt.Errorf("eventlog: record %d: strings are out of bounds", rec.RecordNumber)
+		}
+		s := b[stringOffset : n-4]
+		rec.Strings = make([]string, numStrings)
+		for i := range rec.Strings {
+			if rec.Strings[i], s, err = readString(s); err != nil {
+				return nil, 0, fmt.Errorf("eventlog: record %d: string %d: %w", rec.RecordNumber, i, err)
+			}
+		}
+	}
+
+	if dataLength > 0 {
+		if uint64(dataOffset)+uint64(dataLength) > uint64(n) {
+			return nil, 0, fmt.Errorf("eventlog: record %d: data is out of bounds", rec.RecordNumber)
+		}
+		rec.Data = append([]byte(nil), b[dataOffset:dataOffset+dataLength]...)
+	}
+
+	return rec, n, nil
+}
+
+// readString function reads the NUL-terminated UTF-16LE string.
+func readString(b []byte) (string, []byte, error) {
+
+	for i := 0; i+1 < len(b); i += 2 {
+		if b[i] == 0 && b[i+1] == 0 {
+			s, err := utf16le.Decode(b[:i])
+			return s, b[i+2:], err
+		}
+	}
+
+	return "", nil, fmt.Errorf("string is not terminated")
+}
+
+// isEOFRecord function returns true if the buffer starts with the ELF_EOF_RECORD.
+func isEOFRecord(b []byte) bool {
+	return len(b) >= 20 && bytes.Equal(b[4:20], []byte{
+		0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x33, 0x33, 0x33, 0x33, 0x44, 0x44, 0x44, 0x44,
+	})
+}
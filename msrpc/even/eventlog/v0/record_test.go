@@ -0,0 +1,228 @@
+package eventlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/ntstatus"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+func testString(s string) []byte {
+	b, _ := utf16le.Encode(s + "\x00")
+	return b
+}
+
+func testRecord(num uint32, strs ...string) []byte {
+
+	var names, body bytes.Buffer
+
+	names.Write(testString("Service Control Manager"))
+	names.Write(testString("HOST01"))
+	for names.Len()%4 != 0 {
+		names.WriteByte(0)
+	}
+
+	sid := []byte{1, 1, 0, 0, 0, 0, 0, 5, 18, 0, 0, 0}
+	sidOffset := recordHeaderSize + names.Len()
+	strOffset := sidOffset + len(sid)
+
+	body.Write(names.Bytes())
+	body.Write(sid)
+	for _, s := range strs {
+		body.Write(testString(s))
+	}
+	dataOffset := recordHeaderSize + body.Len()
+	body.Write([]byte{0xDE, 0xAD})
+	for body.Len()%4 != 0 {
+		body.WriteByte(0)
+	}
+
+	n := recordHeaderSize + body.Len() + 4
+	b := make([]byte, recordHeaderSize, n)
+
+	le := binary.LittleEndian
+	le.PutUint32(b[0:], uint32(n))
+	le.PutUint32(b[4:], recordSignature)
+	le.PutUint32(b[8:], num)
+	le.PutUint32(b[12:], 1700000000)
+	le.PutUint32(b[16:], 1700000001)
+	le.PutUint32(b[20:], 0xC0001B58)
+	le.PutUint16(b[24:], EventTypeError)
+	le.PutUint16(b[26:], uint16(len(strs)))
+	le.PutUint32(b[36:], uint32(strOffset))
+	le.PutUint32(b[40:], uint32(len(sid)))
+	le.PutUint32(b[44:], uint32(sidOffset))
+	le.PutUint32(b[48:], 2)
+	le.PutUint32(b[52:], uint32(dataOffset))
+
+	b = append(b, body.Bytes()...)
+	return le.AppendUint32(b, uint32(n))
+}
+
+func testEOFRecord(start, end, current, oldest uint32) []byte {
+	le := binary.LittleEndian
+	b := le.AppendUint32(nil, eofRecordSize)
+	for _, v := range []uint32{0x11111111, 0x22222222, 0x33333333, 0x44444444, start, end, current, oldest, eofRecordSize} {
+		b = le.AppendUint32(b, v)
+	}
+	return b
+}
+
+func TestParseRecord(t *testing.T) {
+
+	rec, err := ParseRecord(testRecord(7, "Print Spooler", "Stopped"))
+	if err != nil {
+		t.Fatalf("parse record: %v", err)
+	}
+
+	if rec.RecordNumber != 7 || rec.Code() != 7000 || rec.SourceName != "Service Control Manager" || rec.ComputerName != "HOST01" {
+		t.Errorf("parse record: unexpected record %+v", rec)
+	}
+
+	if rec.UserSID.String() != "S-1-5-18" || len(rec.Strings) != 2 || rec.Strings[1] != "Stopped" || !bytes.Equal(rec.Data, []byte{0xDE, 0xAD}) {
+		t.Errorf("parse record: unexpected sid %s, strings %q, data %x", rec.UserSID, rec.Strings, rec.Data)
+	}
+
+	if rec.TimeWritten.Unix() != 1700000001 {
+		t.Errorf("parse record: unexpected time written %v", rec.TimeWritten)
+	}
+}
+
+func TestParseMalformedRecord(t *testing.T) {
+
+	le := binary.LittleEndian
+
+	for _, tc := range []struct {
+		name   string
+		offset int
+		value  func(n int) uint32
+	}{
+		{"string offset inside the trailing length", 36, func(n int) uint32 { return uint32(n - 2) }},
+		{"string offset past the record", 36, func(n int) uint32 { return uint32(n + 4) }},
+		{"sid out of bounds", 40, func(n int) uint32 { return uint32(n) }},
+		{"data out of bounds", 48, func(n int) uint32 { return 0xFFFFFFFF }},
+	} {
+		b := testRecord(1, "a")
+		le.PutUint32(b[tc.offset:], tc.value(len(b)))
+		if _, err := ParseRecord(b); err == nil {
+			t.Errorf("parse record: %s: expected error", tc.name)
+		}
+	}
+}
+
+func TestParseEVT(t *testing.T) {
+
+	r1, r2, r3 := testRecord(1, "a"), testRecord(2, "b"), testRecord(3, "c")
+
+	// the record 1 is overwritten, the record 3 wraps around the end of file.
+	size := logFileHeaderSize + len(r1) + len(r2) + len(r3)/2
+	b := make([]byte, size)
+
+	start := logFileHeaderSize + len(r1)
+	copy(b[start:], r2)
+	n := copy(b[start+len(r2):], r3)
+	end := logFileHeaderSize + copy(b[logFileHeaderSize:], r3[n:])
+	copy(b[end:], testEOFRecord(uint32(start), uint32(end), 4, 2))
+
+	le := binary.LittleEndian
+	for i, v := range []uint32{logFileHeaderSize, recordSignature, 1, 1, uint32(start), uint32(end), 4, 2, uint32(size), LogFileHeaderWrap, 0, logFileHeaderSize} {
+		le.PutUint32(b[i*4:], v)
+	}
+
+	evt, err := ParseEVT(b)
+	if err != nil {
+		t.Fatalf("parse evt: %v", err)
+	}
+
+	if len(evt.Records) != 2 || evt.Records[0].RecordNumber != 2 || evt.Records[1].Strings[0] != "c" {
+		t.Fatalf("parse evt: unexpected records %+v", evt.Records)
+	}
+
+	// the dirty log: the offsets are taken from the end-of-file record.
+	le.PutUint32(b[20:], uint32(start))
+	le.PutUint32(b[36:], LogFileHeaderDirty|LogFileHeaderWrap)
+
+	if evt, err = ParseEVT(b); err != nil || len(evt.Records) != 2 {
+		t.Fatalf("parse dirty evt: %v", err)
+	}
+}
+
+type testReadClient struct {
+	EventlogClient
+	records [][]byte
+	reads   []*ReadEventLogWRequest
+}
+
+func (c *testReadClient) ReadEventLogW(ctx context.Context, in *ReadEventLogWRequest, opts ...dcerpc.CallOption) (*ReadEventLogWResponse, error) {
+
+	c.reads = append(c.reads, in)
+
+	if len(c.records) == 0 {
+		return &ReadEventLogWResponse{Return: int32(ntstatus.StatusEndOfFile.Code)}, fmt.Errorf("ReadEventLogW: %w", ntstatus.StatusEndOfFile)
+	}
+
+	if uint32(len(c.records[0])) > in.NumberOfBytesToRead {
+		return &ReadEventLogWResponse{MinNumberOfBytesNeeded: uint32(len(c.records[0]))}, fmt.Errorf("ReadEventLogW: %w", ntstatus.StatusBufferTooSmall)
+	}
+
+	b := c.records[0]
+	c.records = c.records[1:]
+
+	return &ReadEventLogWResponse{Buffer: b, NumberOfBytesRead: uint32(len(b))}, nil
+}
+
+func TestReader(t *testing.T) {
+
+	big := make([]string, 40)
+	for i := range big {
+		big[i] = string(bytes.Repeat([]byte{'x'}, 1000))
+	}
+
+	cli := &testReadClient{records: [][]byte{append(testRecord(1, "a"), testRecord(2, "b")...), testRecord(3, big...)}}
+
+	r := NewReader(cli, &Handle{})
+	r.Seek(1)
+
+	var nums []uint32
+	for rec, err := range r.Records(context.Background()) {
+		if err != nil {
+			t.Fatalf("records: %v", err)
+		}
+		nums = append(nums, rec.RecordNumber)
+	}
+
+	if fmt.Sprint(nums) != "[1 2 3]" {
+		t.Errorf("records: unexpected records %v", nums)
+	}
+
+	if cli.reads[0].ReadFlags != SeekRead|ForwardsRead || cli.reads[0].RecordOffset != 1 || cli.reads[1].ReadFlags != SequentialRead|ForwardsRead {
+		t.Errorf("records: unexpected read flags %d, %d", cli.reads[0].ReadFlags, cli.reads[1].ReadFlags)
+	}
+
+	if len(cli.reads) != 4 || cli.reads[1].NumberOfBytesToRead >= cli.reads[2].NumberOfBytesToRead {
+		t.Errorf("records: expected buffer to grow, got %d reads", len(cli.reads))
+	}
+}
+
+func TestReaderRecordTooLarge(t *testing.T) {
+
+	cli := &testReadClient{records: [][]byte{make([]byte, MaxBatchBuffer+1)}}
+
+	r := NewReader(cli, &Handle{})
+
+	for _, err := range r.Records(context.Background()) {
+		if err == nil {
+			t.Fatalf("records: expected error")
+		}
+		break
+	}
+
+	if len(cli.reads) != 1 {
+		t.Errorf("records: expected single read, got %d reads", len(cli.reads))
+	}
+}
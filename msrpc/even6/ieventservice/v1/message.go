@@ -0,0 +1,326 @@
+package ieventservice
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/binxml"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+// The message render flags (EvtRpcMessageRender).
+const (
+	FormatMessageEvent    uint32 = 0x00000001
+	FormatMessageLevel    uint32 = 0x00000002
+	FormatMessageTask     uint32 = 0x00000003
+	FormatMessageOpcode   uint32 = 0x00000004
+	FormatMessageKeyword  uint32 = 0x00000005
+	FormatMessageChannel  uint32 = 0x00000006
+	FormatMessageProvider uint32 = 0x00000007
+	FormatMessageID       uint32 = 0x00000008
+)
+
+const (
+	// the event metadata property indices (EvtRpcGetNextEventMetadata).
+	eventMetadataID        = 0
+	eventMetadataVersion   = 1
+	eventMetadataMessageID = 7
+
+	defaultMessageSize = 0x4000
+	maxMessageSize     = 0x100000
+)
+
+// EventMessages is the rendered event strings.
+type EventMessages struct {
+	// The event message.
+	Message string `json:"message,omitempty"`
+	// The localized level name.
+	Level string `json:"level,omitempty"`
+	// The localized task name.
+	Task string `json:"task,omitempty"`
+	// The localized opcode name.
+	Opcode string `json:"opcode,omitempty"`
+	// The localized keyword names.
+	Keywords string `json:"keywords,omitempty"`
+	// The localized provider name.
+	Provider string `json:"provider,omitempty"`
+}
+
+// MessageRenderer renders the event messages using the publisher metadata
+// (EvtRpcGetPublisherMetadata, EvtRpcGetEventMetadataEnum and EvtRpcMessageRender).
+// The publisher metadata handles and the event message identifiers are cached
+// per publisher until Close.
+type MessageRenderer struct {
+	// The event service client.
+	Client EventServiceClient
+	// The locale of the messages (see lcid package).
+	Locale uint32
+
+	mu         sync.Mutex
+	publishers map[string]*publisher
+}
+
+// publisher is the cached publisher metadata.
+type publisher struct {
+	handle *PublisherMetadata
+	// the event (id, version) to message id map.
+	messages map[uint64]uint32
+	err      error
+}
+
+// NewMessageRenderer function returns the message renderer.
+func NewMessageRenderer(cli EventServiceClient, locale uint32) *MessageRenderer {
+	return &MessageRenderer{Client: cli, Locale: locale, publishers: make(map[string]*publisher)}
+}
+
+// Close function releases the cached publisher metadata handles.
+func (o *MessageRenderer) Close(ctx context.Context) error {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var errs []error
+	for name, pub := range o.publishers {
+		if pub.handle != nil {
+			if _, err := o.Client.Close(ctx, &CloseRequest{Handle: pub.handle.ContextHandle()}); err != nil {
+				errs = append(errs, fmt.Errorf("ieventservice: close publisher %s: %w", name, err))
+			}
+		}
+		delete(o.publishers, name)
+	}
+
+	return errors.Join(errs...)
+}
+
+// publisher function returns the cached publisher metadata. The publisher
+// that is not found is cached too, the other failures (for example, the
+// connection errors) are not cached. The lock is not held during the calls,
+// the concurrent lookups of the same publisher keep the first result.
+func (o *MessageRenderer) publisher(ctx context.Context, name string) (*publisher, error) {
+
+	key := strings.ToLower(name)
+
+	o.mu.Lock()
+	pub, ok := o.publishers[key]
+	o.mu.Unlock()
+
+	if ok {
+		return pub, pub.err
+	}
+
+	pub = &publisher{messages: make(map[uint64]uint32)}
+
+	resp, err := o.Client.GetPublisherMetadata(ctx, &GetPublisherMetadataRequest{
+		PublisherID: name,
+		Locale:      o.Locale,
+	})
+	if err != nil {
+		if pub.err = fmt.Errorf("ieventservice: get publisher metadata %s: %w", name, err); !isPublisherNotFound(err) {
+			return nil, pub.err
+		}
+	} else {
+		pub.handle = resp.PublisherMetadata
+		// the event metadata is optional, the message is rendered with the
+		// event descriptor if the message id is not known.
+		o.enumEvents(ctx, pub)
+	}
+
+	o.mu.Lock()
+
+	cached, ok := o.publishers[key]
+	if !ok {
+		if o.publishers == nil {
+			o.publishers = make(map[string]*publisher)
+		}
+		o.publishers[key] = pub
+	}
+
+	o.mu.Unlock()
+
+	if ok {
+		// the publisher was opened by the concurrent lookup.
+		if pub.handle != nil {
+			o.Client.Close(ctx, &CloseRequest{Handle: pub.handle.ContextHandle()})
+		}
+		return cached, cached.err
+	}
+
+	return pub, pub.err
+}
+
+// isPublisherNotFound function returns `true` if the error indicates that
+// the publisher (or its metadata) does not exist.
+func isPublisherNotFound(err error) bool {
+	return errors.Is(err, win32.ErrorFileNotFound) || errors.Is(err, win32.ErrorEvtPublisherMetadataNotFound)
+}
+
+// enumEvents function fills the message identifiers of the publisher events.
+func (o *MessageRenderer) enumEvents(ctx context.Context, pub *publisher) {
+
+	enum, err := o.Client.GetEventMetadataEnum(ctx, &GetEventMetadataEnumRequest{
+		PublisherMetadata: pub.handle,
+	})
+	if err != nil {
+		return
+	}
+
+	defer o.Client.Close(ctx, &CloseRequest{Handle: enum.EventMetadataEnum.ContextHandle()})
+
+	for {
+
+		resp, err := o.Client.GetNextEventMetadata(ctx, &GetNextEventMetadataRequest{
+			EventMetadataEnum: enum.EventMetadataEnum,
+			RequestedLength:   100,
+		})
+		if err != nil || resp.ReturnedLength == 0 {
+			return
+		}
+
+		for _, props := range resp.EventMetadataInstances {
+			if props == nil || len(props.Properties) <= eventMetadataMessageID {
+				continue
+			}
+			id, _ := variantValue(props.Properties[eventMetadataID]).(uint32)
+			version, _ := variantValue(props.Properties[eventMetadataVersion]).(uint32)
+			if msgID, ok := variantValue(props.Properties[eventMetadataMessageID]).(uint32); ok && msgID != 0xFFFFFFFF {
+				pub.messages[eventKey(id, version)] = msgID
+			}
+		}
+	}
+}
+
+func variantValue(v *Variant) any {
+	if v == nil {
+		return nil
+	}
+	return v.Variant.GetValue()
+}
+
+func eventKey(id, version uint32) uint64 {
+	return uint64(id)<<32 | uint64(version)
+}
+
+// Message function returns the rendered event message.
+func (o *MessageRenderer) Message(ctx context.Context, ev *binxml.Event) (string, error) {
+	return o.Render(ctx, ev, FormatMessageEvent)
+}
+
+// RenderEvent function returns the rendered event message and the localized
+// names of the level, task, opcode, keywords and provider. The strings that
+// cannot be rendered are left empty, the error is returned only if no string
+// was rendered.
+func (o *MessageRenderer) RenderEvent(ctx context.Context, ev *binxml.Event) (*EventMessages, error) {
+
+	var (
+		ret      = &EventMessages{}
+		errs     []error
+		rendered bool
+	)
+
+	for _, field := range []struct {
+		flags uint32
+		s     *string
+	}{
+		{FormatMessageEvent, &ret.Message},
+		{FormatMessageLevel, &ret.Level},
+		{FormatMessageTask, &ret.Task},
+		{FormatMessageOpcode, &ret.Opcode},
+		{FormatMessageKeyword, &ret.Keywords},
+		{FormatMessageProvider, &ret.Provider},
+	} {
+		s, err := o.Render(ctx, ev, field.flags)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		*field.s, rendered = s, true
+	}
+
+	if !rendered {
+		return nil, errors.Join(errs...)
+	}
+
+	return ret, nil
+}
+
+// Render function returns the event string specified by the flags
+// (FormatMessageEvent, FormatMessageLevel, ...).
+func (o *MessageRenderer) Render(ctx context.Context, ev *binxml.Event, flags uint32) (string, error) {
+
+	if ev == nil || ev.System == nil {
+		return "", fmt.Errorf("ieventservice: render message: event has no system properties")
+	}
+
+	name := ev.System.Provider.Name
+
+	pub, err := o.publisher(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	req := &MessageRenderRequest{
+		PublisherConfigObject: pub.handle,
+		Flags:                 flags,
+		Values:                &VariantList{Properties: []*Variant{}},
+	}
+
+	if flags == FormatMessageEvent {
+		for _, v := range ev.Values() {
+			req.Values.Properties = append(req.Values.Properties, &Variant{
+				Type:    VariantTypeString,
+				Variant: &Variant_Variant{Value: &Variant_StringValue{StringValue: v}},
+			})
+		}
+		req.Values.Count = uint32(len(req.Values.Properties))
+		if msgID, ok := pub.messages[eventKey(ev.System.EventID, uint32(ev.System.Version))]; ok {
+			req.Flags, req.MessageID = FormatMessageID, msgID
+		}
+	}
+
+	if req.Flags != FormatMessageID {
+		req.EventID = eventDescriptor(ev.System)
+	}
+
+	req.SizeEventID = uint32(len(req.EventID))
+	if req.SizeEventID == 0 {
+		// the event id must not be empty.
+		req.SizeEventID, req.EventID = 1, []byte{0}
+	}
+
+	for size := uint32(defaultMessageSize); ; {
+
+		req.MaxSizeString = size
+
+		resp, err := o.Client.MessageRender(ctx, req)
+		if err != nil {
+			if resp != nil && errors.Is(err, win32.ErrorInsufficientBuffer) && resp.NeededSizeString > size && resp.NeededSizeString <= maxMessageSize {
+				size = resp.NeededSizeString
+				continue
+			}
+			return "", fmt.Errorf("ieventservice: render message %s/%d: %w", name, ev.System.EventID, err)
+		}
+
+		s, err := utf16le.Decode(resp.String)
+		if err != nil {
+			return "", fmt.Errorf("ieventservice: render message %s/%d: decode: %w", name, ev.System.EventID, err)
+		}
+
+		// the keywords are returned as the multi-string.
+		return strings.Join(strings.FieldsFunc(s, func(r rune) bool { return r == 0 }), ", "), nil
+	}
+}
+
+// eventDescriptor function returns the EVENT_DESCRIPTOR of the event.
+func eventDescriptor(sys *binxml.EventSystem) []byte {
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint16(b[0:], uint16(sys.EventID))
+	b[2], b[4], b[5] = sys.Version, sys.Level, sys.Opcode
+	binary.LittleEndian.PutUint16(b[6:], sys.Task)
+	binary.LittleEndian.PutUint64(b[8:], uint64(sys.Keywords))
+	return b
+}
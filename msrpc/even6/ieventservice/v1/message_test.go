@@ -0,0 +1,124 @@
+package ieventservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/binxml"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+type testMessageClient struct {
+	EventServiceClient
+	publishers int
+	requests   []*MessageRenderRequest
+	// the errors returned by the publisher metadata calls.
+	errs []error
+}
+
+func (c *testMessageClient) GetPublisherMetadata(ctx context.Context, in *GetPublisherMetadataRequest, opts ...dcerpc.CallOption) (*GetPublisherMetadataResponse, error) {
+	c.publishers++
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		return nil, err
+	}
+	return &GetPublisherMetadataResponse{PublisherMetadata: &PublisherMetadata{}}, nil
+}
+
+func (c *testMessageClient) GetEventMetadataEnum(ctx context.Context, in *GetEventMetadataEnumRequest, opts ...dcerpc.CallOption) (*GetEventMetadataEnumResponse, error) {
+	return &GetEventMetadataEnumResponse{EventMetadataEnum: &EventMetadataEnum{}}, nil
+}
+
+func (c *testMessageClient) GetNextEventMetadata(ctx context.Context, in *GetNextEventMetadataRequest, opts ...dcerpc.CallOption) (*GetNextEventMetadataResponse, error) {
+	if c.requests != nil {
+		return &GetNextEventMetadataResponse{}, nil
+	}
+	props := make([]*Variant, 9)
+	for i, v := range map[int]uint32{eventMetadataID: 4624, eventMetadataVersion: 2, eventMetadataMessageID: 0xB0001210} {
+		props[i] = &Variant{Type: VariantTypeUint32, Variant: &Variant_Variant{Value: &Variant_Uint32Value{Uint32Value: v}}}
+	}
+	c.requests = []*MessageRenderRequest{}
+	return &GetNextEventMetadataResponse{ReturnedLength: 1, EventMetadataInstances: []*VariantList{{Count: 9, Properties: props}}}, nil
+}
+
+func (c *testMessageClient) MessageRender(ctx context.Context, in *MessageRenderRequest, opts ...dcerpc.CallOption) (*MessageRenderResponse, error) {
+	c.requests = append(c.requests, in)
+	b, _ := utf16le.Encode("An account was logged on: " + in.Values.Properties[1].Variant.GetValue().(string) + "\x00")
+	return &MessageRenderResponse{String: b, ActualSizeString: uint32(len(b))}, nil
+}
+
+func (c *testMessageClient) Close(ctx context.Context, in *CloseRequest, opts ...dcerpc.CallOption) (*CloseResponse, error) {
+	return &CloseResponse{}, nil
+}
+
+func TestMessageRenderer(t *testing.T) {
+
+	ev, err := binxml.ParseEvent(`<Event xmlns='http://schemas.microsoft.com/win/2004/08/events/event'>` +
+		`<System><Provider Name='Microsoft-Windows-Security-Auditing' Guid='{54849625-5478-4994-A5BA-3E3B0328C30D}'/>` +
+		`<EventID>4624</EventID><Version>2</Version><Level>0</Level><Task>12544</Task><Opcode>0</Opcode>` +
+		`<Keywords>0x8020000000000000</Keywords><TimeCreated SystemTime='2024-05-01T10:00:00Z'/>` +
+		`<EventRecordID>42</EventRecordID><Correlation ActivityID='{00000000-0000-0000-0000-000000000001}'/>` +
+		`<Execution ProcessID='4' ThreadID='8'/><Channel>Security</Channel><Computer>dc01</Computer><Security/></System>` +
+		`<EventData><Data Name='SubjectUserSid'>S-1-5-18</Data><Data Name='TargetUserName'>alice</Data></EventData></Event>`)
+	if err != nil {
+		t.Fatalf("parse event: %v", err)
+	}
+
+	if ev.System.Keywords != 0x8020000000000000 || ev.System.Execution.ThreadID != 8 || ev.Data()["TargetUserName"] != "alice" {
+		t.Fatalf("parse event: unexpected event %+v", ev.System)
+	}
+
+	cli := &testMessageClient{}
+	r := NewMessageRenderer(cli, 0x0409)
+
+	for i := 0; i < 2; i++ {
+		msg, err := r.Message(context.Background(), ev)
+		if err != nil {
+			t.Fatalf("message: %v", err)
+		}
+		if msg != "An account was logged on: alice" {
+			t.Errorf("message: unexpected message %q", msg)
+		}
+	}
+
+	if cli.publishers != 1 {
+		t.Errorf("expected cached publisher metadata, got %d calls", cli.publishers)
+	}
+
+	if req := cli.requests[0]; req.Flags != FormatMessageID || req.MessageID != 0xB0001210 {
+		t.Errorf("expected message id render, got flags %d, id %x", req.Flags, req.MessageID)
+	}
+
+	if err := r.Close(context.Background()); err != nil {
+		t.Errorf("close: %v", err)
+	}
+}
+
+func TestMessageRendererPublisherError(t *testing.T) {
+
+	ev := &binxml.Event{System: &binxml.EventSystem{EventID: 1}}
+	ev.System.Provider.Name = "Test"
+
+	cli := &testMessageClient{errs: []error{dcerpc.ErrConnClosed, win32.ErrorFileNotFound}}
+	r := NewMessageRenderer(cli, 0x0409)
+
+	// the connection error is not cached.
+	if _, err := r.Render(context.Background(), ev, FormatMessageLevel); !errors.Is(err, dcerpc.ErrConnClosed) {
+		t.Fatalf("render: expected connection error, got %v", err)
+	}
+
+	// the publisher that is not found is cached.
+	for i := 0; i < 2; i++ {
+		if _, err := r.Render(context.Background(), ev, FormatMessageLevel); !errors.Is(err, win32.ErrorFileNotFound) {
+			t.Fatalf("render: expected not found error, got %v", err)
+		}
+	}
+
+	if cli.publishers != 2 {
+		t.Errorf("expected 2 publisher metadata calls, got %d", cli.publishers)
+	}
+}
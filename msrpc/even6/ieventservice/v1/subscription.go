@@ -0,0 +1,290 @@
+package ieventservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/binxml"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+)
+
+// The subscription flags (EvtRpcRegisterRemoteSubscription), see also SubscribePull.
+const (
+	SubscribeToFutureEvents      uint32 = 0x00000001
+	SubscribeStartAtOldestRecord uint32 = 0x00000002
+	SubscribeStartAfterBookmark  uint32 = 0x00000003
+	SubscribeTolerateQueryErrors uint32 = 0x00001000
+	SubscribeStrict              uint32 = 0x00010000
+)
+
+const (
+	defaultSubscriptionBatchSize = 100
+	defaultSubscriptionTimeout   = 5 * time.Second
+	defaultReconnectDelay        = 5 * time.Second
+)
+
+// Record is the event received from the subscription.
+type Record struct {
+	// The decoded event.
+	Event *binxml.Event `json:"event"`
+	// The BinXML result set of the event.
+	ResultSet *binxml.ResultSet `json:"result_set"`
+	// The bookmark XML pointing to this event, it can be used to resume
+	// the subscription after the event.
+	Bookmark string `json:"bookmark"`
+}
+
+// Subscription is the pull subscription to the event channels. The events
+// are fetched with EvtRpcRemoteSubscriptionNext, the subscription is
+// re-registered after the transport errors starting after the last bookmark.
+type Subscription struct {
+	// Dial returns the event service client. It is called to establish the
+	// subscription and on each reconnect. The client connection (the Conn)
+	// is owned by the subscription and is closed before the reconnect and
+	// once the iteration ends.
+	Dial func(context.Context) (EventServiceClient, error)
+	// The channel path, can be empty if the query is the structured XML query.
+	Path string
+	// The XPath or structured XML query.
+	Query string
+	// The bookmark XML to start after. If empty, the subscription starts with
+	// the future events or with the oldest record if StartAtOldestRecord is set.
+	// The bookmark is updated with each received event.
+	Bookmark string
+	// StartAtOldestRecord is set to receive all events from the channels.
+	StartAtOldestRecord bool
+	// Additional subscription flags (SubscribeTolerateQueryErrors, SubscribeStrict).
+	Flags uint32
+	// The maximum number of events returned by the single call.
+	BatchSize uint32
+	// The timeout of the single call.
+	Timeout time.Duration
+	// The delay between the reconnect attempts.
+	ReconnectDelay time.Duration
+	// The maximum number of consecutive reconnect attempts, zero means no
+	// reconnect, negative value means unlimited attempts.
+	MaxReconnects int
+}
+
+// errStopIteration is returned when the consumer stops the iteration.
+var errStopIteration = errors.New("ieventservice: stop iteration")
+
+// Events function returns the iterator over the subscription events. The
+// iteration ends when the consumer stops it, the context is done or the
+// non-recoverable error is returned. The Bookmark field is updated with the
+// bookmark of each received event (including the event that cannot be
+// decoded) before the event is yielded, so the subscription is resumed
+// after the last received event.
+func (o *Subscription) Events(ctx context.Context) iter.Seq2[*Record, error] {
+
+	return func(yield func(*Record, error) bool) {
+
+		for attempt := 0; ; attempt++ {
+
+			received, err := o.run(ctx, yield)
+			if err == nil || errors.Is(err, errStopIteration) {
+				return
+			}
+
+			if received {
+				// the subscription made progress, reset the counter.
+				attempt = 0
+			}
+
+			var terr *transportError
+			if ctx.Err() != nil || !errors.As(err, &terr) || (o.MaxReconnects >= 0 && attempt >= o.MaxReconnects) {
+				yield(nil, err)
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				yield(nil, ctx.Err())
+				return
+			case <-time.After(o.reconnectDelay()):
+			}
+		}
+	}
+}
+
+// Channel function returns the channel of the subscription events. The
+// channel is closed when the iteration ends, the error (if any) is sent as
+// the record with nil event.
+func (o *Subscription) Channel(ctx context.Context) <-chan *RecordOrError {
+
+	ch := make(chan *RecordOrError)
+
+	go func() {
+		defer close(ch)
+		for rec, err := range o.Events(ctx) {
+			select {
+			case ch <- &RecordOrError{Record: rec, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// RecordOrError is the subscription channel item.
+type RecordOrError struct {
+	Record *Record
+	Err    error
+}
+
+// transportError is the error not reported by the server.
+type transportError struct{ err error }
+
+func (e *transportError) Error() string { return e.err.Error() }
+func (e *transportError) Unwrap() error { return e.err }
+
+// run function registers the subscription and pulls the events until the
+// error. The received flag is set if at least one event was received.
+func (o *Subscription) run(ctx context.Context, yield func(*Record, error) bool) (bool, error) {
+
+	cli, err := o.Dial(ctx)
+	if err != nil {
+		return false, &transportError{fmt.Errorf("ieventservice: dial: %w", err)}
+	}
+
+	defer func() {
+		if cc := cli.Conn(); cc != nil {
+			cc.Close(ctx)
+		}
+	}()
+
+	flags := o.Flags | uint32(SubscribePull) | SubscribeToFutureEvents
+	switch {
+	case o.Bookmark != "":
+		flags = o.Flags | uint32(SubscribePull) | SubscribeStartAfterBookmark
+	case o.StartAtOldestRecord:
+		flags = o.Flags | uint32(SubscribePull) | SubscribeStartAtOldestRecord
+	}
+
+	sub, err := cli.RegisterRemoteSubscription(ctx, &RegisterRemoteSubscriptionRequest{
+		ChannelPath: o.Path,
+		Query:       o.Query,
+		BookmarkXML: o.Bookmark,
+		Flags:       flags,
+	})
+	if err != nil {
+		if sub == nil {
+			return false, &transportError{fmt.Errorf("ieventservice: register subscription: %w", err)}
+		}
+		return false, fmt.Errorf("ieventservice: register subscription: %w", err)
+	}
+
+	defer func() {
+		// the handles are released on the best-effort basis.
+		cli.Close(ctx, &CloseRequest{Handle: sub.Handle.ContextHandle()})
+		cli.Close(ctx, &CloseRequest{Handle: sub.Control.ContextHandle()})
+	}()
+
+	channels := make([]string, len(sub.QueryChannelInfo))
+	for i, info := range sub.QueryChannelInfo {
+		channels[i] = info.Name
+	}
+
+	received := false
+
+	for {
+
+		if err := ctx.Err(); err != nil {
+			return received, err
+		}
+
+		resp, err := cli.RemoteSubscriptionNext(ctx, &RemoteSubscriptionNextRequest{
+			Handle:                 sub.Handle,
+			RequestedRecordsLength: o.batchSize(),
+			Timeout:                uint32(o.timeout().Milliseconds()),
+		})
+		if err != nil {
+			if resp == nil {
+				return received, &transportError{fmt.Errorf("ieventservice: subscription next: %w", err)}
+			}
+			if errors.Is(err, win32.ErrorTimeout) || errors.Is(err, win32.ErrorNoMoreItems) {
+				continue
+			}
+			return received, fmt.Errorf("ieventservice: subscription next: %w", err)
+		}
+
+		for i := range resp.EventDataIndices {
+
+			rec, err := parseRecord(resp, i, channels)
+
+			// the event that cannot be decoded is skipped on resume.
+			if rec != nil && rec.Bookmark != "" {
+				o.Bookmark, received = rec.Bookmark, true
+			}
+
+			if err != nil {
+				if !yield(nil, err) {
+					return received, errStopIteration
+				}
+				continue
+			}
+
+			if !yield(rec, nil) {
+				return received, errStopIteration
+			}
+		}
+	}
+}
+
+// parseRecord function decodes the i-th event of the result buffer. If the
+// result set is decoded, but the event is not, the record with the bookmark
+// is returned with the error.
+func parseRecord(resp *RemoteSubscriptionNextResponse, i int, channels []string) (*Record, error) {
+
+	if i >= len(resp.EventDataSizes) {
+		return nil, fmt.Errorf("ieventservice: event %d: missing event size", i)
+	}
+
+	off, size := uint64(resp.EventDataIndices[i]), uint64(resp.EventDataSizes[i])
+	if off+size > uint64(len(resp.ResultBuffer)) {
+		return nil, fmt.Errorf("ieventservice: event %d: out of result buffer bounds", i)
+	}
+
+	rs, err := binxml.Unmarshal(resp.ResultBuffer[off : off+size])
+	if err != nil {
+		return nil, fmt.Errorf("ieventservice: event %d: %w", i, err)
+	}
+
+	rec := &Record{ResultSet: rs}
+
+	if rs.Bookmark != nil {
+		rec.Bookmark = rs.Bookmark.XML(channels)
+	}
+
+	if rec.Event, err = rs.Event(); err != nil {
+		return rec, fmt.Errorf("ieventservice: event %d: %w", i, err)
+	}
+
+	return rec, nil
+}
+
+func (o *Subscription) batchSize() uint32 {
+	if o.BatchSize == 0 {
+		return defaultSubscriptionBatchSize
+	}
+	return o.BatchSize
+}
+
+func (o *Subscription) timeout() time.Duration {
+	if o.Timeout <= 0 {
+		return defaultSubscriptionTimeout
+	}
+	return o.Timeout
+}
+
+func (o *Subscription) reconnectDelay() time.Duration {
+	if o.ReconnectDelay <= 0 {
+		return defaultReconnectDelay
+	}
+	return o.ReconnectDelay
+}
@@ -0,0 +1,97 @@
+package ieventservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+)
+
+type testSubscriptionClient struct {
+	EventServiceClient
+	register func() (*RegisterRemoteSubscriptionResponse, error)
+	bookmark string
+	conn     *testSubscriptionConn
+}
+
+// testSubscriptionConn counts the connection closes.
+type testSubscriptionConn struct {
+	dcerpc.Conn
+	closed int
+}
+
+func (c *testSubscriptionConn) Close(ctx context.Context) error {
+	c.closed++
+	return nil
+}
+
+func (c *testSubscriptionClient) Conn() dcerpc.Conn {
+	return c.conn
+}
+
+func (c *testSubscriptionClient) RegisterRemoteSubscription(ctx context.Context, in *RegisterRemoteSubscriptionRequest, opts ...dcerpc.CallOption) (*RegisterRemoteSubscriptionResponse, error) {
+	c.bookmark = in.BookmarkXML
+	return c.register()
+}
+
+func (c *testSubscriptionClient) RemoteSubscriptionNext(ctx context.Context, in *RemoteSubscriptionNextRequest, opts ...dcerpc.CallOption) (*RemoteSubscriptionNextResponse, error) {
+	return nil, io.EOF
+}
+
+func (c *testSubscriptionClient) Close(ctx context.Context, in *CloseRequest, opts ...dcerpc.CallOption) (*CloseResponse, error) {
+	return &CloseResponse{}, nil
+}
+
+func TestSubscriptionReconnect(t *testing.T) {
+
+	dials := 0
+
+	cli := &testSubscriptionClient{conn: &testSubscriptionConn{}}
+
+	sub := &Subscription{
+		Dial: func(ctx context.Context) (EventServiceClient, error) {
+			if dials++; dials == 1 {
+				cli.register = func() (*RegisterRemoteSubscriptionResponse, error) {
+					return &RegisterRemoteSubscriptionResponse{Handle: &RemoteSubscription{}, Control: &OperationControl{}}, nil
+				}
+			} else {
+				cli.register = func() (*RegisterRemoteSubscriptionResponse, error) {
+					return &RegisterRemoteSubscriptionResponse{Return: 5}, win32.ErrorAccessDenied
+				}
+			}
+			return cli, nil
+		},
+		Query:          "*",
+		Bookmark:       "<BookmarkList/>",
+		ReconnectDelay: time.Millisecond,
+		MaxReconnects:  3,
+	}
+
+	var errs []error
+	for rec, err := range sub.Events(context.Background()) {
+		if rec != nil {
+			t.Fatalf("unexpected record")
+		}
+		errs = append(errs, err)
+	}
+
+	if dials != 2 {
+		t.Errorf("expected 2 dials, got %d", dials)
+	}
+
+	if cli.conn.closed != 2 {
+		t.Errorf("expected 2 connection closes, got %d", cli.conn.closed)
+	}
+
+	if cli.bookmark != "<BookmarkList/>" {
+		t.Errorf("expected bookmark on reconnect, got %q", cli.bookmark)
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], win32.ErrorAccessDenied) {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
@@ -0,0 +1,64 @@
+package icertpassage
+
+import (
+	"context"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/wcce"
+)
+
+// Submitter submits the certificate requests with CertServerRequest.
+type Submitter struct {
+	// The certificate passage client.
+	Client CertPassageClient
+}
+
+// NewSubmitter function returns the certificate request submitter.
+func NewSubmitter(cli CertPassageClient) *Submitter {
+	return &Submitter{Client: cli}
+}
+
+// NewEnrollment function returns the certificate enrollment client for the CA.
+func NewEnrollment(cli CertPassageClient, authority string) *wcce.Enrollment {
+	return wcce.NewEnrollment(NewSubmitter(cli), authority)
+}
+
+// Submit function submits the request to the CA. The response is returned
+// together with the error if the server returned the disposition.
+func (o *Submitter) Submit(ctx context.Context, authority string, req *wcce.SubmitRequest) (*wcce.SubmitResponse, error) {
+
+	attrs, err := wcce.EncodeAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := o.Client.CertServerRequest(ctx, &CertServerRequestRequest{
+		Flags:      req.Flags,
+		Authority:  authority,
+		RequestID:  req.RequestID,
+		Attributes: &wcce.CertTransportBlob{Length: uint32(len(attrs)), Buffer: attrs},
+		Request:    &wcce.CertTransportBlob{Length: uint32(len(req.Request)), Buffer: req.Request},
+	})
+	if resp == nil {
+		return nil, err
+	}
+
+	ret := &wcce.SubmitResponse{
+		RequestID:   resp.RequestID,
+		Disposition: resp.Disposition,
+		CertChain:   blobBytes(resp.Cert),
+		EncodedCert: blobBytes(resp.EncodedCert),
+	}
+
+	if msg := blobBytes(resp.DispositionMessage); len(msg) > 0 {
+		ret.DispositionMessage, _ = wcce.DecodePropertyString(msg)
+	}
+
+	return ret, err
+}
+
+func blobBytes(blob *wcce.CertTransportBlob) []byte {
+	if blob == nil {
+		return nil
+	}
+	return blob.Buffer
+}
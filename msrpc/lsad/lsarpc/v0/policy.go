@@ -0,0 +1,303 @@
+package lsarpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/ntstatus"
+)
+
+// Policy is the LSA policy object. Policy wraps the LSA client and the
+// policy handle, and provides access to the trusted domains, secrets and
+// account rights.
+//
+//	pol, err := lsarpc.NewPolicy(ctx, cli, "")
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer pol.Close(ctx)
+//
+//	rights, err := pol.AccountRights(ctx, sid)
+type Policy struct {
+	// The LSA client.
+	Client LsarpcClient
+	// The policy handle.
+	Handle *Handle
+}
+
+// NewPolicy function opens the policy handle using LsarOpenPolicy2 with the
+// maximum allowed access.
+func NewPolicy(ctx context.Context, cli LsarpcClient, systemName string) (*Policy, error) {
+	return NewPolicyWithAccess(ctx, cli, systemName, dtyp.AccessMaskMaximumAllowed)
+}
+
+// NewPolicyWithAccess function opens the policy handle using LsarOpenPolicy2 with the
+// desired access.
+func NewPolicyWithAccess(ctx context.Context, cli LsarpcClient, systemName string, access uint32) (*Policy, error) {
+
+	resp, err := cli.OpenPolicy2(ctx, &OpenPolicy2Request{
+		SystemName:       systemName,
+		ObjectAttributes: &ObjectAttributes{},
+		DesiredAccess:    access,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: open policy: %w", err)
+	}
+
+	return &Policy{Client: cli, Handle: resp.Policy}, nil
+}
+
+// Close function closes the policy handle.
+func (o *Policy) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.Client, o.Handle)
+}
+
+// query function returns the policy information for the class.
+func (o *Policy) query(ctx context.Context, class PolicyInformationClass) (any, error) {
+
+	resp, err := o.Client.QueryInformationPolicy2(ctx, &QueryInformationPolicy2Request{
+		Policy:           o.Handle,
+		InformationClass: class,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: query information policy: %w", err)
+	}
+
+	return resp.PolicyInformation.GetValue(), nil
+}
+
+// AccountDomain function returns the account domain name and SID.
+func (o *Policy) AccountDomain(ctx context.Context) (*PolicyAccountDomInfo, error) {
+
+	info, err := o.query(ctx, PolicyInformationClassAccountDomainInformation)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, ok := info.(*PolicyAccountDomInfo); ok && info != nil {
+		return info, nil
+	}
+
+	return nil, fmt.Errorf("lsa: query account domain: unexpected information %T", info)
+}
+
+// PrimaryDomain function returns the primary domain name and SID.
+func (o *Policy) PrimaryDomain(ctx context.Context) (*PolicyPrimaryDomInfo, error) {
+
+	info, err := o.query(ctx, PolicyInformationClassPrimaryDomainInformation)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, ok := info.(*PolicyPrimaryDomInfo); ok && info != nil {
+		return info, nil
+	}
+
+	return nil, fmt.Errorf("lsa: query primary domain: unexpected information %T", info)
+}
+
+// DNSDomain function returns the DNS domain information.
+func (o *Policy) DNSDomain(ctx context.Context) (*PolicyDNSDomainInfo, error) {
+
+	info, err := o.query(ctx, PolicyInformationClassDNSDomainInformation)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, ok := info.(*PolicyDNSDomainInfo); ok && info != nil {
+		return info, nil
+	}
+
+	return nil, fmt.Errorf("lsa: query dns domain: unexpected information %T", info)
+}
+
+// Privileges function returns the list of the privileges known to the server.
+func (o *Policy) Privileges(ctx context.Context) ([]*PolicyPrivilegeDefinition, error) {
+
+	var privs []*PolicyPrivilegeDefinition
+
+	for enum := uint32(0); ; {
+
+		resp, err := o.Client.EnumeratePrivileges(ctx, &EnumeratePrivilegesRequest{
+			Policy:                 o.Handle,
+			EnumerationContext:     enum,
+			PreferredMaximumLength: 0xFFFF,
+		})
+		if err != nil {
+			if errors.Is(err, ntstatus.StatusNoMoreEntries) {
+				break
+			}
+			if !errors.Is(err, ntstatus.StatusMoreEntries) {
+				return nil, fmt.Errorf("lsa: enumerate privileges: %w", err)
+			}
+		}
+
+		if resp.EnumerationBuffer == nil || len(resp.EnumerationBuffer.Privileges) == 0 {
+			break
+		}
+
+		privs = append(privs, resp.EnumerationBuffer.Privileges...)
+
+		if enum == resp.EnumerationContext {
+			break
+		}
+
+		enum = resp.EnumerationContext
+	}
+
+	return privs, nil
+}
+
+// LookupPrivilegeValue function returns the locally unique identifier of the privilege.
+func (o *Policy) LookupPrivilegeValue(ctx context.Context, name string) (*dtyp.LUID, error) {
+
+	resp, err := o.Client.LookupPrivilegeValue(ctx, &LookupPrivilegeValueRequest{
+		Policy: o.Handle,
+		Name:   &dtyp.UnicodeString{Buffer: name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: lookup privilege value: %w", err)
+	}
+
+	return resp.Value, nil
+}
+
+// LookupPrivilegeName function returns the name of the privilege.
+func (o *Policy) LookupPrivilegeName(ctx context.Context, luid *dtyp.LUID) (string, error) {
+
+	resp, err := o.Client.LookupPrivilegeName(ctx, &LookupPrivilegeNameRequest{
+		Policy: o.Handle,
+		Value:  luid,
+	})
+	if err != nil {
+		return "", fmt.Errorf("lsa: lookup privilege name: %w", err)
+	}
+
+	return unicodeString(resp.Name), nil
+}
+
+// AccountRights function returns the rights and privileges assigned to the account.
+// No error is returned if the account has no rights assigned.
+func (o *Policy) AccountRights(ctx context.Context, sid *dtyp.SID) ([]string, error) {
+
+	resp, err := o.Client.EnumerateAccountRights(ctx, &EnumerateAccountRightsRequest{
+		Policy:     o.Handle,
+		AccountSID: sid,
+	})
+	if err != nil {
+		if errors.Is(err, ntstatus.StatusObjectNameNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lsa: enumerate account rights: %w", err)
+	}
+
+	if resp.UserRights == nil {
+		return nil, nil
+	}
+
+	rights := make([]string, 0, len(resp.UserRights.UserRights))
+	for _, right := range resp.UserRights.UserRights {
+		rights = append(rights, unicodeString(right))
+	}
+
+	return rights, nil
+}
+
+// AddAccountRights function assigns the rights and privileges to the account. The
+// account object is created if it does not exist.
+func (o *Policy) AddAccountRights(ctx context.Context, sid *dtyp.SID, rights ...string) error {
+
+	if _, err := o.Client.AddAccountRights(ctx, &AddAccountRightsRequest{
+		Policy:     o.Handle,
+		AccountSID: sid,
+		UserRights: newUserRightSet(rights),
+	}); err != nil {
+		return fmt.Errorf("lsa: add account rights: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveAccountRights function removes the rights and privileges from the account.
+// If no rights are given, all rights are removed and the account object is deleted.
+func (o *Policy) RemoveAccountRights(ctx context.Context, sid *dtyp.SID, rights ...string) error {
+
+	req := &RemoveAccountRightsRequest{
+		Policy:     o.Handle,
+		AccountSID: sid,
+		UserRights: newUserRightSet(rights),
+	}
+
+	if len(rights) == 0 {
+		req.AllRights = 1
+	}
+
+	if _, err := o.Client.RemoveAccountRights(ctx, req); err != nil {
+		return fmt.Errorf("lsa: remove account rights: %w", err)
+	}
+
+	return nil
+}
+
+// AccountsWithRight function returns the SIDs of the accounts that hold the right
+// or privilege.
+func (o *Policy) AccountsWithRight(ctx context.Context, right string) ([]*dtyp.SID, error) {
+
+	resp, err := o.Client.EnumerateAccountsWithUserRight(ctx, &EnumerateAccountsWithUserRightRequest{
+		Policy:    o.Handle,
+		UserRight: &dtyp.UnicodeString{Buffer: right},
+	})
+	if err != nil {
+		if errors.Is(err, ntstatus.StatusNoMoreEntries) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lsa: enumerate accounts with user right: %w", err)
+	}
+
+	if resp.EnumerationBuffer == nil {
+		return nil, nil
+	}
+
+	sids := make([]*dtyp.SID, 0, len(resp.EnumerationBuffer.Information))
+	for _, info := range resp.EnumerationBuffer.Information {
+		if info != nil && info.SID != nil {
+			sids = append(sids, info.SID)
+		}
+	}
+
+	return sids, nil
+}
+
+func newUserRightSet(rights []string) *UserRightSet {
+	set := &UserRightSet{Entries: uint32(len(rights))}
+	for _, right := range rights {
+		set.UserRights = append(set.UserRights, &dtyp.UnicodeString{Buffer: right})
+	}
+	return set
+}
+
+func closeHandle(ctx context.Context, cli LsarpcClient, h *Handle) error {
+	if h == nil {
+		return nil
+	}
+	if _, err := cli.Close(ctx, &CloseRequest{Object: h}); err != nil {
+		return fmt.Errorf("lsa: close handle: %w", err)
+	}
+	return nil
+}
+
+func deleteObject(ctx context.Context, cli LsarpcClient, h *Handle) error {
+	if _, err := cli.DeleteObject(ctx, &DeleteObjectRequest{Object: h}); err != nil {
+		return fmt.Errorf("lsa: delete object: %w", err)
+	}
+	return nil
+}
+
+func unicodeString(s *dtyp.UnicodeString) string {
+	if s == nil {
+		return ""
+	}
+	return s.Buffer
+}
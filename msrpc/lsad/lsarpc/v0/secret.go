@@ -0,0 +1,183 @@
+package lsarpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// Secret is the LSA secret object.
+type Secret struct {
+	policy *Policy
+	// The secret name.
+	Name string
+	// The secret handle.
+	Handle *Handle
+}
+
+// SecretValue is the decrypted LSA secret value.
+type SecretValue struct {
+	// The current value.
+	Current []byte `json:"current"`
+	// The time the current value was set.
+	CurrentSetTime time.Time `json:"current_set_time"`
+	// The previous value.
+	Old []byte `json:"old"`
+	// The time the previous value was set.
+	OldSetTime time.Time `json:"old_set_time"`
+}
+
+// OpenSecret function opens the secret with the name.
+func (o *Policy) OpenSecret(ctx context.Context, name string) (*Secret, error) {
+
+	resp, err := o.Client.OpenSecret(ctx, &OpenSecretRequest{
+		Policy:        o.Handle,
+		SecretName:    &dtyp.UnicodeString{Buffer: name},
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: open secret: %w", err)
+	}
+
+	return &Secret{policy: o, Name: name, Handle: resp.Secret}, nil
+}
+
+// CreateSecret function creates the secret with the name.
+func (o *Policy) CreateSecret(ctx context.Context, name string) (*Secret, error) {
+
+	resp, err := o.Client.CreateSecret(ctx, &CreateSecretRequest{
+		Policy:        o.Handle,
+		SecretName:    &dtyp.UnicodeString{Buffer: name},
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: create secret: %w", err)
+	}
+
+	return &Secret{policy: o, Name: name, Handle: resp.Secret}, nil
+}
+
+// Query function queries and decrypts the secret values using the session key.
+func (o *Secret) Query(ctx context.Context) (*SecretValue, error) {
+
+	key, ok := gssapi.GetEffectiveSessionKey(o.policy.Client.Conn().Context())
+	if !ok {
+		return nil, fmt.Errorf("lsa: query secret: unable to get session key")
+	}
+
+	resp, err := o.policy.Client.QuerySecret(ctx, &QuerySecretRequest{
+		Secret:                o.Handle,
+		EncryptedCurrentValue: &CRCipherValue{},
+		CurrentValueSetTime:   &dtyp.LargeInteger{},
+		EncryptedOldValue:     &CRCipherValue{},
+		OldValueSetTime:       &dtyp.LargeInteger{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: query secret: %w", err)
+	}
+
+	value := &SecretValue{
+		CurrentSetTime: largeIntegerToTime(resp.CurrentValueSetTime),
+		OldSetTime:     largeIntegerToTime(resp.OldValueSetTime),
+	}
+
+	if value.Current, err = DecryptSecret(key, resp.EncryptedCurrentValue); err != nil {
+		return nil, fmt.Errorf("lsa: query secret: current value: %w", err)
+	}
+
+	if value.Old, err = DecryptSecret(key, resp.EncryptedOldValue); err != nil {
+		return nil, fmt.Errorf("lsa: query secret: old value: %w", err)
+	}
+
+	return value, nil
+}
+
+// Set function encrypts the secret values using the session key and sets
+// them. The nil value is not changed.
+func (o *Secret) Set(ctx context.Context, current, old []byte) error {
+
+	key, ok := gssapi.GetEffectiveSessionKey(o.policy.Client.Conn().Context())
+	if !ok {
+		return fmt.Errorf("lsa: set secret: unable to get session key")
+	}
+
+	req := &SetSecretRequest{Secret: o.Handle}
+
+	var err error
+
+	if current != nil {
+		if req.EncryptedCurrentValue, err = EncryptSecret(key, current); err != nil {
+			return fmt.Errorf("lsa: set secret: %w", err)
+		}
+	}
+
+	if old != nil {
+		if req.EncryptedOldValue, err = EncryptSecret(key, old); err != nil {
+			return fmt.Errorf("lsa: set secret: %w", err)
+		}
+	}
+
+	if _, err := o.policy.Client.SetSecret(ctx, req); err != nil {
+		return fmt.Errorf("lsa: set secret: %w", err)
+	}
+
+	return nil
+}
+
+// Delete function deletes the secret. The secret handle is closed by the server.
+func (o *Secret) Delete(ctx context.Context) error {
+
+	if err := deleteObject(ctx, o.policy.Client, o.Handle); err != nil {
+		return err
+	}
+
+	o.Handle = nil
+	return nil
+}
+
+// Close function closes the secret handle.
+func (o *Secret) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.policy.Client, o.Handle)
+}
+
+// EncryptSecret function encrypts the secret value with the session key
+// as specified in MS-LSAD 5.1.2.
+func EncryptSecret(key []byte, b []byte) (*CRCipherValue, error) {
+
+	buf, err := crypto.EncryptSecret(key, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CRCipherValue{
+		Length:        uint32(len(buf)),
+		MaximumLength: uint32(len(buf)),
+		Buffer:        buf,
+	}, nil
+}
+
+// DecryptSecret function decrypts the secret value encrypted with the session
+// key as specified in MS-LSAD 5.1.2. The nil value is returned for the empty
+// cipher value.
+func DecryptSecret(key []byte, v *CRCipherValue) ([]byte, error) {
+
+	if v == nil || len(v.Buffer) == 0 {
+		return nil, nil
+	}
+
+	return crypto.DecryptSecret(key, v.Buffer)
+}
+
+func largeIntegerToTime(li *dtyp.LargeInteger) time.Time {
+	if li == nil {
+		return time.Time{}
+	}
+	return (&dtyp.Filetime{
+		LowDateTime:  uint32(li.QuadPart),
+		HighDateTime: uint32(li.QuadPart >> 32),
+	}).AsTime()
+}
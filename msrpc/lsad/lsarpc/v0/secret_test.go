@@ -0,0 +1,36 @@
+package lsarpc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncryptSecret(t *testing.T) {
+
+	key := []byte("0123456789abcdef")
+
+	for _, value := range [][]byte{
+		[]byte("secret"),
+		[]byte("0123456789abcdef0123456789abcdef"),
+		{},
+	} {
+
+		enc, err := EncryptSecret(key, value)
+		if err != nil {
+			t.Fatalf("encrypt_secret: %v", err)
+		}
+
+		if len(enc.Buffer)%8 != 0 {
+			t.Fatalf("encrypt_secret: invalid length %d", len(enc.Buffer))
+		}
+
+		dec, err := DecryptSecret(key, enc)
+		if err != nil {
+			t.Fatalf("decrypt_secret: %v", err)
+		}
+
+		if !bytes.Equal(dec, value) {
+			t.Errorf("decrypt_secret: value mismatch: %x, expected %x", dec, value)
+		}
+	}
+}
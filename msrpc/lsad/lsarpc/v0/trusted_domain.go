@@ -0,0 +1,342 @@
+package lsarpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	dcerpc_errors "github.com/oiweiwei/go-msrpc/dcerpc/errors"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/ntstatus"
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// The trust direction. (See MS-LSAD 2.2.7.9).
+const (
+	TrustDirectionInbound       uint32 = 0x00000001
+	TrustDirectionOutbound      uint32 = 0x00000002
+	TrustDirectionBidirectional uint32 = 0x00000003
+)
+
+// The trust type. (See MS-LSAD 2.2.7.9).
+const (
+	TrustTypeDownlevel uint32 = 0x00000001
+	TrustTypeUplevel   uint32 = 0x00000002
+	TrustTypeMIT       uint32 = 0x00000003
+)
+
+// The trust attributes. (See MS-LSAD 2.2.7.9).
+const (
+	TrustAttributeNonTransitive     uint32 = 0x00000001
+	TrustAttributeUplevelOnly       uint32 = 0x00000002
+	TrustAttributeQuarantinedDomain uint32 = 0x00000004
+	TrustAttributeForestTransitive  uint32 = 0x00000008
+	TrustAttributeCrossOrganization uint32 = 0x00000010
+	TrustAttributeWithinForest      uint32 = 0x00000020
+	TrustAttributeTreatAsExternal   uint32 = 0x00000040
+	TrustAttributeUsesRC4Encryption uint32 = 0x00000080
+)
+
+// The authentication information type. (See MS-LSAD 2.2.7.17).
+const (
+	TrustAuthTypeNone    uint32 = 0x00000000
+	TrustAuthTypeNT4OWF  uint32 = 0x00000001
+	TrustAuthTypeClear   uint32 = 0x00000002
+	TrustAuthTypeVersion uint32 = 0x00000003
+)
+
+var (
+	// The AES-256-CBC-HMAC-SHA512 key derivation labels.
+	aesEncryptionKeyLabel = []byte("Microsoft LSAD encryption key AEAD-AES-256-CBC-HMAC-SHA512 16\x00")
+	aesMACKeyLabel        = []byte("Microsoft LSAD MAC key AEAD-AES-256-CBC-HMAC-SHA512 16\x00")
+)
+
+// TrustedDomain is the LSA trusted domain object.
+type TrustedDomain struct {
+	policy *Policy
+	// The trusted domain handle.
+	Handle *Handle
+}
+
+// TrustedDomains function returns the list of the trusted domains.
+func (o *Policy) TrustedDomains(ctx context.Context) ([]*TrustedDomainInformationEx, error) {
+
+	var domains []*TrustedDomainInformationEx
+
+	for enum := uint32(0); ; {
+
+		resp, err := o.Client.EnumerateTrustedDomainsEx(ctx, &EnumerateTrustedDomainsExRequest{
+			Policy:                 o.Handle,
+			EnumerationContext:     enum,
+			PreferredMaximumLength: 0xFFFF,
+		})
+		if err != nil {
+			if errors.Is(err, ntstatus.StatusNoMoreEntries) {
+				break
+			}
+			if !errors.Is(err, ntstatus.StatusMoreEntries) {
+				return nil, fmt.Errorf("lsa: enumerate trusted domains: %w", err)
+			}
+		}
+
+		if resp.EnumerationBuffer == nil || len(resp.EnumerationBuffer.EnumerationBuffer) == 0 {
+			break
+		}
+
+		domains = append(domains, resp.EnumerationBuffer.EnumerationBuffer...)
+
+		if enum == resp.EnumerationContext {
+			break
+		}
+
+		enum = resp.EnumerationContext
+	}
+
+	return domains, nil
+}
+
+// OpenTrustedDomain function opens the trusted domain with the name.
+func (o *Policy) OpenTrustedDomain(ctx context.Context, name string) (*TrustedDomain, error) {
+
+	resp, err := o.Client.OpenTrustedDomainByName(ctx, &OpenTrustedDomainByNameRequest{
+		Policy:            o.Handle,
+		TrustedDomainName: &dtyp.UnicodeString{Buffer: name},
+		DesiredAccess:     dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: open trusted domain: %w", err)
+	}
+
+	return &TrustedDomain{policy: o, Handle: resp.TrustedDomain}, nil
+}
+
+// CreateTrustedDomain function creates the trusted domain object with the
+// authentication information. The authentication information is encrypted
+// with the session key using AES (LsarCreateTrustedDomainEx3), if the server
+// does not support it, RC4 (LsarCreateTrustedDomainEx2) is used.
+func (o *Policy) CreateTrustedDomain(ctx context.Context, info *TrustedDomainInformationEx, auth *TrustedDomainAuthInformation) (*TrustedDomain, error) {
+
+	key, ok := gssapi.GetEffectiveSessionKey(o.Client.Conn().Context())
+	if !ok {
+		return nil, fmt.Errorf("lsa: create trusted domain: unable to get session key")
+	}
+
+	blob, err := EncodeTrustedDomainAuthBlob(auth)
+	if err != nil {
+		return nil, fmt.Errorf("lsa: create trusted domain: %w", err)
+	}
+
+	authAES, err := EncryptTrustedDomainAuthInformationAES(key, blob)
+	if err != nil {
+		return nil, fmt.Errorf("lsa: create trusted domain: %w", err)
+	}
+
+	resp, err := o.Client.CreateTrustedDomainEx3(ctx, &CreateTrustedDomainEx3Request{
+		Policy:                    o.Handle,
+		TrustedDomainInformation:  info,
+		AuthenticationInformation: authAES,
+		DesiredAccess:             dtyp.AccessMaskMaximumAllowed,
+	})
+	if err == nil {
+		return &TrustedDomain{policy: o, Handle: resp.TrustedDomain}, nil
+	}
+
+	if !isNotSupported(err) {
+		return nil, fmt.Errorf("lsa: create trusted domain: %w", err)
+	}
+
+	authRC4, err := EncryptTrustedDomainAuthInformation(key, blob)
+	if err != nil {
+		return nil, fmt.Errorf("lsa: create trusted domain: %w", err)
+	}
+
+	resp2, err := o.Client.CreateTrustedDomainEx2(ctx, &CreateTrustedDomainEx2Request{
+		Policy:                    o.Handle,
+		TrustedDomainInformation:  info,
+		AuthenticationInformation: authRC4,
+		DesiredAccess:             dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: create trusted domain: %w", err)
+	}
+
+	return &TrustedDomain{policy: o, Handle: resp2.TrustedDomain}, nil
+}
+
+// DeleteTrustedDomain function deletes the trusted domain with the SID.
+func (o *Policy) DeleteTrustedDomain(ctx context.Context, sid *dtyp.SID) error {
+
+	if _, err := o.Client.DeleteTrustedDomain(ctx, &DeleteTrustedDomainRequest{
+		Policy:           o.Handle,
+		TrustedDomainSID: sid,
+	}); err != nil {
+		return fmt.Errorf("lsa: delete trusted domain: %w", err)
+	}
+
+	return nil
+}
+
+// Info function returns the trusted domain information.
+func (o *TrustedDomain) Info(ctx context.Context) (*TrustedDomainInformationEx, error) {
+
+	resp, err := o.policy.Client.QueryInfoTrustedDomain(ctx, &QueryInfoTrustedDomainRequest{
+		TrustedDomain:    o.Handle,
+		InformationClass: TrustedInformationClassDomainInformationEx,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lsa: query trusted domain information: %w", err)
+	}
+
+	info, ok := resp.TrustedDomainInformation.GetValue().(*TrustedDomainInformationEx)
+	if !ok || info == nil {
+		return nil, fmt.Errorf("lsa: query trusted domain information: unexpected information %T", resp.TrustedDomainInformation.GetValue())
+	}
+
+	return info, nil
+}
+
+// Delete function deletes the trusted domain. The trusted domain handle is
+// closed by the server.
+func (o *TrustedDomain) Delete(ctx context.Context) error {
+
+	if err := deleteObject(ctx, o.policy.Client, o.Handle); err != nil {
+		return err
+	}
+
+	o.Handle = nil
+	return nil
+}
+
+// Close function closes the trusted domain handle.
+func (o *TrustedDomain) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.policy.Client, o.Handle)
+}
+
+// EncodeTrustedDomainAuthBlob function returns the LSAPR_TRUSTED_DOMAIN_AUTH_BLOB
+// (see MS-LSAD 2.2.7.16) for the authentication information: the random 512-byte
+// confounder, the outgoing and incoming authentication information and their sizes.
+func EncodeTrustedDomainAuthBlob(auth *TrustedDomainAuthInformation) ([]byte, error) {
+
+	if auth == nil {
+		auth = &TrustedDomainAuthInformation{}
+	}
+
+	b, err := crypto.Nonce(512)
+	if err != nil {
+		return nil, err
+	}
+
+	var outgoing, incoming []byte
+
+	if auth.OutgoingAuthInfos > 0 {
+		outgoing = encodeAuthInfoBlob(auth.OutgoingAuthenticationInformation, auth.OutgoingPreviousAuthenticationInformation)
+	} else {
+		outgoing = encodeAuthInfoBlob(nil, nil)
+	}
+
+	if auth.IncomingAuthInfos > 0 {
+		incoming = encodeAuthInfoBlob(auth.IncomingAuthenticationInformation, auth.IncomingPreviousAuthenticationInformation)
+	} else {
+		incoming = encodeAuthInfoBlob(nil, nil)
+	}
+
+	b = append(b, outgoing...)
+	b = append(b, incoming...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(outgoing)))
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(incoming)))
+
+	return b, nil
+}
+
+// EncryptTrustedDomainAuthInformation function encrypts the authentication blob
+// with the session key using RC4.
+func EncryptTrustedDomainAuthInformation(key []byte, blob []byte) (*TrustedDomainAuthInformationInternal, error) {
+
+	b := append([]byte{}, blob...)
+
+	if err := crypto.RC4K(key, b); err != nil {
+		return nil, fmt.Errorf("encrypt trusted domain auth information: %w", err)
+	}
+
+	return &TrustedDomainAuthInformationInternal{
+		AuthBlob: &TrustedDomainAuthBlob{AuthSize: uint32(len(b)), AuthBlob: b},
+	}, nil
+}
+
+// EncryptTrustedDomainAuthInformationAES function encrypts the authentication blob
+// with the session key using AES-256-CBC-HMAC-SHA512.
+func EncryptTrustedDomainAuthInformationAES(key []byte, blob []byte) (*TrustedDomainAuthInformationInternalAES, error) {
+
+	salt, err := crypto.Nonce(16)
+	if err != nil {
+		return nil, err
+	}
+
+	c, authData, err := crypto.AEAD_AES_256_CBC_HMAC_SHA512_Encrypt(key, aesEncryptionKeyLabel, aesMACKeyLabel, salt, blob)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt trusted domain auth information: %w", err)
+	}
+
+	return &TrustedDomainAuthInformationInternalAES{
+		AuthData:     authData,
+		Salt:         salt,
+		CipherLength: uint32(len(c)),
+		Cipher:       c,
+	}, nil
+}
+
+// encodeAuthInfoBlob function encodes the authentication information count,
+// the offsets and the current and previous authentication information arrays.
+// If previous information is not set, the current information is used.
+func encodeAuthInfoBlob(current, previous *AuthInformation) []byte {
+
+	if current == nil {
+		return make([]byte, 12)
+	}
+
+	if previous == nil {
+		previous = current
+	}
+
+	cur, prev := encodeAuthInfo(current), encodeAuthInfo(previous)
+
+	b := make([]byte, 0, 12+len(cur)+len(prev))
+	b = binary.LittleEndian.AppendUint32(b, 1)
+	b = binary.LittleEndian.AppendUint32(b, 12)
+	b = binary.LittleEndian.AppendUint32(b, uint32(12+len(cur)))
+	b = append(b, cur...)
+	b = append(b, prev...)
+
+	return b
+}
+
+func encodeAuthInfo(info *AuthInformation) []byte {
+
+	var lastUpdateTime int64
+	if info.LastUpdateTime != nil {
+		lastUpdateTime = info.LastUpdateTime.QuadPart
+	}
+
+	b := make([]byte, 0, 16+len(info.AuthInfo)+3)
+	b = binary.LittleEndian.AppendUint64(b, uint64(lastUpdateTime))
+	b = binary.LittleEndian.AppendUint32(b, info.AuthType)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(info.AuthInfo)))
+	b = append(b, info.AuthInfo...)
+
+	// align to 4 bytes.
+	for len(b)%4 != 0 {
+		b = append(b, 0)
+	}
+
+	return b
+}
+
+// isNotSupported function returns true if the error indicates that the
+// server does not implement the method.
+func isNotSupported(err error) bool {
+	return errors.Is(err, dcerpc_errors.OperationRangeError) ||
+		errors.Is(err, ntstatus.RpcNtProcnumOutOfRange) ||
+		errors.Is(err, ntstatus.StatusNotSupported)
+}
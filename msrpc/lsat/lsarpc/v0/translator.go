@@ -0,0 +1,190 @@
+package lsarpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/ntstatus"
+)
+
+// DefaultTranslateBatchSize is the maximum number of the SIDs or names
+// translated within a single call.
+const DefaultTranslateBatchSize = 1000
+
+// The client revision sent with the LsarLookupSids3 and LsarLookupNames4 (LSA_CLIENT_REVISION_2).
+const ClientRevision2 = 0x00000002
+
+// Translator translates the SIDs to the names and vice versa in batches
+// using LsarLookupSids3 and LsarLookupNames4. These methods do not require
+// the policy handle, but must be called over the TCP connection secured
+// with the netlogon secure channel:
+//
+//	sc, err := logon.NewSecureChannelClient(ctx, cc, dcerpc.WithSeal(), dcerpc.WithEndpoint("ncacn_ip_tcp:"))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	cli, err := lsarpc.NewLsarpcClient(ctx, cc, dcerpc.WithSecurityConfig(sc.SecurityConfig()), dcerpc.WithSeal(), dcerpc.WithEndpoint("ncacn_ip_tcp:"))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	names, err := lsarpc.NewTranslator(cli).LookupSIDs(ctx, sids...)
+type Translator struct {
+	// The LSA client.
+	Client LsarpcClient
+	// The lookup level. (LookupLevelWorkstation by default).
+	LookupLevel LookupLevel
+	// The batch size. (DefaultTranslateBatchSize by default).
+	BatchSize int
+}
+
+// Translation is the result of the SID or name translation.
+type Translation struct {
+	// The account SID.
+	SID *dtyp.SID `json:"sid"`
+	// The account name.
+	Name string `json:"name"`
+	// The account domain name.
+	DomainName string `json:"domain_name"`
+	// The account domain SID.
+	DomainSID *dtyp.SID `json:"domain_sid"`
+	// The account type.
+	Use SIDNameUse `json:"use"`
+}
+
+// IsMapped function returns true if the SID or name was translated.
+func (o *Translation) IsMapped() bool {
+	return o.Use != SIDNameUseTypeUnknown && o.Use != SIDNameUseTypeInvalid && o.Use != 0
+}
+
+// NewTranslator function returns the translator for the client.
+func NewTranslator(cli LsarpcClient) *Translator {
+	return &Translator{
+		Client:      cli,
+		LookupLevel: LookupLevelWorkstation,
+		BatchSize:   DefaultTranslateBatchSize,
+	}
+}
+
+func (o *Translator) level() LookupLevel {
+	if o.LookupLevel == 0 {
+		return LookupLevelWorkstation
+	}
+	return o.LookupLevel
+}
+
+func (o *Translator) batchSize() int {
+	if o.BatchSize <= 0 {
+		return DefaultTranslateBatchSize
+	}
+	return o.BatchSize
+}
+
+// LookupSIDs function translates the SIDs to the names. The result contains
+// the translation for every SID in the same order, the SIDs that cannot be
+// translated have SIDNameUseTypeUnknown use.
+func (o *Translator) LookupSIDs(ctx context.Context, sids ...*dtyp.SID) ([]*Translation, error) {
+
+	ret := make([]*Translation, 0, len(sids))
+
+	for batch := range slices.Chunk(sids, o.batchSize()) {
+
+		buf := &SIDEnumBuffer{Entries: uint32(len(batch))}
+		for _, sid := range batch {
+			buf.SIDInfo = append(buf.SIDInfo, &SIDInformation{SID: sid})
+		}
+
+		resp, err := o.Client.LookupSids3(ctx, &LookupSids3Request{
+			SIDEnumBuffer:   buf,
+			TranslatedNames: &TranslatedNamesEx{},
+			LookupLevel:     o.level(),
+			ClientRevision:  ClientRevision2,
+		})
+		if err != nil && !isPartiallyMapped(err) {
+			return nil, fmt.Errorf("lsa: lookup sids: %w", err)
+		}
+
+		var names []*TranslatedNameEx
+		if resp.TranslatedNames != nil {
+			names = resp.TranslatedNames.Names
+		}
+
+		for i, sid := range batch {
+			t := &Translation{SID: sid, Use: SIDNameUseTypeUnknown}
+			if i < len(names) && names[i] != nil {
+				t.Name, t.Use = unicodeString(names[i].Name), names[i].Use
+				t.DomainName, t.DomainSID = referencedDomain(resp.ReferencedDomains, names[i].DomainIndex)
+			}
+			ret = append(ret, t)
+		}
+	}
+
+	return ret, nil
+}
+
+// LookupNames function translates the names to the SIDs. The result contains
+// the translation for every name in the same order, the names that cannot be
+// translated have SIDNameUseTypeUnknown use.
+func (o *Translator) LookupNames(ctx context.Context, names ...string) ([]*Translation, error) {
+
+	ret := make([]*Translation, 0, len(names))
+
+	for batch := range slices.Chunk(names, o.batchSize()) {
+
+		req := &LookupNames4Request{
+			Count:          uint32(len(batch)),
+			TranslatedSIDs: &TranslatedSIDsEx2{},
+			LookupLevel:    o.level(),
+			ClientRevision: ClientRevision2,
+		}
+
+		for _, name := range batch {
+			req.Names = append(req.Names, &dtyp.UnicodeString{Buffer: name})
+		}
+
+		resp, err := o.Client.LookupNames4(ctx, req)
+		if err != nil && !isPartiallyMapped(err) {
+			return nil, fmt.Errorf("lsa: lookup names: %w", err)
+		}
+
+		var sids []*TranslatedSIDEx2
+		if resp.TranslatedSIDs != nil {
+			sids = resp.TranslatedSIDs.SIDs
+		}
+
+		for i, name := range batch {
+			t := &Translation{Name: name, Use: SIDNameUseTypeUnknown}
+			if i < len(sids) && sids[i] != nil {
+				t.SID, t.Use = sids[i].SID, sids[i].Use
+				t.DomainName, t.DomainSID = referencedDomain(resp.ReferencedDomains, sids[i].DomainIndex)
+			}
+			ret = append(ret, t)
+		}
+	}
+
+	return ret, nil
+}
+
+// isPartiallyMapped function returns true if the error indicates that
+// the response contains the translations.
+func isPartiallyMapped(err error) bool {
+	return errors.Is(err, ntstatus.StatusSomeNotMapped) || errors.Is(err, ntstatus.StatusNoneMapped)
+}
+
+func referencedDomain(domains *ReferencedDomainList, idx int32) (string, *dtyp.SID) {
+	if domains == nil || idx < 0 || int(idx) >= len(domains.Domains) || domains.Domains[idx] == nil {
+		return "", nil
+	}
+	return unicodeString(domains.Domains[idx].Name), domains.Domains[idx].SID
+}
+
+func unicodeString(s *dtyp.UnicodeString) string {
+	if s == nil {
+		return ""
+	}
+	return s.Buffer
+}
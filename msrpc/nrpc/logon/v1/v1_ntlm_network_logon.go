@@ -0,0 +1,159 @@
+package logon
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/ssp/ntlm"
+)
+
+// NTLMNetworkLogon implements the NTLM network logon (pass-through authentication)
+// using the NetrLogonSamLogonEx call over the netlogon secure channel.
+//
+// The value can be used as the NTLM acceptor credential database, so that the
+// acceptor verifies the authenticate message with the domain controller and
+// never needs to know the user password:
+//
+//	gssapi.WithCredentialDatabase(gssapi.NewCredentialDatabase(logon.NewNTLMNetworkLogon(cli)))
+type NTLMNetworkLogon struct {
+	// The secure channel client.
+	Client LogonSecureChannelClient
+	// The validation level. (NetlogonValidationSamInfo4 by default).
+	ValidationLevel ValidationInfoClass
+	// The logon identity parameter control flags.
+	ParameterControl uint32
+}
+
+// NewNTLMNetworkLogon function returns the new NTLM network logon for the
+// established secure channel client.
+func NewNTLMNetworkLogon(cli LogonSecureChannelClient) *NTLMNetworkLogon {
+	return &NTLMNetworkLogon{
+		Client:          cli,
+		ValidationLevel: ValidationInfoClassSAMInfo4,
+		ParameterControl: IdentityAllowServerTrustAccount |
+			IdentityAllowWorkstationTrustAccount,
+	}
+}
+
+var (
+	_ ntlm.NetworkLogon = (*NTLMNetworkLogon)(nil)
+)
+
+// NetworkLogon function forwards the authenticate message for the server challenge
+// to the domain controller and returns the user session key.
+func (o *NTLMNetworkLogon) NetworkLogon(ctx context.Context, cred ntlm.Credential, nonce []byte, am *ntlm.AuthenticateMessage) (*ntlm.NetworkLogonInfo, error) {
+
+	lvl := o.ValidationLevel
+	if lvl == 0 {
+		lvl = ValidationInfoClassSAMInfo4
+	}
+
+	var logonServer string
+	if dc := o.Client.DomainControllerInfo(); dc != nil {
+		logonServer = dc.DomainControllerName
+	}
+
+	resp, err := o.Client.SAMLogonEx(ctx, &SAMLogonExRequest{
+		LogonServer:  logonServer,
+		ComputerName: o.Client.ComputerName(),
+		LogonLevel:   LogonInfoClassNetworkTransitiveInformation,
+		LogonInformation: &Level{
+			Value: &Level_LogonNetworkTransitive{
+				LogonNetworkTransitive: &NetworkInfo{
+					Identity: &LogonIdentityInfo{
+						ParameterControl: o.ParameterControl,
+						LogonDomainName:  &dtyp.UnicodeString{Buffer: cred.DomainName()},
+						UserName:         &dtyp.UnicodeString{Buffer: cred.UserName()},
+						Workstation:      &dtyp.UnicodeString{Buffer: cred.Workstation()},
+					},
+					LMChallenge:         &LMChallenge{Data: nonce},
+					NTChallengeResponse: &String{Buffer: am.NTChallengeResponse},
+					LMChallengeResponse: &String{Buffer: am.LMChallengeResponse},
+				},
+			},
+		},
+		ValidationLevel: lvl,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("network_logon: sam_logon_ex: %w", err)
+	}
+
+	info := &ntlm.NetworkLogonInfo{}
+
+	var (
+		userKey *UserSessionKey
+		lmKey   []byte
+		// NetlogonValidationSamInfo4 keys are not encrypted.
+		encrypted bool
+	)
+
+	switch v := resp.ValidationInformation.GetValue().(type) {
+	case *ValidationSAMInfo4:
+		userKey, lmKey = v.UserSessionKey, v.LMKey
+		info.UserName, info.DomainName = unicodeString(v.EffectiveName), unicodeString(v.LogonDomainName)
+	case *ValidationSAMInfo2:
+		userKey, lmKey, encrypted = v.UserSessionKey, expansionRoomLMKey(v.ExpansionRoom), true
+		info.UserName, info.DomainName = unicodeString(v.EffectiveName), unicodeString(v.LogonDomainName)
+	case *ValidationSAMInfo:
+		userKey, lmKey, encrypted = v.UserSessionKey, expansionRoomLMKey(v.ExpansionRoom), true
+		info.UserName, info.DomainName = unicodeString(v.EffectiveName), unicodeString(v.LogonDomainName)
+	default:
+		return nil, fmt.Errorf("network_logon: unsupported validation information %T", v)
+	}
+
+	if userKey != nil {
+		for _, cb := range userKey.Data {
+			if cb != nil {
+				info.UserSessionKey = append(info.UserSessionKey, cb.Data...)
+			}
+		}
+	}
+
+	info.LMSessionKey = lmKey
+
+	if encrypted {
+		// do not decrypt an all-zero key.
+		if !isZeroKey(info.UserSessionKey) {
+			if info.UserSessionKey, err = o.Client.Decrypt(ctx, info.UserSessionKey); err != nil {
+				return nil, fmt.Errorf("network_logon: decrypt user session key: %w", err)
+			}
+		}
+		if !isZeroKey(info.LMSessionKey) {
+			if info.LMSessionKey, err = o.Client.Decrypt(ctx, info.LMSessionKey); err != nil {
+				return nil, fmt.Errorf("network_logon: decrypt lm session key: %w", err)
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// expansionRoomLMKey function returns the LM session key stored in the first
+// two elements of the expansion room.
+func expansionRoomLMKey(room []uint32) []byte {
+	if len(room) < 2 {
+		return nil
+	}
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint32(b[0:], room[0])
+	binary.LittleEndian.PutUint32(b[4:], room[1])
+	return b
+}
+
+func unicodeString(s *dtyp.UnicodeString) string {
+	if s == nil {
+		return ""
+	}
+	return s.Buffer
+}
+
+func isZeroKey(b []byte) bool {
+	for i := range b {
+		if b[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -17,13 +17,18 @@ import (
 type LogonSecureChannelClient interface {
 	LogonClient
 	Encrypt(context.Context, []byte) ([]byte, error)
+	Decrypt(context.Context, []byte) ([]byte, error)
 	DomainControllerInfo() *DomainControllerInfoW
+	ComputerName() string
+	SecurityConfig() *netlogon.Config
 }
 
 type xxx_SecureChannelClient struct {
 	LogonClient
 	sCred                 *netlogon.SecureCredential
 	domainControllerInfoW *DomainControllerInfoW
+	computerName          string
+	cfg                   *netlogon.Config
 }
 
 var SecureChannel_T = &xxx_SecureChannelClient{}
@@ -118,6 +123,8 @@ func NewSecureChannelClient(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.
 		LogonClient:           cli,
 		sCred:                 sCred,
 		domainControllerInfoW: dc.DomainControllerInfo,
+		computerName:          creds.Workstation(),
+		cfg:                   cfg,
 	}, nil
 }
 
@@ -125,10 +132,27 @@ func (o *xxx_SecureChannelClient) Encrypt(ctx context.Context, b []byte) ([]byte
 	return o.sCred.Encrypt(ctx, b)
 }
 
+func (o *xxx_SecureChannelClient) Decrypt(ctx context.Context, b []byte) ([]byte, error) {
+	return o.sCred.Decrypt(ctx, b)
+}
+
 func (o *xxx_SecureChannelClient) DomainControllerInfo() *DomainControllerInfoW {
 	return o.domainControllerInfoW
 }
 
+func (o *xxx_SecureChannelClient) ComputerName() string {
+	return o.computerName
+}
+
+// SecurityConfig function returns the netlogon security configuration of the
+// established secure channel. The configuration can be used to secure the
+// other RPC connections (ie LSA lookups) with the secure channel:
+//
+//	lsaCli, err := lsarpc.NewLsarpcClient(ctx, cc, dcerpc.WithSecurityConfig(cli.SecurityConfig()), dcerpc.WithSeal())
+func (o *xxx_SecureChannelClient) SecurityConfig() *netlogon.Config {
+	return o.cfg
+}
+
 func (o *xxx_SecureChannelClient) VerifyAuthenticator(ctx context.Context, ra *Authenticator) error {
 	return o.sCred.Verify(ctx, 1, ra.Credential.Data)
 }
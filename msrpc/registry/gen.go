@@ -0,0 +1,435 @@
+//go:build exclude
+
+// gen.go generates the registry_gen.go files for the generated packages (the
+// interfaces and the union arms) and the registry/all package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	root   string
+	module string
+)
+
+func init() {
+	flag.StringVar(&root, "o", "../", "msrpc directory")
+	flag.StringVar(&module, "m", "github.com/oiweiwei/go-msrpc/msrpc", "msrpc import path")
+	flag.Parse()
+}
+
+const (
+	midlHeader      = "Code generated by github.com/oiweiwei/midl-gen-go. DO NOT EDIT."
+	generatedHeader = "// Code generated by msrpc/registry/gen.go. DO NOT EDIT."
+	outputFile      = "registry_gen.go"
+)
+
+var (
+	reSyntax     = regexp.MustCompile(`^(\w+)SyntaxV(\d+)_(\d+)$`)
+	reOperation  = regexp.MustCompile(`^xxx_(\w+)Operation$`)
+	reOpName     = regexp.MustCompile(`^/([^/]+)/v[^/]+/(.+)$`)
+	reBaseHandle = regexp.MustCompile(`return (\w+)\.(\w+)ServerHandle\(ctx, o, opNum, r\)`)
+)
+
+type Operation struct {
+	Type   string
+	Name   string
+	OpNum  int
+	IfName string
+}
+
+type Package struct {
+	Dir        string
+	ImportPath string
+	Name       string
+	// the interface go name to the syntax variable.
+	Syntax map[string]string
+	// the base interface server handle (import alias, go name).
+	Base [2]string
+	// the import alias to the import path.
+	Imports    map[string]string
+	Funcs      map[string]bool
+	Types      map[string]bool
+	Operations []*Operation
+	// the interface go name to the idl name.
+	IfNames map[string]string
+	// the union value interface to the arm types.
+	Unions map[string][]string
+}
+
+type Interface struct {
+	GoName     string
+	Name       string
+	Syntax     string
+	BaseSyntax string
+	BaseImport string
+	BaseAlias  string
+}
+
+func parsePackage(dir string) (*Package, error) {
+
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != outputFile
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, _ := filepath.Rel(root, dir)
+
+	p := &Package{
+		Dir:        dir,
+		ImportPath: module + "/" + filepath.ToSlash(rel),
+		Syntax:     map[string]string{},
+		Imports:    map[string]string{},
+		Funcs:      map[string]bool{},
+		Types:      map[string]bool{},
+		IfNames:    map[string]string{},
+		Unions:     map[string][]string{},
+	}
+
+	opNums, opNames := map[string]int{}, map[string]string{}
+
+	// the union arm positions (for the stable order).
+	armPos := map[string]token.Pos{}
+
+	for name, pkg := range pkgs {
+
+		if name == "main" {
+			continue
+		}
+
+		p.Name = name
+
+		for fn, f := range pkg.Files {
+
+			generated := len(f.Comments) > 0 && strings.HasPrefix(f.Comments[0].Text(), midlHeader)
+
+			for _, imp := range f.Imports {
+				path, _ := strconv.Unquote(imp.Path.Value)
+				alias := filepath.Base(path)
+				if imp.Name != nil {
+					alias = imp.Name.Name
+				}
+				if generated {
+					p.Imports[alias] = path
+				}
+			}
+
+			if filepath.Base(fn) == "server.go" && generated {
+				src, err := os.ReadFile(fn)
+				if err != nil {
+					return nil, err
+				}
+				if m := reBaseHandle.FindStringSubmatch(string(src)); m != nil {
+					p.Base = [2]string{m[1], m[2]}
+				}
+			}
+
+			for _, decl := range f.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Recv == nil {
+						p.Funcs[decl.Name.Name] = true
+						continue
+					}
+					recv := recvType(decl.Recv)
+					if strings.HasPrefix(decl.Name.Name, "is_") && generated && recv != "" {
+						p.Unions[decl.Name.Name] = append(p.Unions[decl.Name.Name], recv)
+						armPos[recv] = decl.Pos()
+						continue
+					}
+					m := reOperation.FindStringSubmatch(recv)
+					if m == nil {
+						continue
+					}
+					switch decl.Name.Name {
+					case "OpNum":
+						if n, ok := returnLit(decl); ok {
+							opNums[recv], _ = strconv.Atoi(n)
+						}
+					case "OpName":
+						if n, ok := returnLit(decl); ok {
+							opNames[recv], _ = strconv.Unquote(n)
+						}
+					}
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						switch spec := spec.(type) {
+						case *ast.TypeSpec:
+							p.Types[spec.Name.Name] = true
+							if decl.Doc != nil && strings.HasSuffix(spec.Name.Name, "Client") {
+								if idl, ok := strings.CutSuffix(strings.TrimSpace(decl.Doc.Text()), " interface."); ok {
+									p.IfNames[strings.TrimSuffix(spec.Name.Name, "Client")] = idl
+								}
+							}
+						case *ast.ValueSpec:
+							for _, n := range spec.Names {
+								if m := reSyntax.FindStringSubmatch(n.Name); m != nil && generated {
+									p.Syntax[m[1]] = n.Name
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for typ, opNum := range opNums {
+		opName, ok := opNames[typ]
+		if !ok {
+			continue
+		}
+		m := reOpName.FindStringSubmatch(opName)
+		if m == nil {
+			continue
+		}
+		name := reOperation.FindStringSubmatch(typ)[1]
+		if !p.Types[name+"Request"] || !p.Types[name+"Response"] {
+			continue
+		}
+		p.Operations = append(p.Operations, &Operation{Type: name, Name: m[2], OpNum: opNum, IfName: m[1]})
+	}
+
+	sort.Slice(p.Operations, func(i, j int) bool { return p.Operations[i].OpNum < p.Operations[j].OpNum })
+
+	for _, arms := range p.Unions {
+		sort.Slice(arms, func(i, j int) bool { return armPos[arms[i]] < armPos[arms[j]] })
+	}
+
+	return p, nil
+}
+
+func recvType(fl *ast.FieldList) string {
+	if len(fl.List) == 0 {
+		return ""
+	}
+	t := fl.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func returnLit(decl *ast.FuncDecl) (string, bool) {
+	if decl.Body == nil || len(decl.Body.List) != 1 {
+		return "", false
+	}
+	ret, ok := decl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok {
+		return "", false
+	}
+	return lit.Value, true
+}
+
+func (p *Package) interfaces(all map[string]*Package) []*Interface {
+
+	var ret []*Interface
+
+	for goName, syntax := range p.Syntax {
+		if !p.Funcs["New"+goName+"Client"] || !p.Funcs["New"+goName+"ServerHandle"] {
+			continue
+		}
+		iface := &Interface{GoName: goName, Syntax: syntax, Name: p.IfNames[goName]}
+		for _, op := range p.Operations {
+			if iface.Name == "" {
+				iface.Name = op.IfName
+			}
+		}
+		if iface.Name == "" {
+			iface.Name = goName
+		}
+		if base := p.Base; base[0] != "" {
+			if path, ok := p.Imports[base[0]]; ok {
+				if bp, ok := all[path]; ok {
+					if s, ok := bp.Syntax[base[1]]; ok {
+						iface.BaseAlias, iface.BaseImport, iface.BaseSyntax = base[0], path, s
+					}
+				}
+			}
+		}
+		ret = append(ret, iface)
+	}
+
+	sort.Slice(ret, func(i, j int) bool { return ret[i].GoName < ret[j].GoName })
+
+	return ret
+}
+
+func (p *Package) generate(all map[string]*Package) ([]byte, error) {
+
+	ifaces := p.interfaces(all)
+	if len(ifaces) == 0 && len(p.Unions) == 0 {
+		return nil, nil
+	}
+
+	unions := make([]string, 0, len(p.Unions))
+	for union := range p.Unions {
+		unions = append(unions, union)
+	}
+
+	sort.Strings(unions)
+
+	var buf bytes.Buffer
+
+	fmt.Fprintln(&buf, generatedHeader)
+	fmt.Fprintln(&buf)
+	fmt.Fprintf(&buf, "package %s\n\n", p.Name)
+	fmt.Fprintln(&buf, "import (")
+	fmt.Fprintln(&buf, "\tregistry \"github.com/oiweiwei/go-msrpc/msrpc/registry\"")
+	for _, iface := range ifaces {
+		if iface.BaseImport != "" {
+			fmt.Fprintf(&buf, "\t%s %q\n", iface.BaseAlias, iface.BaseImport)
+			break
+		}
+	}
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "func init() {")
+
+	for _, iface := range ifaces {
+		fmt.Fprintln(&buf, "\tregistry.Register(&registry.Interface{")
+		fmt.Fprintf(&buf, "\t\tName: %q,\n", iface.Name)
+		fmt.Fprintf(&buf, "\t\tPackage: %q,\n", p.ImportPath)
+		fmt.Fprintf(&buf, "\t\tSyntaxID: %s,\n", iface.Syntax)
+		if iface.BaseSyntax != "" {
+			fmt.Fprintf(&buf, "\t\tBase: %s.%s,\n", iface.BaseAlias, iface.BaseSyntax)
+		}
+		fmt.Fprintf(&buf, "\t\tNewClient: registry.Client(New%sClient),\n", iface.GoName)
+		fmt.Fprintf(&buf, "\t\tNewServerHandle: registry.ServerHandle(New%sServerHandle),\n", iface.GoName)
+		fmt.Fprintln(&buf, "\t\tOperations: []*registry.Operation{")
+		for _, op := range p.Operations {
+			if op.IfName != iface.Name {
+				continue
+			}
+			fmt.Fprintf(&buf, "\t\t\tregistry.Op[xxx_%[1]sOperation, %[1]sRequest, %[1]sResponse](%[2]d, %[3]q),\n", op.Type, op.OpNum, op.Name)
+		}
+		fmt.Fprintln(&buf, "\t\t},")
+		fmt.Fprintln(&buf, "\t})")
+	}
+
+	for _, union := range unions {
+		fmt.Fprintf(&buf, "\tregistry.Union[%s](\n", union)
+		for _, arm := range p.Unions[union] {
+			fmt.Fprintf(&buf, "\t\t(*%s)(nil),\n", arm)
+		}
+		fmt.Fprintln(&buf, "\t)")
+	}
+
+	fmt.Fprintln(&buf, "}")
+
+	return format.Source(buf.Bytes())
+}
+
+func hasGenerated(dir string) bool {
+	files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, fn := range files {
+		if strings.HasSuffix(fn, "_test.go") {
+			continue
+		}
+		if b, err := os.ReadFile(fn); err == nil && bytes.Contains(b, []byte(midlHeader)) {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+
+	all := map[string]*Package{}
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+		if path == filepath.Join(root, "registry") {
+			return filepath.SkipDir
+		}
+		if !hasGenerated(path) {
+			return nil
+		}
+		p, err := parsePackage(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		all[p.ImportPath] = p
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var imports []string
+
+	for path, p := range all {
+		b, err := p.generate(all)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, p.Dir, err)
+			os.Exit(1)
+		}
+		if b == nil {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(p.Dir, outputFile), b, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		imports = append(imports, path)
+	}
+
+	sort.Strings(imports)
+
+	var buf bytes.Buffer
+
+	fmt.Fprintln(&buf, generatedHeader)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Package all registers all generated interfaces.")
+	fmt.Fprintln(&buf, "package all")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import (")
+	for _, path := range imports {
+		fmt.Fprintf(&buf, "\t_ %q\n", path)
+	}
+	fmt.Fprintln(&buf, ")")
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, "registry", "all"), 0755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "registry", "all", "all.go"), b, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,212 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var (
+	unionMu sync.RWMutex
+	unions  = map[reflect.Type][]reflect.Type{}
+)
+
+// Union function registers the union arm types for the union value interface
+// T. The function is used by the generated code.
+func Union[T any](arms ...T) {
+	unionMu.Lock()
+	defer unionMu.Unlock()
+	typ := reflect.TypeFor[T]()
+	for _, arm := range arms {
+		unions[typ] = append(unions[typ], reflect.TypeOf(arm))
+	}
+}
+
+// UnionArms function returns the registered union arm types for the union
+// value interface type.
+func UnionArms(typ reflect.Type) []reflect.Type {
+	unionMu.RLock()
+	defer unionMu.RUnlock()
+	return unions[typ]
+}
+
+var unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// UnmarshalJSON function decodes the JSON document into the generated
+// structure. Unlike json.Unmarshal, the union values are decoded: the union
+// value must be the object with the arm field name ({"level1": {...}}), as
+// produced by json.Marshal.
+func UnmarshalJSON(b []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("registry: unmarshal json: non-pointer %T", v)
+	}
+	if err := decodeJSON(b, rv.Elem(), ""); err != nil {
+		return fmt.Errorf("registry: unmarshal json: %w", err)
+	}
+	return nil
+}
+
+func decodeJSON(b []byte, v reflect.Value, path string) error {
+
+	null := bytes.Equal(bytes.TrimSpace(b), []byte("null"))
+
+	if v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface && v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		if null {
+			return nil
+		}
+		if err := v.Addr().Interface().(json.Unmarshaler).UnmarshalJSON(b); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if null {
+			v.SetZero()
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeJSON(b, v.Elem(), path)
+	case reflect.Interface:
+		if null {
+			v.SetZero()
+			return nil
+		}
+		arms := UnionArms(v.Type())
+		if len(arms) == 0 {
+			if v.NumMethod() == 0 {
+				return json.Unmarshal(b, v.Addr().Interface())
+			}
+			return fmt.Errorf("%s: unknown union %s", path, v.Type())
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		arm := unionArm(arms, obj)
+		if arm == nil {
+			return fmt.Errorf("%s: no %s union arm matches the value", path, v.Type())
+		}
+		val := reflect.New(arm.Elem())
+		if err := decodeStruct(obj, val.Elem(), path); err != nil {
+			return err
+		}
+		v.Set(val)
+		return nil
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return decodeStruct(obj, v, path)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			// base64-encoded byte slice.
+			break
+		}
+		if null {
+			v.SetZero()
+			return nil
+		}
+		var arr []json.RawMessage
+		if err := json.Unmarshal(b, &arr); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(arr), len(arr)))
+		for i := range arr {
+			if err := decodeJSON(arr[i], v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Array:
+		var arr []json.RawMessage
+		if err := json.Unmarshal(b, &arr); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for i := 0; i < len(arr) && i < v.Len(); i++ {
+			if err := decodeJSON(arr[i], v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := json.Unmarshal(b, v.Addr().Interface()); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+func decodeStruct(obj map[string]json.RawMessage, v reflect.Value, path string) error {
+
+	typ := v.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, ok := jsonName(f)
+		if !ok {
+			continue
+		}
+		for key, b := range obj {
+			if !strings.EqualFold(key, name) {
+				continue
+			}
+			if err := decodeJSON(b, v.Field(i), path+"."+name); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+// unionArm function returns the first arm which fields include all object
+// keys.
+func unionArm(arms []reflect.Type, obj map[string]json.RawMessage) reflect.Type {
+	for _, arm := range arms {
+		typ, match := arm.Elem(), true
+		for key := range obj {
+			if !hasJSONField(typ, key) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return arm
+		}
+	}
+	return nil
+}
+
+func hasJSONField(typ reflect.Type, key string) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if name, ok := jsonName(typ.Field(i)); ok && strings.EqualFold(name, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return f.Name, true
+}
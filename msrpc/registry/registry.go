@@ -0,0 +1,357 @@
+//go:generate go run gen.go -o ../
+
+// Package registry implements the runtime registry of the generated RPC
+// interfaces. Every generated package registers its interfaces on import
+// (see registry_gen.go files), import the "all" package to register all
+// interfaces.
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+var (
+	// The interface is not registered.
+	ErrUnknownInterface = errors.New("registry: unknown interface")
+	// The operation is not registered.
+	ErrUnknownOperation = errors.New("registry: unknown operation")
+)
+
+// Message is the operation request or response structure.
+type Message interface {
+	ndr.Marshaler
+	ndr.Unmarshaler
+}
+
+// Operation is the registered interface operation.
+type Operation struct {
+	// The operation number.
+	OpNum int `json:"opnum"`
+	// The operation name.
+	Name string `json:"name"`
+	// The function returns the new operation.
+	NewOperation func() dcerpc.Operation `json:"-"`
+	// The function returns the new operation request.
+	NewRequest func() Message `json:"-"`
+	// The function returns the new operation response.
+	NewResponse func() Message `json:"-"`
+	// The request type.
+	RequestType reflect.Type `json:"-"`
+	// The response type.
+	ResponseType reflect.Type `json:"-"`
+}
+
+// Interface is the registered interface.
+type Interface struct {
+	// The interface name (for example "ICertAdminD2").
+	Name string `json:"name"`
+	// The Go package import path.
+	Package string `json:"package"`
+	// The abstract syntax identifier.
+	SyntaxID *dcerpc.SyntaxID `json:"-"`
+	// The base interface syntax identifier (DCOM interfaces).
+	Base *dcerpc.SyntaxID `json:"-"`
+	// The interface operations (without the base interface operations).
+	Operations []*Operation `json:"operations"`
+	// The function returns the new client.
+	NewClient func(context.Context, dcerpc.Conn, ...dcerpc.Option) (any, error) `json:"-"`
+	// The function returns the server handle for the server implementation.
+	NewServerHandle func(any) (dcerpc.ServerHandle, error) `json:"-"`
+}
+
+// String function returns the interface name and version.
+func (i *Interface) String() string {
+	return fmt.Sprintf("%s v%d.%d", i.Name, i.SyntaxID.IfVersionMajor, i.SyntaxID.IfVersionMinor)
+}
+
+// UUID function returns the interface UUID.
+func (i *Interface) UUID() *uuid.UUID {
+	return i.SyntaxID.IfUUID
+}
+
+// Operation function returns the operation by the operation number, the base
+// interface operations are included.
+func (i *Interface) Operation(opNum int) (*Operation, bool) {
+	for cur := i; cur != nil; cur = cur.base() {
+		for _, op := range cur.Operations {
+			if op.OpNum == opNum {
+				return op, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// OperationByName function returns the operation by the case-insensitive
+// IDL name or Go name, the base interface operations are included.
+func (i *Interface) OperationByName(name string) (*Operation, bool) {
+	for cur := i; cur != nil; cur = cur.base() {
+		for _, op := range cur.Operations {
+			if strings.EqualFold(op.Name, name) || strings.EqualFold(op.GoName(), name) {
+				return op, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// AllOperations function returns the operations including the base interface
+// operations, ordered by the operation number.
+func (i *Interface) AllOperations() []*Operation {
+	var ret []*Operation
+	for cur := i; cur != nil; cur = cur.base() {
+		ret = append(ret, cur.Operations...)
+	}
+	slices.SortFunc(ret, func(a, b *Operation) int { return a.OpNum - b.OpNum })
+	return ret
+}
+
+// GoName function returns the operation Go name (the request type name
+// without the "Request" suffix).
+func (o *Operation) GoName() string {
+	if o.RequestType == nil {
+		return o.Name
+	}
+	return strings.TrimSuffix(o.RequestType.Name(), "Request")
+}
+
+func (i *Interface) base() *Interface {
+	if i.Base == nil {
+		return nil
+	}
+	base, _ := Lookup(i.Base)
+	return base
+}
+
+type key struct {
+	uuid         string
+	major, minor uint16
+}
+
+func keyOf(syntax *dcerpc.SyntaxID) key {
+	return key{syntax.IfUUID.String(), syntax.IfVersionMajor, syntax.IfVersionMinor}
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[key]*Interface{}
+)
+
+// Register function registers the interfaces. The operations of the
+// interface with the same syntax identifier (the interface that is split
+// between the packages, like lsarpc) are merged into the interface that was
+// registered first.
+func Register(ifaces ...*Interface) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, iface := range ifaces {
+		prev, ok := registry[keyOf(iface.SyntaxID)]
+		if !ok {
+			registry[keyOf(iface.SyntaxID)] = iface
+			continue
+		}
+		for _, op := range iface.Operations {
+			if !slices.ContainsFunc(prev.Operations, func(o *Operation) bool { return o.OpNum == op.OpNum }) {
+				prev.Operations = append(prev.Operations, op)
+			}
+		}
+		slices.SortFunc(prev.Operations, func(a, b *Operation) int { return a.OpNum - b.OpNum })
+	}
+}
+
+// Lookup function returns the interface by the syntax identifier.
+func Lookup(syntax *dcerpc.SyntaxID) (*Interface, bool) {
+	if syntax == nil || syntax.IfUUID == nil {
+		return nil, false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	iface, ok := registry[keyOf(syntax)]
+	return iface, ok
+}
+
+// LookupUUID function returns the interface versions by the interface UUID,
+// the latest version first.
+func LookupUUID(u *uuid.UUID) []*Interface {
+	return filter(func(iface *Interface) bool {
+		return iface.SyntaxID.IfUUID.Equals(u)
+	})
+}
+
+// LookupName function returns the interfaces by the case-insensitive interface
+// name, the latest version first. The name can be qualified with the version
+// ("srvsvc/v3").
+func LookupName(name string) []*Interface {
+	name, version, _ := strings.Cut(name, "/")
+	return filter(func(iface *Interface) bool {
+		if !strings.EqualFold(iface.Name, name) {
+			return false
+		}
+		return version == "" || strings.EqualFold(version, fmt.Sprintf("v%d", iface.SyntaxID.IfVersionMajor)) ||
+			strings.EqualFold(version, fmt.Sprintf("v%d.%d", iface.SyntaxID.IfVersionMajor, iface.SyntaxID.IfVersionMinor))
+	})
+}
+
+// Interfaces function returns all registered interfaces ordered by name and
+// version.
+func Interfaces() []*Interface {
+	return filter(func(*Interface) bool { return true })
+}
+
+func filter(fn func(*Interface) bool) []*Interface {
+
+	mu.RLock()
+	var ret []*Interface
+	for _, iface := range registry {
+		if fn(iface) {
+			ret = append(ret, iface)
+		}
+	}
+	mu.RUnlock()
+
+	slices.SortFunc(ret, func(a, b *Interface) int {
+		if c := strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)); c != 0 {
+			return c
+		}
+		if a.SyntaxID.IfVersionMajor != b.SyntaxID.IfVersionMajor {
+			return int(b.SyntaxID.IfVersionMajor) - int(a.SyntaxID.IfVersionMajor)
+		}
+		return int(b.SyntaxID.IfVersionMinor) - int(a.SyntaxID.IfVersionMinor)
+	})
+
+	return ret
+}
+
+// LookupOperation function returns the interface and the operation by the
+// syntax identifier and the operation number.
+func LookupOperation(syntax *dcerpc.SyntaxID, opNum int) (*Interface, *Operation, error) {
+	iface, ok := Lookup(syntax)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s v%d.%d", ErrUnknownInterface, syntax.IfUUID, syntax.IfVersionMajor, syntax.IfVersionMinor)
+	}
+	op, ok := iface.Operation(opNum)
+	if !ok {
+		return iface, nil, fmt.Errorf("%w: %s opnum %d", ErrUnknownOperation, iface, opNum)
+	}
+	return iface, op, nil
+}
+
+// DecodeRequest function decodes the request stub data. The options are
+// passed to the NDR decoder (for example the data representation).
+func DecodeRequest(syntax *dcerpc.SyntaxID, opNum int, b []byte, opts ...any) (Message, error) {
+	_, op, err := LookupOperation(syntax, opNum)
+	if err != nil {
+		return nil, err
+	}
+	req := op.NewRequest()
+	if err := ndr.Unmarshal(b, req, opts...); err != nil {
+		return nil, fmt.Errorf("registry: decode %s request: %w", op.Name, err)
+	}
+	return req, nil
+}
+
+// DecodeResponse function decodes the response stub data.
+func DecodeResponse(syntax *dcerpc.SyntaxID, opNum int, b []byte, opts ...any) (Message, error) {
+	_, op, err := LookupOperation(syntax, opNum)
+	if err != nil {
+		return nil, err
+	}
+	resp := op.NewResponse()
+	if err := ndr.Unmarshal(b, resp, opts...); err != nil {
+		return nil, fmt.Errorf("registry: decode %s response: %w", op.Name, err)
+	}
+	return resp, nil
+}
+
+// NewClient function returns the new client for the interface name (see
+// LookupName), the latest version is used if the version is not specified.
+func NewClient(ctx context.Context, name string, cc dcerpc.Conn, opts ...dcerpc.Option) (any, error) {
+	ifaces := LookupName(name)
+	if len(ifaces) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownInterface, name)
+	}
+	return ifaces[0].NewClient(ctx, cc, opts...)
+}
+
+// Invoke function invokes the operation on the client returned by the
+// interface NewClient function. The response can be returned along with the
+// error (for example, for the non-zero return code).
+func Invoke(ctx context.Context, cli any, op *Operation, req Message, opts ...dcerpc.CallOption) (Message, error) {
+
+	m := reflect.ValueOf(cli).MethodByName(op.GoName())
+	if !m.IsValid() {
+		return nil, fmt.Errorf("%w: %T does not implement %s", ErrUnknownOperation, cli, op.GoName())
+	}
+
+	if m.Type().NumIn() != 3 || m.Type().In(1) != reflect.TypeOf(req) || m.Type().NumOut() != 2 {
+		return nil, fmt.Errorf("registry: %T.%s: unexpected method signature %s", cli, op.GoName(), m.Type())
+	}
+
+	args := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)}
+	for _, opt := range opts {
+		args = append(args, reflect.ValueOf(opt))
+	}
+
+	out := m.Call(args)
+
+	resp, _ := out[0].Interface().(Message)
+	err, _ := out[1].Interface().(error)
+	if out[0].IsNil() {
+		resp = nil
+	}
+
+	return resp, err
+}
+
+// Client function returns the generic client constructor. The function is
+// used by the generated code.
+func Client[T any](fn func(context.Context, dcerpc.Conn, ...dcerpc.Option) (T, error)) func(context.Context, dcerpc.Conn, ...dcerpc.Option) (any, error) {
+	return func(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.Option) (any, error) {
+		return fn(ctx, cc, opts...)
+	}
+}
+
+// ServerHandle function returns the generic server handle constructor. The
+// function is used by the generated code.
+func ServerHandle[T any](fn func(T) dcerpc.ServerHandle) func(any) (dcerpc.ServerHandle, error) {
+	return func(o any) (dcerpc.ServerHandle, error) {
+		srv, ok := o.(T)
+		if !ok {
+			return nil, fmt.Errorf("registry: %T does not implement %s", o, reflect.TypeFor[T]())
+		}
+		return fn(srv), nil
+	}
+}
+
+// Op function returns the operation for the operation, request and response
+// types. The function is used by the generated code.
+func Op[O, Req, Resp any, PO interface {
+	*O
+	dcerpc.Operation
+}, PReq interface {
+	*Req
+	Message
+}, PResp interface {
+	*Resp
+	Message
+}](opNum int, name string) *Operation {
+	return &Operation{
+		OpNum:        opNum,
+		Name:         name,
+		NewOperation: func() dcerpc.Operation { return PO(new(O)) },
+		NewRequest:   func() Message { return PReq(new(Req)) },
+		NewResponse:  func() Message { return PResp(new(Resp)) },
+		RequestType:  reflect.TypeFor[Req](),
+		ResponseType: reflect.TypeFor[Resp](),
+	}
+}
@@ -0,0 +1,129 @@
+package registry_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/midl/uuid"
+	"github.com/oiweiwei/go-msrpc/msrpc/registry"
+	"github.com/oiweiwei/go-msrpc/ndr"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dcom/csra/icertadmind2/v0"
+	"github.com/oiweiwei/go-msrpc/msrpc/samr/samr/v1"
+	"github.com/oiweiwei/go-msrpc/msrpc/srvs/srvsvc/v3"
+)
+
+func TestLookup(t *testing.T) {
+
+	u := uuid.MustParse("12345778-1234-abcd-ef00-0123456789ac")
+
+	ifaces := registry.LookupUUID(u)
+	if len(ifaces) != 1 || ifaces[0].Name != "samr" {
+		t.Fatalf("lookup uuid: unexpected interfaces %v", ifaces)
+	}
+
+	iface, op, err := registry.LookupOperation(samr.SamrSyntaxV1_0, 22)
+	if err != nil {
+		t.Fatalf("lookup operation: %v", err)
+	}
+
+	if iface != ifaces[0] || op.Name != "SamrAddMemberToGroup" || op.GoName() != "AddMemberToGroup" {
+		t.Errorf("lookup operation: unexpected operation %s %s", iface, op.Name)
+	}
+
+	if _, _, err := registry.LookupOperation(samr.SamrSyntaxV1_0, 1000); !errors.Is(err, registry.ErrUnknownOperation) {
+		t.Errorf("lookup operation: expected unknown operation error, got %v", err)
+	}
+
+	if ifaces := registry.LookupName("SRVSVC/v3"); len(ifaces) != 1 || ifaces[0].SyntaxID != srvsvc.SrvsvcSyntaxV3_0 {
+		t.Errorf("lookup name: unexpected interfaces %v", ifaces)
+	}
+
+	if ifaces := registry.LookupName("srvsvc/v2"); len(ifaces) != 0 {
+		t.Errorf("lookup name: unexpected interfaces %v", ifaces)
+	}
+
+	// the base interface operation.
+	iface, _ = registry.Lookup(icertadmind2.CertAdminD2SyntaxV0_0)
+	if op, ok := iface.Operation(3); !ok || op.Name != "SetExtension" {
+		t.Errorf("lookup base operation: unexpected operation %v", op)
+	}
+
+	if op, ok := iface.OperationByName("publishcrls"); !ok || op.OpNum != 31 {
+		t.Errorf("lookup operation by name: unexpected operation %v", op)
+	}
+}
+
+func TestDecode(t *testing.T) {
+
+	b, err := ndr.Marshal(&srvsvc.ShareGetInfoRequest{ServerName: "\\\\server", NetName: "share", Level: 2})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	msg, err := registry.DecodeRequest(srvsvc.SrvsvcSyntaxV3_0, 16, b)
+	if err != nil {
+		t.Fatalf("decode request: %v", err)
+	}
+
+	req, ok := msg.(*srvsvc.ShareGetInfoRequest)
+	if !ok || req.ServerName != "\\\\server" || req.NetName != "share" || req.Level != 2 {
+		t.Errorf("decode request: unexpected request %#v", msg)
+	}
+
+	if _, err := registry.DecodeResponse(srvsvc.SrvsvcSyntaxV3_0, 16, b[:3]); err == nil {
+		t.Errorf("decode response: expected error")
+	}
+}
+
+func TestServerHandle(t *testing.T) {
+
+	iface, _ := registry.Lookup(srvsvc.SrvsvcSyntaxV3_0)
+
+	if _, err := iface.NewServerHandle(struct{}{}); err == nil {
+		t.Errorf("server handle: expected type mismatch error")
+	}
+
+	if _, err := iface.NewServerHandle(srvsvc.UnimplementedSrvsvcServer{}); err != nil {
+		t.Errorf("server handle: %v", err)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+
+	in := &srvsvc.ShareEnumRequest{
+		Info: &srvsvc.ShareEnum{
+			Level: 2,
+			ShareInfo: &srvsvc.ShareEnumUnion{
+				Value: &srvsvc.ShareEnumUnion_Level2{Level2: &srvsvc.ShareInfo2Container{
+					EntriesRead: 1,
+					Buffer:      []*srvsvc.ShareInfo2{{NetworkName: "C$", Path: "C:\\"}},
+				}},
+			},
+		},
+		PreferredMaximumLength: 0xffffffff,
+	}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if err := json.Unmarshal(b, &srvsvc.ShareEnumRequest{}); err == nil {
+		t.Fatalf("json: expected union unmarshal error")
+	}
+
+	out := &srvsvc.ShareEnumRequest{}
+	if err := registry.UnmarshalJSON(b, out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if b2, _ := json.Marshal(out); string(b2) != string(b) {
+		t.Errorf("unmarshal: expected %s, got %s", b, b2)
+	}
+
+	if err := registry.UnmarshalJSON([]byte(`{"info": {"share_info": {"value": {"unknown": {}}}}}`), out); err == nil {
+		t.Errorf("unmarshal: expected no union arm error")
+	}
+}
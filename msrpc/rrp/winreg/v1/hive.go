@@ -0,0 +1,712 @@
+package winreg
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+// The offline registry hive file (regf) format.
+//
+// The hive file is the base block (4096 bytes) followed by the hive bins. Each
+// hive bin is the sequence of the cells, the cell offsets are relative to the
+// start of the first hive bin.
+const (
+	hiveBaseBlockSize = 4096
+	// the maximum size of the data stored in the single cell (version 1.4+).
+	hiveBigDataSegmentSize = 16344
+	// the offset value for the empty cell reference.
+	hiveNilOffset = 0xFFFFFFFF
+)
+
+const (
+	// the key name is stored as ASCII string.
+	hiveKeyCompressedName = 0x0020
+	// the value name is stored as ASCII string.
+	hiveValueCompressedName = 0x0001
+)
+
+var (
+	_ KeyReader         = (*Hive)(nil)
+	_ KeySecurityReader = (*Hive)(nil)
+)
+
+// Hive is the offline registry hive file (regf) reader, for example the hive
+// saved with BaseRegSaveKey. The hive implements the KeyReader and the
+// KeySecurityReader, the key paths are relative to the Root.
+type Hive struct {
+	// The path of the hive root key, for example "HKEY_LOCAL_MACHINE\SYSTEM".
+	// If empty, the hive root key path is empty string.
+	Root string
+	// The hive file name stored in the base block.
+	FileName string
+	// The last write time of the hive.
+	LastWriteTime time.Time
+	// The hive format version.
+	MajorVersion, MinorVersion uint32
+
+	// the base block sequence numbers.
+	primarySeq, secondarySeq uint32
+	// the root key cell offset.
+	rootCell uint32
+	// the hive bins data size.
+	dataSize uint32
+	// the hive bins data.
+	data []byte
+}
+
+// OpenHive function parses the hive file. The transaction logs (.LOG1, .LOG2)
+// are replayed if the hive is dirty (the base block sequence numbers do not match).
+func OpenHive(b []byte, logs ...[]byte) (*Hive, error) {
+
+	o := &Hive{}
+
+	// the logs the dirty data is recovered from.
+	var dirty [][]byte
+
+	if err := o.parseBaseBlock(b); err != nil {
+		// recover the base block from the transaction log, the hive bins
+		// data must be recovered from the same log, since the primary
+		// file state is unknown.
+		if len(logs) == 0 {
+			return nil, fmt.Errorf("winreg: hive: %w", err)
+		}
+		var lerr error
+		for _, log := range logs {
+			if lerr = o.parseBaseBlock(log); lerr == nil {
+				dirty = [][]byte{log}
+				break
+			}
+		}
+		if lerr != nil {
+			return nil, fmt.Errorf("winreg: hive: %w", err)
+		}
+	} else if o.primarySeq != o.secondarySeq {
+		dirty = logs
+	}
+
+	if len(b) > hiveBaseBlockSize {
+		o.data = append([]byte(nil), b[hiveBaseBlockSize:min(len(b), hiveBaseBlockSize+int(o.dataSize))]...)
+	}
+
+	if len(dirty) > 0 {
+		if err := o.replay(dirty); err != nil {
+			return nil, fmt.Errorf("winreg: hive: replay log: %w", err)
+		}
+	}
+
+	if !bytes.HasPrefix(o.data, []byte("hbin")) {
+		return nil, fmt.Errorf("winreg: hive: invalid hive bin signature")
+	}
+
+	if _, err := o.key(o.rootCell); err != nil {
+		return nil, fmt.Errorf("winreg: hive: root key: %w", err)
+	}
+
+	return o, nil
+}
+
+// parseBaseBlock function parses the base block.
+func (o *Hive) parseBaseBlock(b []byte) error {
+
+	if len(b) < 512 {
+		return fmt.Errorf("base block is truncated")
+	}
+
+	if !bytes.HasPrefix(b, []byte("regf")) {
+		return fmt.Errorf("invalid base block signature")
+	}
+
+	if sum := hiveChecksum(b); sum != binary.LittleEndian.Uint32(b[508:]) {
+		return fmt.Errorf("invalid base block checksum")
+	}
+
+	o.primarySeq = binary.LittleEndian.Uint32(b[4:])
+	o.secondarySeq = binary.LittleEndian.Uint32(b[8:])
+	o.LastWriteTime = hiveTime(b[12:])
+	o.MajorVersion = binary.LittleEndian.Uint32(b[20:])
+	o.MinorVersion = binary.LittleEndian.Uint32(b[24:])
+	o.rootCell = binary.LittleEndian.Uint32(b[36:])
+	o.dataSize = binary.LittleEndian.Uint32(b[40:])
+	o.FileName, _ = utf16le.Decode(b[48 : 48+64])
+	o.FileName = strings.TrimRight(o.FileName, "\x00")
+
+	if o.MajorVersion != 1 {
+		return fmt.Errorf("unsupported version %d.%d", o.MajorVersion, o.MinorVersion)
+	}
+
+	return nil
+}
+
+// hiveChecksum function returns the base block checksum.
+func hiveChecksum(b []byte) uint32 {
+	var sum uint32
+	for i := 0; i < 508; i += 4 {
+		sum ^= binary.LittleEndian.Uint32(b[i:])
+	}
+	switch sum {
+	case 0xFFFFFFFF:
+		return 0xFFFFFFFE
+	case 0:
+		return 1
+	}
+	return sum
+}
+
+func hiveTime(b []byte) time.Time {
+	ft := &dtyp.Filetime{
+		LowDateTime:  binary.LittleEndian.Uint32(b),
+		HighDateTime: binary.LittleEndian.Uint32(b[4:]),
+	}
+	return ft.AsTime()
+}
+
+// cell function returns the cell data.
+func (o *Hive) cell(off uint32) ([]byte, error) {
+
+	if off == hiveNilOffset || uint64(off)+4 > uint64(len(o.data)) {
+		return nil, fmt.Errorf("invalid cell offset 0x%x", off)
+	}
+
+	size := int32(binary.LittleEndian.Uint32(o.data[off:]))
+	if size < 0 {
+		// allocated cell.
+		size = -size
+	}
+
+	if size < 4 || uint64(off)+uint64(size) > uint64(len(o.data)) {
+		return nil, fmt.Errorf("invalid cell size 0x%x at 0x%x", size, off)
+	}
+
+	return o.data[off+4 : off+uint32(size)], nil
+}
+
+// hiveKey is the key node (nk) cell.
+type hiveKey struct {
+	offset        uint32
+	flags         uint16
+	lastWriteTime time.Time
+	parent        uint32
+	subKeysCount  uint32
+	subKeysList   uint32
+	valuesCount   uint32
+	valuesList    uint32
+	security      uint32
+	class         uint32
+	classLength   uint16
+	name          string
+}
+
+// key function returns the key node.
+func (o *Hive) key(off uint32) (*hiveKey, error) {
+
+	b, err := o.cell(off)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 76 || !bytes.HasPrefix(b, []byte("nk")) {
+		return nil, fmt.Errorf("invalid key node at 0x%x", off)
+	}
+
+	k := &hiveKey{
+		offset:        off,
+		flags:         binary.LittleEndian.Uint16(b[2:]),
+		lastWriteTime: hiveTime(b[4:]),
+		parent:        binary.LittleEndian.Uint32(b[16:]),
+		subKeysCount:  binary.LittleEndian.Uint32(b[20:]),
+		subKeysList:   binary.LittleEndian.Uint32(b[28:]),
+		valuesCount:   binary.LittleEndian.Uint32(b[36:]),
+		valuesList:    binary.LittleEndian.Uint32(b[40:]),
+		security:      binary.LittleEndian.Uint32(b[44:]),
+		class:         binary.LittleEndian.Uint32(b[48:]),
+		classLength:   binary.LittleEndian.Uint16(b[74:]),
+	}
+
+	n := int(binary.LittleEndian.Uint16(b[72:]))
+	if 76+n > len(b) {
+		return nil, fmt.Errorf("invalid key name length at 0x%x", off)
+	}
+
+	if k.name, err = hiveName(b[76:76+n], k.flags&hiveKeyCompressedName != 0); err != nil {
+		return nil, fmt.Errorf("key name at 0x%x: %w", off, err)
+	}
+
+	return k, nil
+}
+
+// hiveName function decodes the ASCII (compressed) or UTF-16LE name.
+func hiveName(b []byte, compressed bool) (string, error) {
+	if !compressed {
+		return utf16le.Decode(b)
+	}
+	r := make([]rune, len(b))
+	for i := range b {
+		r[i] = rune(b[i])
+	}
+	return string(r), nil
+}
+
+// subKeys function returns the subkey nodes. The subkey must refer to the
+// key as its parent and have the unique name, so that the corrupted hive
+// cannot make the subkey reachable on several paths (and the Walk visit it
+// exponentially many times).
+func (o *Hive) subKeys(k *hiveKey) ([]*hiveKey, error) {
+
+	if k.subKeysCount == 0 {
+		return nil, nil
+	}
+
+	offs, err := o.subKeysList(k.subKeysList, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, names := make([]*hiveKey, len(offs)), make(map[string]bool, len(offs))
+
+	for i, off := range offs {
+		if ret[i], err = o.key(off); err != nil {
+			return nil, err
+		}
+		if ret[i].parent != k.offset {
+			return nil, fmt.Errorf("subkey at 0x%x refers to the parent 0x%x instead of 0x%x", off, ret[i].parent, k.offset)
+		}
+		name := strings.ToUpper(ret[i].name)
+		if names[name] {
+			return nil, fmt.Errorf("duplicate subkey %s at 0x%x", ret[i].name, off)
+		}
+		names[name] = true
+	}
+
+	return ret, nil
+}
+
+// subKeysList function parses the index leaf (li), fast leaf (lf), hash leaf (lh)
+// and index root (ri) subkey lists.
+func (o *Hive) subKeysList(off uint32, depth int) ([]uint32, error) {
+
+	if depth > 1 {
+		return nil, fmt.Errorf("invalid subkeys list nesting at 0x%x", off)
+	}
+
+	b, err := o.cell(off)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 4 {
+		return nil, fmt.Errorf("invalid subkeys list at 0x%x", off)
+	}
+
+	sig, n := string(b[:2]), int(binary.LittleEndian.Uint16(b[2:]))
+
+	step := 4
+	if sig == "lf" || sig == "lh" {
+		step = 8 // offset and name hint or hash.
+	}
+
+	if 4+n*step > len(b) {
+		return nil, fmt.Errorf("subkeys list %s at 0x%x is truncated", sig, off)
+	}
+
+	var ret []uint32
+
+	for i := 0; i < n; i++ {
+		item := binary.LittleEndian.Uint32(b[4+i*step:])
+		switch sig {
+		case "li", "lf", "lh":
+			ret = append(ret, item)
+		case "ri":
+			items, err := o.subKeysList(item, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, items...)
+		default:
+			return nil, fmt.Errorf("invalid subkeys list signature %q at 0x%x", sig, off)
+		}
+	}
+
+	return ret, nil
+}
+
+// hiveValue is the key value (vk) cell.
+type hiveValue struct {
+	name     string
+	typ      uint32
+	size     uint32
+	dataCell uint32
+	resident bool
+}
+
+// values function returns the key values.
+func (o *Hive) values(k *hiveKey) ([]*hiveValue, error) {
+
+	if k.valuesCount == 0 {
+		return nil, nil
+	}
+
+	b, err := o.cell(k.valuesList)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(k.valuesCount)*4 > uint64(len(b)) {
+		return nil, fmt.Errorf("values list at 0x%x is truncated", k.valuesList)
+	}
+
+	ret := make([]*hiveValue, k.valuesCount)
+
+	for i := range ret {
+
+		off := binary.LittleEndian.Uint32(b[i*4:])
+
+		vb, err := o.cell(off)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(vb) < 20 || !bytes.HasPrefix(vb, []byte("vk")) {
+			return nil, fmt.Errorf("invalid value at 0x%x", off)
+		}
+
+		n := int(binary.LittleEndian.Uint16(vb[2:]))
+		if 20+n > len(vb) {
+			return nil, fmt.Errorf("invalid value name length at 0x%x", off)
+		}
+
+		v := &hiveValue{
+			size:     binary.LittleEndian.Uint32(vb[4:]),
+			dataCell: binary.LittleEndian.Uint32(vb[8:]),
+			typ:      binary.LittleEndian.Uint32(vb[12:]),
+		}
+
+		if v.resident = v.size&0x80000000 != 0; v.resident {
+			// the data is stored in the data offset field.
+			v.dataCell, v.size = off+4+8, min(v.size&0x7FFFFFFF, 4)
+		}
+
+		if v.name, err = hiveName(vb[20:20+n], binary.LittleEndian.Uint16(vb[16:])&hiveValueCompressedName != 0); err != nil {
+			return nil, fmt.Errorf("value name at 0x%x: %w", off, err)
+		}
+
+		ret[i] = v
+	}
+
+	return ret, nil
+}
+
+// valueData function returns the value data.
+func (o *Hive) valueData(v *hiveValue) ([]byte, error) {
+
+	if v.size == 0 {
+		return []byte{}, nil
+	}
+
+	if v.resident {
+		return append([]byte(nil), o.data[v.dataCell:v.dataCell+v.size]...), nil
+	}
+
+	b, err := o.cell(v.dataCell)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.size <= hiveBigDataSegmentSize || o.MinorVersion < 4 || !bytes.HasPrefix(b, []byte("db")) {
+		if uint64(v.size) > uint64(len(b)) {
+			return nil, fmt.Errorf("value data at 0x%x is truncated", v.dataCell)
+		}
+		return append([]byte(nil), b[:v.size]...), nil
+	}
+
+	// big data (db) record: the list of the data segments.
+	if len(b) < 8 {
+		return nil, fmt.Errorf("invalid big data at 0x%x", v.dataCell)
+	}
+
+	n, list := int(binary.LittleEndian.Uint16(b[2:])), binary.LittleEndian.Uint32(b[4:])
+
+	segs, err := o.cell(list)
+	if err != nil {
+		return nil, err
+	}
+
+	if n*4 > len(segs) {
+		return nil, fmt.Errorf("big data segments list at 0x%x is truncated", list)
+	}
+
+	ret := make([]byte, 0, v.size)
+
+	for i := 0; i < n && uint32(len(ret)) < v.size; i++ {
+		seg, err := o.cell(binary.LittleEndian.Uint32(segs[i*4:]))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, seg[:min(len(seg), hiveBigDataSegmentSize, int(v.size)-len(ret))]...)
+	}
+
+	if uint32(len(ret)) != v.size {
+		return nil, fmt.Errorf("big data at 0x%x is truncated", v.dataCell)
+	}
+
+	return ret, nil
+}
+
+// relPath function returns the path relative to the hive root.
+func (o *Hive) relPath(path string) (string, error) {
+
+	path, root := JoinPath(path), JoinPath(o.Root)
+	if root == "" {
+		return path, nil
+	}
+
+	if len(path) >= len(root) && strings.EqualFold(path[:len(root)], root) {
+		if rel := path[len(root):]; rel == "" || rel[0] == '\\' {
+			return JoinPath(rel), nil
+		}
+	}
+
+	return "", fmt.Errorf("winreg: hive: path %s is outside of the hive root %s: %w", path, root, win32.ErrorFileNotFound)
+}
+
+// lookup function returns the key node for the path.
+func (o *Hive) lookup(path string) (*hiveKey, error) {
+
+	rel, err := o.relPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := o.key(o.rootCell)
+	if err != nil {
+		return nil, fmt.Errorf("winreg: hive: %w", err)
+	}
+
+	// the key cells on the path, the corrupted hive can contain the subkey
+	// that refers to its parent key, so the Walk never ends.
+	visited := map[uint32]bool{o.rootCell: true}
+
+	for _, name := range strings.Split(rel, `\`) {
+
+		if name == "" {
+			continue
+		}
+
+		sks, err := o.subKeys(k)
+		if err != nil {
+			return nil, fmt.Errorf("winreg: hive: %s: %w", path, err)
+		}
+
+		var found *hiveKey
+		for _, sk := range sks {
+			if strings.EqualFold(sk.name, name) {
+				if visited[sk.offset] {
+					return nil, fmt.Errorf("winreg: hive: %s: key cycle at 0x%x", path, sk.offset)
+				}
+				found, visited[sk.offset] = sk, true
+				break
+			}
+		}
+
+		if found == nil {
+			return nil, fmt.Errorf("winreg: hive: key %s: %w", path, win32.ErrorFileNotFound)
+		}
+
+		k = found
+	}
+
+	return k, nil
+}
+
+// KeyInfo function returns the key information.
+func (o *Hive) KeyInfo(ctx context.Context, path string) (*KeyInfo, error) {
+
+	k, err := o.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &KeyInfo{
+		SubKeysCount:  int(k.subKeysCount),
+		ValuesCount:   int(k.valuesCount),
+		LastWriteTime: k.lastWriteTime,
+	}
+
+	if k.class != hiveNilOffset && k.classLength > 0 {
+		b, err := o.cell(k.class)
+		if err != nil {
+			return nil, fmt.Errorf("winreg: hive: %s: class: %w", path, err)
+		}
+		if info.Class, err = utf16le.Decode(b[:min(len(b), int(k.classLength))]); err != nil {
+			return nil, fmt.Errorf("winreg: hive: %s: class: %w", path, err)
+		}
+	}
+
+	return info, nil
+}
+
+// SubKeys function returns the names of the subkeys.
+func (o *Hive) SubKeys(ctx context.Context, path string) ([]string, error) {
+
+	k, err := o.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sks, err := o.subKeys(k)
+	if err != nil {
+		return nil, fmt.Errorf("winreg: hive: %s: %w", path, err)
+	}
+
+	ret := make([]string, 0, len(sks))
+	for _, sk := range sks {
+		ret = append(ret, sk.name)
+	}
+
+	return ret, nil
+}
+
+// Values function returns the key values.
+func (o *Hive) Values(ctx context.Context, path string) ([]*Value, error) {
+
+	k, err := o.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := o.values(k)
+	if err != nil {
+		return nil, fmt.Errorf("winreg: hive: %s: %w", path, err)
+	}
+
+	ret := make([]*Value, 0, len(values))
+	for _, v := range values {
+		b, err := o.valueData(v)
+		if err != nil {
+			return nil, fmt.Errorf("winreg: hive: %s\\%s: %w", path, v.name, err)
+		}
+		ret = append(ret, &Value{Name: v.name, Type: v.typ, Data: b})
+	}
+
+	return ret, nil
+}
+
+// Value function returns the key value.
+func (o *Hive) Value(ctx context.Context, path string, name string) (*Value, error) {
+
+	values, err := o.Values(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range values {
+		if strings.EqualFold(v.Name, name) {
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("winreg: hive: value %s\\%s: %w", path, name, win32.ErrorFileNotFound)
+}
+
+// KeySecurity function returns the self-relative security descriptor of the
+// key (sk cell). The info is the combination of the OwnerSecurityInformation,
+// GroupSecurityInformation, DACLSecurityInformation and SACLSecurityInformation,
+// the parts that are not requested are removed from the descriptor.
+func (o *Hive) KeySecurity(ctx context.Context, path string, info uint32) ([]byte, error) {
+
+	k, err := o.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := o.cell(k.security)
+	if err != nil {
+		return nil, fmt.Errorf("winreg: hive: %s: security: %w", path, err)
+	}
+
+	if len(b) < 20 || !bytes.HasPrefix(b, []byte("sk")) {
+		return nil, fmt.Errorf("winreg: hive: %s: invalid security cell", path)
+	}
+
+	n := binary.LittleEndian.Uint32(b[16:])
+	if uint64(n)+20 > uint64(len(b)) {
+		return nil, fmt.Errorf("winreg: hive: %s: security descriptor is truncated", path)
+	}
+
+	sd, err := hiveSecurity(b[20:20+n], info)
+	if err != nil {
+		return nil, fmt.Errorf("winreg: hive: %s: security: %w", path, err)
+	}
+
+	return sd, nil
+}
+
+// hiveSecurity function returns the self-relative security descriptor that
+// contains only the parts requested by the security information.
+func hiveSecurity(b []byte, info uint32) ([]byte, error) {
+
+	if len(b) < 20 || binary.LittleEndian.Uint16(b[2:])&dtyp.SelfRelative == 0 {
+		return nil, fmt.Errorf("invalid security descriptor")
+	}
+
+	control := binary.LittleEndian.Uint16(b[2:])
+
+	ret := make([]byte, 20)
+	ret[0], ret[1] = b[0], b[1]
+
+	for _, part := range []struct {
+		// the security information flag.
+		info uint32
+		// the position of the offset in the header.
+		pos int
+		// the control flags of the part.
+		control uint16
+		// the part is the ACL (or the SID).
+		acl bool
+	}{
+		{OwnerSecurityInformation, 4, dtyp.OwnerDefaulted, false},
+		{GroupSecurityInformation, 8, dtyp.GroupDefaulted, false},
+		{SACLSecurityInformation, 12, dtyp.SACLPresent | dtyp.SACLDefaulted | dtyp.SACLAutoInherited | dtyp.SACLProtected | dtyp.SACLComputedInheritanceRequired, true},
+		{DACLSecurityInformation, 16, dtyp.DACLPresent | dtyp.DACLDefaulted | dtyp.DACLAutoInherited | dtyp.DACLProtected | dtyp.DACLComputedInheritanceRequired, true},
+	} {
+
+		if info&part.info == 0 {
+			control &^= part.control
+			continue
+		}
+
+		off := uint64(binary.LittleEndian.Uint32(b[part.pos:]))
+		if off == 0 {
+			continue
+		}
+
+		if off+8 > uint64(len(b)) {
+			return nil, fmt.Errorf("invalid security descriptor offset 0x%x", off)
+		}
+
+		// the sid size is computed from the sub-authority count, the acl
+		// size is stored in the acl header.
+		n := 8 + 4*uint64(b[off+1])
+		if part.acl {
+			n = uint64(binary.LittleEndian.Uint16(b[off+2:]))
+		}
+
+		if n < 8 || off+n > uint64(len(b)) {
+			return nil, fmt.Errorf("security descriptor part at 0x%x is truncated", off)
+		}
+
+		binary.LittleEndian.PutUint32(ret[part.pos:], uint32(len(ret)))
+		ret = append(ret, b[off:off+n]...)
+	}
+
+	binary.LittleEndian.PutUint16(ret[2:], control)
+
+	return ret, nil
+}
@@ -0,0 +1,208 @@
+package winreg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// The transaction log file is the base block (512 bytes) followed by the
+// dirty vector (old format, "DIRT") or by the log entries (new format, "HvLE").
+const (
+	hiveLogBaseBlockSize = 512
+	hiveLogSectorSize    = 512
+	hiveLogEntryHdrSize  = 40
+	// the Marvin32 seed for the log entry hashes.
+	hiveLogMarvinSeed = 0x82EF4D887A4E55C5
+)
+
+// hiveLogEntry is the new format log entry.
+type hiveLogEntry struct {
+	seq      uint32
+	dataSize uint32
+	pages    []hiveLogPage
+}
+
+// hiveLogPage is the dirty page of the log entry.
+type hiveLogPage struct {
+	offset uint32
+	data   []byte
+}
+
+// replay function applies the transaction logs to the hive bins data.
+func (o *Hive) replay(logs [][]byte) error {
+
+	var entries []*hiveLogEntry
+
+	for i, log := range logs {
+
+		if len(log) < hiveLogBaseBlockSize+4 {
+			continue
+		}
+
+		switch sig := string(log[hiveLogBaseBlockSize : hiveLogBaseBlockSize+4]); sig {
+		case "DIRT":
+			if err := o.replayDirtyVector(log); err != nil {
+				return fmt.Errorf("log %d: %w", i, err)
+			}
+		case "HvLE":
+			entries = append(entries, parseHiveLogEntries(log[hiveLogBaseBlockSize:])...)
+		default:
+			return fmt.Errorf("log %d: unknown log signature %q", i, sig)
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	// the entries of both logs are applied in the order of the sequence numbers
+	// starting from the secondary sequence number of the hive.
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].seq < entries[j].seq })
+
+	seq := o.secondarySeq
+
+	for _, e := range entries {
+		if e.seq < seq {
+			continue
+		}
+		if e.seq != seq {
+			break
+		}
+		if len(o.data) < int(e.dataSize) {
+			o.data = append(o.data, make([]byte, int(e.dataSize)-len(o.data))...)
+		}
+		for _, page := range e.pages {
+			if uint64(page.offset)+uint64(len(page.data)) > uint64(len(o.data)) {
+				return fmt.Errorf("log entry %d: dirty page 0x%x is out of bounds", e.seq, page.offset)
+			}
+			copy(o.data[page.offset:], page.data)
+		}
+		o.dataSize, seq = e.dataSize, seq+1
+	}
+
+	o.primarySeq, o.secondarySeq = seq, seq
+
+	return nil
+}
+
+// parseHiveLogEntries function returns the valid log entries. The parsing stops
+// at the first invalid entry.
+func parseHiveLogEntries(b []byte) []*hiveLogEntry {
+
+	var entries []*hiveLogEntry
+
+	for len(b) >= hiveLogEntryHdrSize && bytes.HasPrefix(b, []byte("HvLE")) {
+
+		size := int(binary.LittleEndian.Uint32(b[4:]))
+		if size < hiveLogEntryHdrSize || size%hiveLogSectorSize != 0 || size > len(b) {
+			break
+		}
+
+		entry := b[:size]
+
+		if marvin32(hiveLogMarvinSeed, entry[hiveLogEntryHdrSize:]) != binary.LittleEndian.Uint64(entry[24:]) ||
+			marvin32(hiveLogMarvinSeed, entry[:32]) != binary.LittleEndian.Uint64(entry[32:]) {
+			break
+		}
+
+		e := &hiveLogEntry{
+			seq:      binary.LittleEndian.Uint32(entry[12:]),
+			dataSize: binary.LittleEndian.Uint32(entry[16:]),
+		}
+
+		n := int(binary.LittleEndian.Uint32(entry[20:]))
+		refs, pages := entry[hiveLogEntryHdrSize:], entry[hiveLogEntryHdrSize:]
+		if n*8 > len(refs) {
+			break
+		}
+
+		pages = pages[n*8:]
+
+		for i := 0; i < n; i++ {
+			off, sz := binary.LittleEndian.Uint32(refs[i*8:]), int(binary.LittleEndian.Uint32(refs[i*8+4:]))
+			if sz > len(pages) {
+				return entries
+			}
+			e.pages, pages = append(e.pages, hiveLogPage{offset: off, data: pages[:sz]}), pages[sz:]
+		}
+
+		entries, b = append(entries, e), b[size:]
+	}
+
+	return entries
+}
+
+// replayDirtyVector function applies the old format log: each bit of the
+// dirty vector marks the dirty sector of the hive bins data, the dirty sectors
+// follow the dirty vector.
+func (o *Hive) replayDirtyVector(log []byte) error {
+
+	lb := &Hive{}
+	if err := lb.parseBaseBlock(log); err != nil {
+		return fmt.Errorf("base block: %w", err)
+	}
+
+	sectors := int(lb.dataSize) / hiveLogSectorSize
+	vector := log[hiveLogBaseBlockSize+4:]
+	if len(vector) < (sectors+7)/8 {
+		return fmt.Errorf("dirty vector is truncated")
+	}
+
+	// the dirty sectors start at the sector boundary after the dirty vector.
+	pos := (hiveLogBaseBlockSize + 4 + (sectors+7)/8 + hiveLogSectorSize - 1) / hiveLogSectorSize * hiveLogSectorSize
+
+	if len(o.data) < int(lb.dataSize) {
+		o.data = append(o.data, make([]byte, int(lb.dataSize)-len(o.data))...)
+	}
+
+	for i := 0; i < sectors; i++ {
+		if vector[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if pos+hiveLogSectorSize > len(log) {
+			return fmt.Errorf("dirty sector %d is truncated", i)
+		}
+		copy(o.data[i*hiveLogSectorSize:], log[pos:pos+hiveLogSectorSize])
+		pos += hiveLogSectorSize
+	}
+
+	o.dataSize, o.primarySeq, o.secondarySeq = lb.dataSize, lb.primarySeq, lb.primarySeq
+
+	return nil
+}
+
+// marvin32 function returns the Marvin32 hash of the data.
+func marvin32(seed uint64, b []byte) uint64 {
+
+	lo, hi := uint32(seed), uint32(seed>>32)
+
+	block := func() {
+		hi ^= lo
+		lo = bits.RotateLeft32(lo, 20)
+		lo += hi
+		hi = bits.RotateLeft32(hi, 9)
+		hi ^= lo
+		lo = bits.RotateLeft32(lo, 27)
+		lo += hi
+		hi = bits.RotateLeft32(hi, 19)
+	}
+
+	for ; len(b) >= 4; b = b[4:] {
+		lo += binary.LittleEndian.Uint32(b)
+		block()
+	}
+
+	final := uint32(0x80) << (8 * len(b))
+	for i := range b {
+		final |= uint32(b[i]) << (8 * i)
+	}
+
+	lo += final
+	block()
+	block()
+
+	return uint64(hi)<<32 | uint64(lo)
+}
@@ -0,0 +1,321 @@
+package winreg
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+)
+
+// testHive is the minimal hive builder.
+type testHive struct {
+	data []byte
+}
+
+func (h *testHive) cell(b []byte) uint32 {
+	off, size := uint32(len(h.data)), (len(b)+4+7)&^7
+	h.data = binary.LittleEndian.AppendUint32(h.data, uint32(-int32(size)))
+	h.data = append(h.data, b...)
+	h.data = append(h.data, make([]byte, size-len(b)-4)...)
+	return off
+}
+
+func (h *testHive) key(name string, subKeys, values []uint32, sk uint32) uint32 {
+	b := make([]byte, 76)
+	copy(b, "nk")
+	binary.LittleEndian.PutUint16(b[2:], hiveKeyCompressedName)
+	binary.LittleEndian.PutUint32(b[20:], uint32(len(subKeys)))
+	binary.LittleEndian.PutUint32(b[28:], hiveNilOffset)
+	binary.LittleEndian.PutUint32(b[36:], uint32(len(values)))
+	binary.LittleEndian.PutUint32(b[40:], hiveNilOffset)
+	binary.LittleEndian.PutUint32(b[44:], sk)
+	binary.LittleEndian.PutUint32(b[48:], hiveNilOffset)
+	binary.LittleEndian.PutUint16(b[72:], uint16(len(name)))
+	if len(subKeys) > 0 {
+		list := []byte("lf")
+		list = binary.LittleEndian.AppendUint16(list, uint16(len(subKeys)))
+		for _, off := range subKeys {
+			list = binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(list, off), 0)
+		}
+		binary.LittleEndian.PutUint32(b[28:], h.cell(list))
+	}
+	if len(values) > 0 {
+		var list []byte
+		for _, off := range values {
+			list = binary.LittleEndian.AppendUint32(list, off)
+		}
+		binary.LittleEndian.PutUint32(b[40:], h.cell(list))
+	}
+	off := h.cell(append(b, name...))
+	for _, sub := range subKeys {
+		binary.LittleEndian.PutUint32(h.data[sub+4+16:], off)
+	}
+	return off
+}
+
+func (h *testHive) value(name string, typ uint32, data []byte) uint32 {
+	b := make([]byte, 20)
+	copy(b, "vk")
+	binary.LittleEndian.PutUint16(b[2:], uint16(len(name)))
+	binary.LittleEndian.PutUint32(b[4:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(b[12:], typ)
+	binary.LittleEndian.PutUint16(b[16:], hiveValueCompressedName)
+	if len(data) <= 4 {
+		binary.LittleEndian.PutUint32(b[4:], uint32(len(data))|0x80000000)
+		copy(b[8:12], data)
+	} else {
+		binary.LittleEndian.PutUint32(b[8:], h.cell(data))
+	}
+	return h.cell(append(b, name...))
+}
+
+func (h *testHive) bytes(root uint32) []byte {
+	size := (len(h.data) + 4095) &^ 4095
+	h.data = append(h.data, make([]byte, size-len(h.data))...)
+	copy(h.data, "hbin")
+	binary.LittleEndian.PutUint32(h.data[8:], uint32(size))
+
+	base := make([]byte, hiveBaseBlockSize)
+	copy(base, "regf")
+	binary.LittleEndian.PutUint32(base[4:], 1)
+	binary.LittleEndian.PutUint32(base[8:], 1)
+	binary.LittleEndian.PutUint32(base[20:], 1)
+	binary.LittleEndian.PutUint32(base[24:], 5)
+	binary.LittleEndian.PutUint32(base[36:], root)
+	binary.LittleEndian.PutUint32(base[40:], uint32(size))
+	binary.LittleEndian.PutUint32(base[508:], hiveChecksum(base))
+
+	return append(base, h.data...)
+}
+
+func TestHive(t *testing.T) {
+
+	ctx := context.Background()
+
+	h := &testHive{data: make([]byte, 32)}
+
+	// O:BAG:SYD:NO_ACCESS_CONTROL
+	sd := []byte{
+		1, 0, 0x04, 0x80, 20, 0, 0, 0, 36, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 2, 0, 0, 0, 0, 0, 5, 32, 0, 0, 0, 32, 2, 0, 0,
+		1, 1, 0, 0, 0, 0, 0, 5, 18, 0, 0, 0,
+	}
+	skb := append(make([]byte, 20), sd...)
+	copy(skb, "sk")
+	binary.LittleEndian.PutUint32(skb[16:], uint32(len(sd)))
+	sk := h.cell(skb)
+
+	str, _ := EncodeValue("hello", RegString)
+	dword, _ := EncodeValue(42, RegDword)
+
+	sub := h.key("Sub", nil, []uint32{h.value("Str", RegString, str)}, sk)
+	root := h.key("ROOT", []uint32{sub}, []uint32{h.value("", RegDword, dword)}, sk)
+
+	b := h.bytes(root)
+
+	hive, err := OpenHive(b)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	hive.Root = `HKEY_LOCAL_MACHINE\TEST`
+
+	f, err := NewRegFile(ctx, hive, hive.Root)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	if len(f.Keys) != 2 || f.Keys[1].Path != `HKEY_LOCAL_MACHINE\TEST\Sub` {
+		t.Fatalf("export: unexpected keys: %s", f.String())
+	}
+
+	if v, _ := f.Keys[0].Values[0].Value.Value(); v != uint32(42) {
+		t.Errorf("export: unexpected default value %v", v)
+	}
+
+	v, err := hive.Value(ctx, `HKEY_LOCAL_MACHINE\TEST\sub`, "str")
+	if err != nil {
+		t.Fatalf("value: %v", err)
+	}
+
+	if s, _ := v.Value(); s != "hello" {
+		t.Errorf("value: unexpected string %v", s)
+	}
+
+	if _, err := hive.SubKeys(ctx, `HKEY_LOCAL_MACHINE\TEST\Missing`); !errors.Is(err, win32.ErrorFileNotFound) {
+		t.Errorf("subkeys: expected file not found, got %v", err)
+	}
+
+	sid := func(s *dtyp.SID) string {
+		if s == nil {
+			return ""
+		}
+		return s.String()
+	}
+
+	for _, tc := range []struct {
+		info         uint32
+		owner, group string
+		dacl         bool
+	}{
+		{OwnerSecurityInformation, "S-1-5-32-544", "", false},
+		{GroupSecurityInformation | DACLSecurityInformation, "", "S-1-5-18", true},
+		{OwnerSecurityInformation | GroupSecurityInformation | DACLSecurityInformation, "S-1-5-32-544", "S-1-5-18", true},
+	} {
+		sd, err := KeySecurityDescriptor(ctx, hive, hive.Root, tc.info)
+		if err != nil {
+			t.Fatalf("security: %x: %v", tc.info, err)
+		}
+		if sid(sd.Owner) != tc.owner || sid(sd.Group) != tc.group || (sd.Control&dtyp.DACLPresent != 0) != tc.dacl {
+			t.Errorf("security: %x: unexpected descriptor: owner %s, group %s, control %x", tc.info, sid(sd.Owner), sid(sd.Group), sd.Control)
+		}
+	}
+
+	// the security descriptor length overflows the cell.
+	binary.LittleEndian.PutUint32(hive.data[sk+4+16:], 0xFFFFFFF0)
+
+	if _, err := hive.KeySecurity(ctx, hive.Root, OwnerSecurityInformation); err == nil {
+		t.Errorf("security: expected truncated security descriptor error")
+	}
+
+	binary.LittleEndian.PutUint32(hive.data[sk+4+16:], uint32(len(sd)))
+
+	// dirty hive: the new value data is in the log entry.
+	off := binary.LittleEndian.Uint32(b[hiveBaseBlockSize+int(testValueCell(t, hive, sub))+4+8:])
+	page := append([]byte(nil), b[hiveBaseBlockSize:hiveBaseBlockSize+4096]...)
+	copy(page[off+4:], bytes.Repeat([]byte{'w', 0}, 5))
+
+	binary.LittleEndian.PutUint32(b[4:], 2)
+	binary.LittleEndian.PutUint32(b[508:], hiveChecksum(b))
+
+	entry := make([]byte, hiveLogEntryHdrSize)
+	copy(entry, "HvLE")
+	binary.LittleEndian.PutUint32(entry[12:], 1)
+	binary.LittleEndian.PutUint32(entry[16:], uint32(len(b)-hiveBaseBlockSize))
+	binary.LittleEndian.PutUint32(entry[20:], 1)
+	entry = binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint32(entry, 0), 4096)
+	entry = append(entry, page...)
+	entry = append(entry, make([]byte, 512-len(entry)%512)...)
+	binary.LittleEndian.PutUint32(entry[4:], uint32(len(entry)))
+	binary.LittleEndian.PutUint64(entry[24:], marvin32(hiveLogMarvinSeed, entry[hiveLogEntryHdrSize:]))
+	binary.LittleEndian.PutUint64(entry[32:], marvin32(hiveLogMarvinSeed, entry[:32]))
+
+	log := append(append([]byte(nil), b[:hiveLogBaseBlockSize]...), entry...)
+
+	if hive, err = OpenHive(b, log); err != nil {
+		t.Fatalf("open dirty: %v", err)
+	}
+
+	if v, err = hive.Value(ctx, "Sub", "Str"); err != nil {
+		t.Fatalf("dirty value: %v", err)
+	}
+
+	if s, _ := v.Value(); s != "wwwww" {
+		t.Errorf("dirty value: unexpected string %v", s)
+	}
+
+	// the primary base block is corrupted: the base block and the data
+	// are recovered from the log.
+	binary.LittleEndian.PutUint32(log[4:], 1)
+	binary.LittleEndian.PutUint32(log[508:], hiveChecksum(log))
+	copy(b, "xxxx")
+
+	if hive, err = OpenHive(b, log); err != nil {
+		t.Fatalf("open recovered: %v", err)
+	}
+
+	if v, err = hive.Value(ctx, "Sub", "Str"); err != nil {
+		t.Fatalf("recovered value: %v", err)
+	}
+
+	if s, _ := v.Value(); s != "wwwww" {
+		t.Errorf("recovered value: unexpected string %v", s)
+	}
+}
+
+func TestHiveKeyCycle(t *testing.T) {
+
+	h := &testHive{data: make([]byte, 32)}
+
+	// the subkey refers to itself.
+	loop := h.key("Loop", nil, nil, hiveNilOffset)
+	list := binary.LittleEndian.AppendUint32(binary.LittleEndian.AppendUint16([]byte("lf"), 1), loop)
+	list = binary.LittleEndian.AppendUint32(list, 0)
+	off := h.cell(list)
+	binary.LittleEndian.PutUint32(h.data[loop+4+20:], 1)
+	binary.LittleEndian.PutUint32(h.data[loop+4+28:], off)
+
+	hive, err := OpenHive(h.bytes(h.key("ROOT", []uint32{loop}, nil, hiveNilOffset)))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	if _, err := NewRegFile(context.Background(), hive, ""); err == nil {
+		t.Fatalf("export: expected key cycle error")
+	}
+}
+
+func TestHiveKeyDAG(t *testing.T) {
+
+	ctx := context.Background()
+
+	// each key lists the same subkey twice, the full walk is 2^23 keys.
+	h := &testHive{data: make([]byte, 32)}
+
+	key := h.key("Leaf", nil, nil, hiveNilOffset)
+	for i := 0; i < 23; i++ {
+		key = h.key("Key", []uint32{key, key}, nil, hiveNilOffset)
+	}
+
+	hive, err := OpenHive(h.bytes(h.key("ROOT", []uint32{key}, nil, hiveNilOffset)))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	visited := 0
+
+	err = Walk(ctx, hive, "", func(path string, info *KeyInfo, values []*Value, err error) error {
+		visited++
+		return err
+	})
+
+	// the root, the first key and the subkeys error of the first key.
+	if err == nil || visited > 3 {
+		t.Fatalf("walk: expected duplicate subkey error, got %v after %d keys", err, visited)
+	}
+
+	// the subkey is listed by two keys, the second key is not its parent.
+	h = &testHive{data: make([]byte, 32)}
+
+	leaf := h.key("Leaf", nil, nil, hiveNilOffset)
+	a, b := h.key("A", []uint32{leaf}, nil, hiveNilOffset), h.key("B", []uint32{leaf}, nil, hiveNilOffset)
+
+	if hive, err = OpenHive(h.bytes(h.key("ROOT", []uint32{a, b}, nil, hiveNilOffset))); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	if _, err := hive.SubKeys(ctx, `A`); err == nil {
+		t.Errorf("subkeys: A: expected parent error")
+	}
+
+	if _, err := hive.KeyInfo(ctx, `B\Leaf`); err != nil {
+		t.Errorf("key info: B\\Leaf: %v", err)
+	}
+}
+
+// testValueCell function returns the offset of the first value cell of the key.
+func testValueCell(t *testing.T, hive *Hive, key uint32) uint32 {
+	k, err := hive.key(key)
+	if err != nil {
+		t.Fatalf("key: %v", err)
+	}
+	list, err := hive.cell(k.valuesList)
+	if err != nil {
+		t.Fatalf("values list: %v", err)
+	}
+	return binary.LittleEndian.Uint32(list)
+}
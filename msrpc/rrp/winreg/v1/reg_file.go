@@ -0,0 +1,420 @@
+package winreg
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// RegFileHeader is the header of the .reg file (REGEDIT5).
+	RegFileHeader = "Windows Registry Editor Version 5.00"
+	// RegFileHeaderV4 is the header of the legacy .reg file (REGEDIT4).
+	RegFileHeaderV4 = "REGEDIT4"
+)
+
+// RegFile is the Windows registry (.reg) file.
+type RegFile struct {
+	// The keys in the order of appearance.
+	Keys []*RegKey `json:"keys"`
+}
+
+// RegKey is the .reg file key section.
+type RegKey struct {
+	// The full key path.
+	Path string `json:"path"`
+	// Delete is set for the [-key] section.
+	Delete bool `json:"delete,omitempty"`
+	// The key values.
+	Values []*RegValue `json:"values,omitempty"`
+}
+
+// RegValue is the .reg file value.
+type RegValue struct {
+	Value
+	// Delete is set for the "name"=- value.
+	Delete bool `json:"delete,omitempty"`
+}
+
+// NewRegFile function exports the registry subtree rooted at the path.
+func NewRegFile(ctx context.Context, r KeyReader, path string) (*RegFile, error) {
+
+	f := &RegFile{}
+
+	err := Walk(ctx, r, path, func(path string, info *KeyInfo, values []*Value, err error) error {
+		if err != nil {
+			return err
+		}
+		key := &RegKey{Path: path}
+		for _, value := range values {
+			key.Values = append(key.Values, &RegValue{Value: *value})
+		}
+		f.Keys = append(f.Keys, key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Export function exports the registry subtree rooted at the path into the
+// .reg file contents.
+func Export(ctx context.Context, r KeyReader, path string) ([]byte, error) {
+	f, err := NewRegFile(ctx, r, path)
+	if err != nil {
+		return nil, err
+	}
+	return f.Marshal(), nil
+}
+
+// Import function parses the .reg file contents and applies it to the registry.
+func Import(ctx context.Context, w KeyWriter, b []byte) error {
+	f, err := ParseRegFile(b)
+	if err != nil {
+		return err
+	}
+	return f.Apply(ctx, w)
+}
+
+// Apply function applies the .reg file to the registry: deletes the [-key]
+// keys, creates the keys, sets the values and deletes the "name"=- values.
+func (o *RegFile) Apply(ctx context.Context, w KeyWriter) error {
+
+	for _, key := range o.Keys {
+
+		if key.Delete {
+			if err := w.DeleteKey(ctx, key.Path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := w.CreateKey(ctx, key.Path); err != nil {
+			return err
+		}
+
+		for _, value := range key.Values {
+			if value.Delete {
+				if err := w.DeleteValue(ctx, key.Path, value.Name); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := w.SetValue(ctx, key.Path, &value.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Marshal function returns the UTF-16LE encoded .reg file with the byte order mark.
+func (o *RegFile) Marshal() []byte {
+	s := utf16.Encode([]rune(o.String()))
+	b := make([]byte, 2, 2+len(s)*2)
+	binary.LittleEndian.PutUint16(b, 0xFEFF)
+	for _, c := range s {
+		b = binary.LittleEndian.AppendUint16(b, c)
+	}
+	return b
+}
+
+// String function returns the .reg file text.
+func (o *RegFile) String() string {
+
+	var sb strings.Builder
+
+	sb.WriteString(RegFileHeader + "\r\n\r\n")
+
+	for _, key := range o.Keys {
+		if key.Delete {
+			sb.WriteString("[-" + key.Path + "]\r\n\r\n")
+			continue
+		}
+		sb.WriteString("[" + key.Path + "]\r\n")
+		for _, value := range key.Values {
+			sb.WriteString(value.String() + "\r\n")
+		}
+		sb.WriteString("\r\n")
+	}
+
+	return sb.String()
+}
+
+// String function returns the .reg file value line.
+func (o *RegValue) String() string {
+
+	name := "@"
+	if o.Name != "" {
+		name = `"` + escapeRegString(o.Name) + `"`
+	}
+
+	if o.Delete {
+		return name + "=-"
+	}
+
+	switch o.Type {
+	case RegString:
+		// the quoted form is used only if it reproduces the data exactly.
+		if v, err := DecodeValue(o.Type, o.Data); err == nil && !strings.ContainsAny(v.(string), "\r\n") {
+			if b, err := EncodeValue(v, o.Type); err == nil && bytes.Equal(b, o.Data) {
+				return name + `="` + escapeRegString(v.(string)) + `"`
+			}
+		}
+	case RegDword:
+		if len(o.Data) == 4 {
+			return name + "=" + fmt.Sprintf("dword:%08x", binary.LittleEndian.Uint32(o.Data))
+		}
+	}
+
+	prefix := name + "=hex:"
+	if o.Type != RegBinary {
+		prefix = name + "=hex(" + strconv.FormatUint(uint64(o.Type), 16) + "):"
+	}
+
+	return formatHex(prefix, o.Data)
+}
+
+// formatHex function formats the comma-separated hex bytes wrapped at
+// 80 columns as regedit does.
+func formatHex(prefix string, b []byte) string {
+
+	var sb strings.Builder
+
+	sb.WriteString(prefix)
+
+	for i, n := 0, len(prefix); i < len(b); i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+			if n++; n > 76 {
+				sb.WriteString("\\\r\n  ")
+				n = 2
+			}
+		}
+		sb.WriteString(hex.EncodeToString(b[i : i+1]))
+		n += 2
+	}
+
+	return sb.String()
+}
+
+func escapeRegString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+// ParseRegFile function parses the .reg file contents. The UTF-16LE (with byte
+// order mark) and UTF-8 encodings are supported.
+func ParseRegFile(b []byte) (*RegFile, error) {
+
+	text, err := decodeRegFile(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		f    = &RegFile{}
+		key  *RegKey
+		line string
+		hdr  bool
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(nil, len(text)+1)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+
+		if line += strings.TrimSpace(scanner.Text()); strings.HasSuffix(line, `\`) && !strings.HasPrefix(line, "[") {
+			// line continuation.
+			line = strings.TrimSuffix(line, `\`)
+			continue
+		}
+
+		cur := line
+		if line = ""; cur == "" || strings.HasPrefix(cur, ";") {
+			continue
+		}
+
+		if !hdr {
+			if cur != RegFileHeader && cur != RegFileHeaderV4 {
+				return nil, fmt.Errorf("winreg: parse reg file: line %d: invalid header: %q", lineNo, cur)
+			}
+			hdr = true
+			continue
+		}
+
+		if strings.HasPrefix(cur, "[") {
+			if !strings.HasSuffix(cur, "]") {
+				return nil, fmt.Errorf("winreg: parse reg file: line %d: invalid key: %q", lineNo, cur)
+			}
+			key = &RegKey{Path: cur[1 : len(cur)-1]}
+			if key.Path, key.Delete = strings.CutPrefix(key.Path, "-"); key.Path == "" {
+				return nil, fmt.Errorf("winreg: parse reg file: line %d: empty key", lineNo)
+			}
+			f.Keys = append(f.Keys, key)
+			continue
+		}
+
+		if key == nil {
+			return nil, fmt.Errorf("winreg: parse reg file: line %d: value outside of key", lineNo)
+		}
+
+		value, err := parseRegValue(cur)
+		if err != nil {
+			return nil, fmt.Errorf("winreg: parse reg file: line %d: %w", lineNo, err)
+		}
+
+		key.Values = append(key.Values, value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("winreg: parse reg file: %w", err)
+	}
+
+	if !hdr {
+		return nil, fmt.Errorf("winreg: parse reg file: header not found")
+	}
+
+	return f, nil
+}
+
+// decodeRegFile function returns the .reg file text.
+func decodeRegFile(b []byte) (string, error) {
+
+	if len(b) >= 2 && b[0] == 0xFF && b[1] == 0xFE {
+		if b = b[2:]; len(b)%2 != 0 {
+			return "", fmt.Errorf("winreg: parse reg file: invalid utf-16 length")
+		}
+		s := make([]uint16, len(b)/2)
+		for i := range s {
+			s[i] = binary.LittleEndian.Uint16(b[i*2:])
+		}
+		return string(utf16.Decode(s)), nil
+	}
+
+	return string(bytes.TrimPrefix(b, []byte{0xEF, 0xBB, 0xBF})), nil
+}
+
+// parseRegValue function parses the value line.
+func parseRegValue(line string) (*RegValue, error) {
+
+	var (
+		value = &RegValue{}
+		rest  string
+	)
+
+	switch {
+	case strings.HasPrefix(line, "@"):
+		rest = line[1:]
+	case strings.HasPrefix(line, `"`):
+		name, n, err := unquoteRegString(line)
+		if err != nil {
+			return nil, fmt.Errorf("value name: %w", err)
+		}
+		value.Name, rest = name, line[n:]
+	default:
+		return nil, fmt.Errorf("invalid value: %q", line)
+	}
+
+	rest, ok := strings.CutPrefix(strings.TrimSpace(rest), "=")
+	if !ok {
+		return nil, fmt.Errorf("value %q: missing '='", value.Name)
+	}
+
+	switch rest = strings.TrimSpace(rest); {
+	case rest == "-":
+		value.Delete = true
+	case strings.HasPrefix(rest, `"`):
+		s, n, err := unquoteRegString(rest)
+		if err != nil {
+			return nil, fmt.Errorf("value %q: %w", value.Name, err)
+		}
+		if strings.TrimSpace(rest[n:]) != "" {
+			return nil, fmt.Errorf("value %q: trailing data", value.Name)
+		}
+		if value.Data, err = EncodeValue(s, RegString); err != nil {
+			return nil, fmt.Errorf("value %q: %w", value.Name, err)
+		}
+		value.Type = RegString
+	case strings.HasPrefix(rest, "dword:"):
+		v, err := strconv.ParseUint(rest[6:], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("value %q: dword: %w", value.Name, err)
+		}
+		value.Type, value.Data = RegDword, binary.LittleEndian.AppendUint32(nil, uint32(v))
+	case strings.HasPrefix(rest, "hex"):
+		typ, data, ok := strings.Cut(rest[3:], ":")
+		if !ok {
+			return nil, fmt.Errorf("value %q: invalid hex value", value.Name)
+		}
+		value.Type = RegBinary
+		if typ != "" {
+			if !strings.HasPrefix(typ, "(") || !strings.HasSuffix(typ, ")") {
+				return nil, fmt.Errorf("value %q: invalid hex type %q", value.Name, typ)
+			}
+			v, err := strconv.ParseUint(typ[1:len(typ)-1], 16, 32)
+			if err != nil {
+				return nil, fmt.Errorf("value %q: hex type: %w", value.Name, err)
+			}
+			value.Type = uint32(v)
+		}
+		if value.Data, ok = parseHex(data); !ok {
+			return nil, fmt.Errorf("value %q: invalid hex data", value.Name)
+		}
+	default:
+		return nil, fmt.Errorf("value %q: invalid data: %q", value.Name, rest)
+	}
+
+	return value, nil
+}
+
+// unquoteRegString function returns the unescaped string and the length of
+// the quoted string in the line.
+func unquoteRegString(line string) (string, int, error) {
+
+	var sb strings.Builder
+
+	for i := 1; i < len(line); i++ {
+		switch c := line[i]; c {
+		case '"':
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i++; i == len(line) {
+				return "", 0, fmt.Errorf("unterminated escape")
+			}
+			sb.WriteByte(line[i])
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// parseHex function parses the comma-separated hex bytes.
+func parseHex(s string) ([]byte, bool) {
+
+	b := []byte{}
+
+	if s = strings.TrimSpace(s); s == "" {
+		return b, true
+	}
+
+	for _, p := range strings.Split(s, ",") {
+		v, err := strconv.ParseUint(strings.TrimSpace(p), 16, 8)
+		if err != nil {
+			return nil, false
+		}
+		b = append(b, byte(v))
+	}
+
+	return b, true
+}
@@ -0,0 +1,71 @@
+package winreg
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRegFile(t *testing.T) {
+
+	text := "Windows Registry Editor Version 5.00\r\n" +
+		"\r\n" +
+		"; comment\r\n" +
+		"[HKEY_LOCAL_MACHINE\\SOFTWARE\\Test]\r\n" +
+		"@=\"default\"\r\n" +
+		"\"Path\"=\"C:\\\\Program Files\\\\\\\"Test\\\"\"\r\n" +
+		"\"Count\"=dword:0000002a\r\n" +
+		"\"Blob\"=hex:01,02,\\\r\n" +
+		"  03,04\r\n" +
+		"\"Multi\"=hex(7):61,00,00,00,00,00\r\n" +
+		"\"Old\"=-\r\n" +
+		"\r\n" +
+		"[-HKEY_LOCAL_MACHINE\\SOFTWARE\\Test\\Old]\r\n"
+
+	f, err := ParseRegFile([]byte(text))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if len(f.Keys) != 2 || !f.Keys[1].Delete || len(f.Keys[0].Values) != 6 {
+		t.Fatalf("parse: unexpected keys: %+v", f.Keys)
+	}
+
+	values := f.Keys[0].Values
+
+	if v, _ := values[1].Value.Value(); v != `C:\Program Files\"Test"` {
+		t.Errorf("parse: unexpected string %q", v)
+	}
+
+	if v, _ := values[2].Value.Value(); v != uint32(42) {
+		t.Errorf("parse: unexpected dword %v", v)
+	}
+
+	if !bytes.Equal(values[3].Data, []byte{1, 2, 3, 4}) {
+		t.Errorf("parse: unexpected binary %x", values[3].Data)
+	}
+
+	if values[4].Type != RegMultistring || !values[5].Delete {
+		t.Errorf("parse: unexpected values %+v, %+v", values[4], values[5])
+	}
+
+	// round-trip through the UTF-16LE encoding.
+	f2, err := ParseRegFile(f.Marshal())
+	if err != nil {
+		t.Fatalf("parse marshaled: %v", err)
+	}
+
+	if f.String() != f2.String() {
+		t.Errorf("round-trip mismatch:\n%s\n%s", f.String(), f2.String())
+	}
+
+	long := &RegValue{Value: Value{Name: "Long", Type: RegBinary, Data: make([]byte, 100)}}
+	for _, line := range bytes.Split([]byte(long.String()), []byte("\r\n")) {
+		if len(line) > 80 {
+			t.Errorf("format: line is too long: %d", len(line))
+		}
+	}
+
+	if _, err := ParseRegFile([]byte("[HKEY_LOCAL_MACHINE\\SOFTWARE]\r\n")); err == nil {
+		t.Errorf("parse: expected error for the missing header")
+	}
+}
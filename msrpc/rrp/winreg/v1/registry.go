@@ -0,0 +1,170 @@
+package winreg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// SkipKey is returned by the WalkFunc to skip the subkeys of the key.
+var SkipKey = errors.New("winreg: skip key")
+
+// KeyInfo is the registry key information.
+type KeyInfo struct {
+	// The key class.
+	Class string `json:"class,omitempty"`
+	// The number of the subkeys.
+	SubKeysCount int `json:"sub_keys_count"`
+	// The number of the values.
+	ValuesCount int `json:"values_count"`
+	// The last write time.
+	LastWriteTime time.Time `json:"last_write_time"`
+}
+
+// Value is the registry value.
+type Value struct {
+	// The value name, empty name is the default value.
+	Name string `json:"name"`
+	// The value type (RegString, RegDword, ...).
+	Type uint32 `json:"type"`
+	// The raw value data.
+	Data []byte `json:"data"`
+}
+
+// NewValue function returns the value with the data encoded according to the
+// value type. (See EncodeValue).
+func NewValue(name string, valueType uint32, value any) (*Value, error) {
+	b, err := EncodeValue(value, valueType)
+	if err != nil {
+		return nil, err
+	}
+	return &Value{Name: name, Type: valueType, Data: b}, nil
+}
+
+// Value function returns the decoded value data. (See DecodeValue).
+func (o *Value) Value() (any, error) {
+	return DecodeValue(o.Type, o.Data)
+}
+
+// KeyReader is the read access to the registry tree. The key path is the
+// backslash-separated path starting with the hive name (HKEY_LOCAL_MACHINE\...).
+type KeyReader interface {
+	// KeyInfo returns the key information.
+	KeyInfo(context.Context, string) (*KeyInfo, error)
+	// SubKeys returns the names of the subkeys.
+	SubKeys(context.Context, string) ([]string, error)
+	// Values returns the key values.
+	Values(context.Context, string) ([]*Value, error)
+}
+
+// KeyWriter is the write access to the registry tree.
+type KeyWriter interface {
+	// CreateKey creates the key and all missing parent keys.
+	CreateKey(context.Context, string) error
+	// DeleteKey deletes the key with all subkeys.
+	DeleteKey(context.Context, string) error
+	// SetValue sets the key value.
+	SetValue(context.Context, string, *Value) error
+	// DeleteValue deletes the key value.
+	DeleteValue(context.Context, string, string) error
+}
+
+// KeySecurityReader is the read access to the key security.
+type KeySecurityReader interface {
+	// KeySecurity returns the self-relative security descriptor of the key.
+	KeySecurity(context.Context, string, uint32) ([]byte, error)
+}
+
+// KeySecurityDescriptor function returns the decoded security descriptor of the key.
+func KeySecurityDescriptor(ctx context.Context, r KeySecurityReader, path string, info uint32) (*dtyp.SecurityDescriptor, error) {
+
+	b, err := r.KeySecurity(ctx, path, info)
+	if err != nil {
+		return nil, err
+	}
+
+	sd := &dtyp.SecurityDescriptor{}
+	if err := sd.Parse(b); err != nil {
+		return nil, fmt.Errorf("winreg: parse security descriptor %s: %w", path, err)
+	}
+
+	return sd, nil
+}
+
+// WalkFunc is the function called by Walk for each visited key. If the
+// key cannot be read, err is the error and info and values are nil. If the
+// function returns SkipKey, the subkeys are not visited, any other error
+// stops the walk.
+type WalkFunc func(path string, info *KeyInfo, values []*Value, err error) error
+
+// Walk function walks the registry tree rooted at the path in the depth-first
+// order, the subkeys are visited in the order returned by the reader.
+func Walk(ctx context.Context, r KeyReader, path string, fn WalkFunc) error {
+	if err := walk(ctx, r, JoinPath(path), fn); err != nil && err != SkipKey {
+		return err
+	}
+	return nil
+}
+
+func walk(ctx context.Context, r KeyReader, path string, fn WalkFunc) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	info, err := r.KeyInfo(ctx, path)
+	if err != nil {
+		return fn(path, nil, nil, err)
+	}
+
+	values, err := r.Values(ctx, path)
+	if err != nil {
+		return fn(path, nil, nil, err)
+	}
+
+	if err := fn(path, info, values, nil); err != nil {
+		return err
+	}
+
+	subKeys, err := r.SubKeys(ctx, path)
+	if err != nil {
+		return fn(path, nil, nil, err)
+	}
+
+	for _, subKey := range subKeys {
+		if err := walk(ctx, r, JoinPath(path, subKey), fn); err != nil && err != SkipKey {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// JoinPath function joins the path elements with the backslash and
+// removes the empty elements.
+func JoinPath(elem ...string) string {
+	var parts []string
+	for _, e := range elem {
+		for _, p := range strings.Split(e, `\`) {
+			if p != "" {
+				parts = append(parts, p)
+			}
+		}
+	}
+	return strings.Join(parts, `\`)
+}
+
+// SplitPath function splits the path into the canonical hive name and the
+// subkey path.
+func SplitPath(path string) (string, string, error) {
+	hive, subKey, _ := strings.Cut(JoinPath(path), `\`)
+	hive, err := ParseRegistryHiveName(hive)
+	if err != nil {
+		return "", "", fmt.Errorf("winreg: %w", err)
+	}
+	return hive, subKey, nil
+}
@@ -0,0 +1,504 @@
+package winreg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+	"github.com/oiweiwei/go-msrpc/ndr"
+)
+
+const (
+	// maxBufferSize is the maximum buffer size for the enumeration retries.
+	maxBufferSize = 0x100000
+	// maxNameSize is the maximum size of the key or value name in bytes.
+	maxNameSize = 0xFFFE
+)
+
+var (
+	_ KeyReader         = (*Registry)(nil)
+	_ KeyWriter         = (*Registry)(nil)
+	_ KeySecurityReader = (*Registry)(nil)
+)
+
+// Registry is the remote registry (MS-RRP) implementation of the KeyReader
+// and KeyWriter. The hive keys are opened on demand and cached until Close.
+type Registry struct {
+	// The registry client.
+	Client WinregClient
+
+	mu    sync.Mutex
+	hives map[string]*Key
+}
+
+// NewRegistry function returns the remote registry for the client.
+func NewRegistry(cli WinregClient) *Registry {
+	return &Registry{Client: cli, hives: make(map[string]*Key)}
+}
+
+// Close function closes the opened hive keys.
+func (o *Registry) Close(ctx context.Context) error {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var errs []error
+	for hive, key := range o.hives {
+		if err := o.closeKey(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+		delete(o.hives, hive)
+	}
+
+	return errors.Join(errs...)
+}
+
+// hive function returns the opened hive key.
+func (o *Registry) hive(ctx context.Context, name string) (*Key, error) {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if key, ok := o.hives[name]; ok {
+		return key, nil
+	}
+
+	var (
+		key *Key
+		err error
+	)
+
+	switch name {
+	case KeyLocalMachine:
+		var resp *OpenLocalMachineResponse
+		if resp, err = o.Client.OpenLocalMachine(ctx, &OpenLocalMachineRequest{DesiredAccess: dtyp.AccessMaskMaximumAllowed}); err == nil {
+			key = resp.Key
+		}
+	case KeyCurrentUser:
+		var resp *OpenCurrentUserResponse
+		if resp, err = o.Client.OpenCurrentUser(ctx, &OpenCurrentUserRequest{DesiredAccess: dtyp.AccessMaskMaximumAllowed}); err == nil {
+			key = resp.Key
+		}
+	case KeyClassesRoot:
+		var resp *OpenClassesRootResponse
+		if resp, err = o.Client.OpenClassesRoot(ctx, &OpenClassesRootRequest{DesiredAccess: dtyp.AccessMaskMaximumAllowed}); err == nil {
+			key = resp.Key
+		}
+	case KeyUsers:
+		var resp *OpenUsersResponse
+		if resp, err = o.Client.OpenUsers(ctx, &OpenUsersRequest{DesiredAccess: dtyp.AccessMaskMaximumAllowed}); err == nil {
+			key = resp.Key
+		}
+	case KeyCurrentConfig:
+		var resp *OpenCurrentConfigResponse
+		if resp, err = o.Client.OpenCurrentConfig(ctx, &OpenCurrentConfigRequest{DesiredAccess: dtyp.AccessMaskMaximumAllowed}); err == nil {
+			key = resp.Key
+		}
+	default:
+		return nil, fmt.Errorf("winreg: unknown hive: %s", name)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("winreg: open hive %s: %w", name, err)
+	}
+
+	if o.hives == nil {
+		o.hives = make(map[string]*Key)
+	}
+
+	o.hives[name] = key
+
+	return key, nil
+}
+
+// OpenKey function opens the key with the desired access. The returned key
+// must be closed with CloseKey.
+func (o *Registry) OpenKey(ctx context.Context, path string, access uint32) (*Key, error) {
+
+	hiveName, subKey, err := SplitPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	hive, err := o.hive(ctx, hiveName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := o.Client.BaseRegOpenKey(ctx, &BaseRegOpenKeyRequest{
+		Key:           hive,
+		SubKey:        unicodeString(subKey),
+		DesiredAccess: access,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("winreg: open key %s: %w", path, err)
+	}
+
+	return resp.ResultKey, nil
+}
+
+// CloseKey function closes the key opened with OpenKey.
+func (o *Registry) CloseKey(ctx context.Context, key *Key) error {
+	return o.closeKey(ctx, key)
+}
+
+func (o *Registry) closeKey(ctx context.Context, key *Key) error {
+	if key == nil {
+		return nil
+	}
+	if _, err := o.Client.BaseRegCloseKey(ctx, &BaseRegCloseKeyRequest{Key: key}); err != nil {
+		return fmt.Errorf("winreg: close key: %w", err)
+	}
+	return nil
+}
+
+// withKey function opens the key, calls the function and closes the key.
+func (o *Registry) withKey(ctx context.Context, path string, access uint32, fn func(*Key) error) error {
+
+	key, err := o.OpenKey(ctx, path, access)
+	if err != nil {
+		return err
+	}
+
+	defer o.closeKey(ctx, key)
+
+	return fn(key)
+}
+
+// KeyInfo function returns the key information.
+func (o *Registry) KeyInfo(ctx context.Context, path string) (*KeyInfo, error) {
+
+	var info *KeyInfo
+
+	err := o.withKey(ctx, path, KeyQueryValue, func(key *Key) error {
+		resp, err := o.queryInfo(ctx, key)
+		if err != nil {
+			return fmt.Errorf("winreg: query info %s: %w", path, err)
+		}
+		info = &KeyInfo{
+			SubKeysCount:  int(resp.SubKeysCount),
+			ValuesCount:   int(resp.ValuesCount),
+			LastWriteTime: resp.LastWriteTime.AsTime(),
+		}
+		if resp.ClassOut != nil {
+			info.Class = strings.TrimRight(resp.ClassOut.Buffer, ndr.ZeroString)
+		}
+		return nil
+	})
+
+	return info, err
+}
+
+func (o *Registry) queryInfo(ctx context.Context, key *Key) (*BaseRegQueryInfoKeyResponse, error) {
+	return o.Client.BaseRegQueryInfoKey(ctx, &BaseRegQueryInfoKeyRequest{
+		Key:     key,
+		ClassIn: &UnicodeString{MaximumLength: maxNameSize},
+	})
+}
+
+// SubKeys function returns the names of the subkeys.
+func (o *Registry) SubKeys(ctx context.Context, path string) ([]string, error) {
+
+	var subKeys []string
+
+	err := o.withKey(ctx, path, KeyQueryValue|KeyEnumerateSubKeys, func(key *Key) error {
+
+		info, err := o.queryInfo(ctx, key)
+		if err != nil {
+			return fmt.Errorf("winreg: query info %s: %w", path, err)
+		}
+
+		size := nameSize(info.MaxSubKeyLength)
+
+		for i := uint32(0); ; i++ {
+
+			resp, err := o.Client.BaseRegEnumKey(ctx, &BaseRegEnumKeyRequest{
+				Key:           key,
+				Index:         i,
+				NameIn:        &UnicodeString{MaximumLength: uint16(size)},
+				ClassIn:       &UnicodeString{},
+				LastWriteTime: &dtyp.Filetime{},
+			})
+			if err != nil {
+				if errors.Is(err, win32.ErrorNoMoreItems) {
+					return nil
+				}
+				if isMoreData(err) && size < maxNameSize {
+					size, i = min(size*2, maxNameSize), i-1
+					continue
+				}
+				return fmt.Errorf("winreg: enum key %s: %w", path, err)
+			}
+
+			if resp.NameOut != nil {
+				subKeys = append(subKeys, strings.TrimRight(resp.NameOut.Buffer, ndr.ZeroString))
+			}
+		}
+	})
+
+	return subKeys, err
+}
+
+// Values function returns the key values.
+func (o *Registry) Values(ctx context.Context, path string) ([]*Value, error) {
+
+	var values []*Value
+
+	err := o.withKey(ctx, path, KeyQueryValue, func(key *Key) error {
+
+		info, err := o.queryInfo(ctx, key)
+		if err != nil {
+			return fmt.Errorf("winreg: query info %s: %w", path, err)
+		}
+
+		nSize, dSize := nameSize(info.MaxValueNameLength), max(info.MaxValueLength, 1)
+
+		for i := uint32(0); ; i++ {
+
+			resp, err := o.Client.BaseRegEnumValue(ctx, &BaseRegEnumValueRequest{
+				Key:         key,
+				Index:       i,
+				ValueNameIn: &UnicodeString{MaximumLength: uint16(nSize)},
+				DataLength:  dSize,
+			})
+			if err != nil {
+				if errors.Is(err, win32.ErrorNoMoreItems) {
+					return nil
+				}
+				if isMoreData(err) && (nSize < maxNameSize || dSize < maxBufferSize) {
+					if nSize, dSize, i = min(nSize*2, maxNameSize), min(dSize*2, maxBufferSize), i-1; resp != nil {
+						dSize = max(dSize, resp.DataLength)
+					}
+					continue
+				}
+				return fmt.Errorf("winreg: enum value %s: %w", path, err)
+			}
+
+			value := &Value{Type: resp.Type, Data: resp.Data[:min(len(resp.Data), int(resp.Length))]}
+			if resp.ValueNameOut != nil {
+				value.Name = strings.TrimRight(resp.ValueNameOut.Buffer, ndr.ZeroString)
+			}
+
+			values = append(values, value)
+		}
+	})
+
+	return values, err
+}
+
+// Value function returns the key value.
+func (o *Registry) Value(ctx context.Context, path string, name string) (*Value, error) {
+
+	var value *Value
+
+	err := o.withKey(ctx, path, KeyQueryValue, func(key *Key) error {
+
+		for size := uint32(0x1000); ; {
+
+			resp, err := o.Client.BaseRegQueryValue(ctx, &BaseRegQueryValueRequest{
+				Key:        key,
+				ValueName:  unicodeString(name),
+				DataLength: size,
+			})
+			if err != nil {
+				if isMoreData(err) && size < maxBufferSize {
+					if size = min(size*2, maxBufferSize); resp != nil {
+						size = max(size, resp.DataLength)
+					}
+					continue
+				}
+				return fmt.Errorf("winreg: query value %s\\%s: %w", path, name, err)
+			}
+
+			value = &Value{Name: name, Type: resp.Type, Data: resp.Data[:min(len(resp.Data), int(resp.Length))]}
+			return nil
+		}
+	})
+
+	return value, err
+}
+
+// CreateKey function creates the key and all missing parent keys.
+func (o *Registry) CreateKey(ctx context.Context, path string) error {
+
+	hiveName, subKey, err := SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	hive, err := o.hive(ctx, hiveName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.Client.BaseRegCreateKey(ctx, &BaseRegCreateKeyRequest{
+		Key:           hive,
+		SubKey:        unicodeString(subKey),
+		Class:         unicodeString(""),
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return fmt.Errorf("winreg: create key %s: %w", path, err)
+	}
+
+	return o.closeKey(ctx, resp.ResultKey)
+}
+
+// DeleteKey function deletes the key with all subkeys.
+func (o *Registry) DeleteKey(ctx context.Context, path string) error {
+
+	path = JoinPath(path)
+
+	subKeys, err := o.SubKeys(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	for _, subKey := range subKeys {
+		if err := o.DeleteKey(ctx, path+`\`+subKey); err != nil {
+			return err
+		}
+	}
+
+	i := strings.LastIndex(path, `\`)
+	if i < 0 {
+		return fmt.Errorf("winreg: delete key %s: cannot delete the hive", path)
+	}
+
+	return o.withKey(ctx, path[:i], dtyp.AccessMaskMaximumAllowed, func(key *Key) error {
+		if _, err := o.Client.BaseRegDeleteKey(ctx, &BaseRegDeleteKeyRequest{
+			Key:    key,
+			SubKey: unicodeString(path[i+1:]),
+		}); err != nil {
+			return fmt.Errorf("winreg: delete key %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// SetValue function sets the key value.
+func (o *Registry) SetValue(ctx context.Context, path string, value *Value) error {
+	return o.withKey(ctx, path, KeySetValue, func(key *Key) error {
+		if _, err := o.Client.BaseRegSetValue(ctx, &BaseRegSetValueRequest{
+			Key:        key,
+			ValueName:  unicodeString(value.Name),
+			Type:       value.Type,
+			Data:       value.Data,
+			DataLength: uint32(len(value.Data)),
+		}); err != nil {
+			return fmt.Errorf("winreg: set value %s\\%s: %w", path, value.Name, err)
+		}
+		return nil
+	})
+}
+
+// DeleteValue function deletes the key value.
+func (o *Registry) DeleteValue(ctx context.Context, path string, name string) error {
+	return o.withKey(ctx, path, KeySetValue, func(key *Key) error {
+		if _, err := o.Client.BaseRegDeleteValue(ctx, &BaseRegDeleteValueRequest{
+			Key:       key,
+			ValueName: unicodeString(name),
+		}); err != nil {
+			return fmt.Errorf("winreg: delete value %s\\%s: %w", path, name, err)
+		}
+		return nil
+	})
+}
+
+// KeySecurity function returns the self-relative security descriptor of the
+// key. The info is the combination of the OwnerSecurityInformation,
+// GroupSecurityInformation, DACLSecurityInformation and SACLSecurityInformation.
+func (o *Registry) KeySecurity(ctx context.Context, path string, info uint32) ([]byte, error) {
+
+	var sd []byte
+
+	err := o.withKey(ctx, path, securityAccess(info, false), func(key *Key) error {
+
+		for size := uint32(0x1000); ; {
+
+			resp, err := o.Client.BaseRegGetKeySecurity(ctx, &BaseRegGetKeySecurityRequest{
+				Key:                  key,
+				SecurityInformation:  info,
+				SecurityDescriptorIn: &SecurityDescriptor{InSecurityDescriptorLength: size},
+			})
+			if err != nil {
+				if isMoreData(err) && size < maxBufferSize {
+					if size = min(size*2, maxBufferSize); resp != nil && resp.SecurityDescriptorOut != nil {
+						size = max(size, resp.SecurityDescriptorOut.InSecurityDescriptorLength)
+					}
+					continue
+				}
+				return fmt.Errorf("winreg: get key security %s: %w", path, err)
+			}
+
+			if resp.SecurityDescriptorOut != nil {
+				sd = resp.SecurityDescriptorOut.SecurityDescriptor
+			}
+
+			return nil
+		}
+	})
+
+	return sd, err
+}
+
+// SetKeySecurity function sets the self-relative security descriptor of the key.
+func (o *Registry) SetKeySecurity(ctx context.Context, path string, info uint32, sd []byte) error {
+	return o.withKey(ctx, path, securityAccess(info, true), func(key *Key) error {
+		if _, err := o.Client.BaseRegSetKeySecurity(ctx, &BaseRegSetKeySecurityRequest{
+			Key:                 key,
+			SecurityInformation: info,
+			SecurityDescriptor: &SecurityDescriptor{
+				SecurityDescriptor:          sd,
+				InSecurityDescriptorLength:  uint32(len(sd)),
+				OutSecurityDescriptorLength: uint32(len(sd)),
+			},
+		}); err != nil {
+			return fmt.Errorf("winreg: set key security %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// securityAccess function returns the access mask required to read or write
+// the security information.
+func securityAccess(info uint32, write bool) uint32 {
+
+	access := uint32(dtyp.AccessMaskReadControl)
+	if write {
+		access = 0
+		if info&(OwnerSecurityInformation|GroupSecurityInformation) != 0 {
+			access |= dtyp.AccessMaskWriteOwner
+		}
+		if info&DACLSecurityInformation != 0 {
+			access |= dtyp.AccessMaskWriteDACL
+		}
+	}
+
+	if info&SACLSecurityInformation != 0 {
+		access |= dtyp.AccessMaskAccessSystemSecurity
+	}
+
+	return access
+}
+
+// unicodeString function returns the NUL-terminated string.
+func unicodeString(s string) *UnicodeString {
+	s += ndr.ZeroString
+	n := uint16(ndr.UTF16Len(s) * 2)
+	return &UnicodeString{Buffer: s, Length: n, MaximumLength: n}
+}
+
+// nameSize function returns the buffer size in bytes for the name of at most
+// n characters including the NUL terminator.
+func nameSize(n uint32) uint32 {
+	return min((n+1)*2, maxNameSize)
+}
+
+func isMoreData(err error) bool {
+	return errors.Is(err, win32.ErrorMoreData) || errors.Is(err, win32.ErrorInsufficientBuffer)
+}
@@ -0,0 +1,41 @@
+package samr
+
+// The user account control flags. (See MS-SAMR 2.2.1.12).
+const (
+	UserAccountDisabled                    uint32 = 0x00000001
+	UserHomeDirectoryRequired              uint32 = 0x00000002
+	UserPasswordNotRequired                uint32 = 0x00000004
+	UserTempDuplicateAccount               uint32 = 0x00000008
+	UserNormalAccount                      uint32 = 0x00000010
+	UserMNSLogonAccount                    uint32 = 0x00000020
+	UserInterdomainTrustAccount            uint32 = 0x00000040
+	UserWorkstationTrustAccount            uint32 = 0x00000080
+	UserServerTrustAccount                 uint32 = 0x00000100
+	UserDontExpirePassword                 uint32 = 0x00000200
+	UserAccountAutoLocked                  uint32 = 0x00000400
+	UserEncryptedTextPasswordAllowed       uint32 = 0x00000800
+	UserSmartcardRequired                  uint32 = 0x00001000
+	UserTrustedForDelegation               uint32 = 0x00002000
+	UserNotDelegated                       uint32 = 0x00004000
+	UserUseDESKeyOnly                      uint32 = 0x00008000
+	UserDontRequirePreauth                 uint32 = 0x00010000
+	UserPasswordExpired                    uint32 = 0x00020000
+	UserTrustedToAuthenticateForDelegation uint32 = 0x00040000
+	UserNoAuthDataRequired                 uint32 = 0x00080000
+	UserPartialSecretsAccount              uint32 = 0x00100000
+	UserUseAESKeys                         uint32 = 0x00200000
+)
+
+// The group membership attributes. (See MS-SAMR 2.2.1.10).
+const (
+	GroupMandatory        uint32 = 0x00000001
+	GroupEnabledByDefault uint32 = 0x00000002
+	GroupEnabled          uint32 = 0x00000004
+)
+
+// The server supported features returned within the SamrConnect5 revision
+// information. (See MS-SAMR 2.2.7.15).
+const (
+	SupportedFeatureRIDToSID uint32 = 0x00000001
+	SupportedFeatureAES      uint32 = 0x00000010
+)
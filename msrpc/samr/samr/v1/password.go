@@ -0,0 +1,179 @@
+package samr
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+var (
+	// The AES-256-CBC-HMAC-SHA512 key derivation labels. (See MS-SAMR 3.2.2.4).
+	aesEncryptionKeyLabel = []byte("Microsoft SAM encryption key AEAD-AES-256-CBC-HMAC-SHA512 16\x00")
+	aesMACKeyLabel        = []byte("Microsoft SAM MAC key AEAD-AES-256-CBC-HMAC-SHA512 16\x00")
+)
+
+// nonce function returns the random bytes for the password buffers and the
+// salts.
+var nonce = crypto.Nonce
+
+// DefaultPBKDF2Iterations is the PBKDF2 iteration count used to derive
+// the content encryption key for the SamrUnicodeChangePasswordUser4.
+const DefaultPBKDF2Iterations = 5000
+
+// NTOWF function returns the NT one-way function of the password (MD4 hash
+// of the UTF-16LE encoded password).
+func NTOWF(password string) ([]byte, error) {
+	b, err := utf16le.Encode(password)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.MD4(b)
+}
+
+// EncryptUserPassword function returns the SAMPR_ENCRYPTED_USER_PASSWORD
+// buffer encrypted with RC4 using the key.
+func EncryptUserPassword(key []byte, password string) (*EncryptedUserPassword, error) {
+
+	b, err := newPasswordBuffer516(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := crypto.RC4K(key, b); err != nil {
+		return nil, fmt.Errorf("encrypt user password: %w", err)
+	}
+
+	return &EncryptedUserPassword{Buffer: b}, nil
+}
+
+// EncryptUserPasswordNew function returns the SAMPR_ENCRYPTED_USER_PASSWORD_NEW
+// buffer encrypted with RC4 using the MD5 of the random salt and the session key.
+func EncryptUserPasswordNew(key []byte, password string) (*EncryptedUserPasswordNew, error) {
+
+	b, err := newPasswordBuffer516(password)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := nonce(16)
+	if err != nil {
+		return nil, err
+	}
+
+	rc4Key, err := crypto.MD5(salt, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := crypto.RC4K(rc4Key, b); err != nil {
+		return nil, fmt.Errorf("encrypt user password: %w", err)
+	}
+
+	return &EncryptedUserPasswordNew{Buffer: append(b, salt...)}, nil
+}
+
+// EncryptPasswordAES function returns the SAMPR_ENCRYPTED_PASSWORD_AES buffer
+// encrypted with the AES-256-CBC-HMAC-SHA512 using the content encryption key.
+// For the SamrUnicodeChangePasswordUser4 the content encryption key must be derived
+// using the PBKDF2 (see ChangePasswordKey) and the same salt and iterations must
+// be used.
+func EncryptPasswordAES(cek []byte, salt []byte, iterations uint64, password string) (*EncryptedPasswordAES, error) {
+
+	b, err := newPasswordBuffer514(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if salt == nil {
+		if salt, err = nonce(16); err != nil {
+			return nil, err
+		}
+	}
+
+	c, authData, err := crypto.AEAD_AES_256_CBC_HMAC_SHA512_Encrypt(cek, aesEncryptionKeyLabel, aesMACKeyLabel, salt, b)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt password aes: %w", err)
+	}
+
+	return &EncryptedPasswordAES{
+		AuthData:         authData,
+		Salt:             salt,
+		CipherLength:     uint32(len(c)),
+		Cipher:           c,
+		Pbkdf2Iterations: iterations,
+	}, nil
+}
+
+// ChangePasswordKey function returns the content encryption key for the
+// SamrUnicodeChangePasswordUser4 derived from the old password NT hash.
+func ChangePasswordKey(oldNTOWF []byte, salt []byte, iterations uint64) ([]byte, error) {
+	return pbkdf2.Key(sha512.New, string(oldNTOWF), salt, int(iterations), 16)
+}
+
+// EncryptNTOWFPassword function encrypts the 16-byte hash with the 16-byte key
+// using the DES in ECB mode. (See MS-SAMR 2.2.11.1.1).
+func EncryptNTOWFPassword(key []byte, hash []byte) (*EncryptedNTOWFPassword, error) {
+
+	if len(key) < 14 || len(hash) != 16 {
+		return nil, fmt.Errorf("encrypt ntowf password: invalid key or hash length")
+	}
+
+	b := make([]byte, 0, 16)
+	b = append(b, crypto.DES(key[0:7], hash[0:8])...)
+	b = append(b, crypto.DES(key[7:14], hash[8:16])...)
+
+	return &EncryptedNTOWFPassword{Data: b}, nil
+}
+
+// newPasswordBuffer516 function returns the 516-byte password buffer: the
+// password is placed at the end of the 512-byte random buffer and
+// followed by its length.
+func newPasswordBuffer516(password string) ([]byte, error) {
+
+	pw, err := utf16le.Encode(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pw) > 512 {
+		return nil, fmt.Errorf("password is too long")
+	}
+
+	b, err := nonce(516)
+	if err != nil {
+		return nil, err
+	}
+
+	copy(b[512-len(pw):], pw)
+	binary.LittleEndian.PutUint32(b[512:], uint32(len(pw)))
+
+	return b, nil
+}
+
+// newPasswordBuffer514 function returns the 514-byte password buffer: the
+// password length is followed by the password and the random padding.
+func newPasswordBuffer514(password string) ([]byte, error) {
+
+	pw, err := utf16le.Encode(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pw) > 512 {
+		return nil, fmt.Errorf("password is too long")
+	}
+
+	b, err := nonce(514)
+	if err != nil {
+		return nil, err
+	}
+
+	binary.LittleEndian.PutUint16(b[0:], uint16(len(pw)))
+	copy(b[2:], pw)
+
+	return b, nil
+}
@@ -0,0 +1,129 @@
+package samr
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+func TestEncryptPassword(t *testing.T) {
+
+	key := []byte("0123456789abcdef")
+
+	pw, _ := utf16le.Encode("Password1!")
+
+	t.Run("rc4", func(t *testing.T) {
+
+		enc, err := EncryptUserPasswordNew(key, "Password1!")
+		if err != nil {
+			t.Fatalf("encrypt_user_password_new: %v", err)
+		}
+
+		if len(enc.Buffer) != 532 {
+			t.Fatalf("encrypt_user_password_new: invalid length %d", len(enc.Buffer))
+		}
+
+		rc4Key, _ := crypto.MD5(enc.Buffer[516:], key)
+		b := append([]byte{}, enc.Buffer[:516]...)
+		if err := crypto.RC4K(rc4Key, b); err != nil {
+			t.Fatalf("encrypt_user_password_new: decrypt: %v", err)
+		}
+
+		l := binary.LittleEndian.Uint32(b[512:])
+		if !bytes.Equal(b[512-l:512], pw) {
+			t.Errorf("encrypt_user_password_new: password mismatch")
+		}
+	})
+
+	t.Run("aes", func(t *testing.T) {
+
+		enc, err := EncryptPasswordAES(key, nil, 0, "Password1!")
+		if err != nil {
+			t.Fatalf("encrypt_password_aes: %v", err)
+		}
+
+		macKey, _ := crypto.HMAC(key, sha512.New, aesMACKeyLabel)
+		authData, _ := crypto.HMAC(macKey, sha512.New, []byte{0x01}, enc.Salt, enc.Cipher, []byte{0x01})
+		if !bytes.Equal(authData, enc.AuthData) {
+			t.Fatalf("encrypt_password_aes: auth data mismatch")
+		}
+
+		encKey, _ := crypto.HMAC(key, sha512.New, aesEncryptionKeyLabel)
+		block, _ := aes.NewCipher(encKey[:32])
+
+		b := append([]byte{}, enc.Cipher...)
+		cipher.NewCBCDecrypter(block, enc.Salt).CryptBlocks(b, b)
+
+		l := binary.LittleEndian.Uint16(b)
+		if !bytes.Equal(b[2:2+l], pw) {
+			t.Errorf("encrypt_password_aes: password mismatch")
+		}
+	})
+}
+
+func TestPasswordKnownAnswer(t *testing.T) {
+
+	// the password buffers and the salts are filled with 00 01 02 ... 0f 10 ...
+	defer func(fn func(int) ([]byte, error)) { nonce = fn }(nonce)
+	nonce = func(n int) ([]byte, error) {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte(i)
+		}
+		return b, nil
+	}
+
+	key, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	unhex := func(s string) []byte {
+		b, _ := hex.DecodeString(s)
+		return b
+	}
+
+	// the well-known NT hash of "password".
+	ntowf, err := NTOWF("password")
+	if err != nil || !bytes.Equal(ntowf, unhex("8846f7eaee8fb117ad06bdd830b7586c")) {
+		t.Errorf("ntowf: unexpected hash %x, %v", ntowf, err)
+	}
+
+	// the DES encryption of "KGS!@#$%" with the zero key is the well-known
+	// LM hash of the empty password.
+	enc, err := EncryptNTOWFPassword(make([]byte, 16), []byte("KGS!@#$%KGS!@#$%"))
+	if err != nil || !bytes.Equal(enc.Data, unhex("aad3b435b51404eeaad3b435b51404ee")) {
+		t.Errorf("encrypt_ntowf_password: unexpected data %x, %v", enc.Data, err)
+	}
+
+	// RC4(MD5(salt | key)) of the password, length and salt (SAMPR_ENCRYPTED_USER_PASSWORD_NEW).
+	encNew, err := EncryptUserPasswordNew(key, "Password1!")
+	if err != nil || !bytes.Equal(encNew.Buffer[492:], unhex("c31a34b723062904476ff7dde3c4f35cbca5240f908c88a8000102030405060708090a0b0c0d0e0f")) {
+		t.Errorf("encrypt_user_password_new: unexpected buffer %x, %v", encNew.Buffer[492:], err)
+	}
+
+	// AEAD-AES-256-CBC-HMAC-SHA512 (MS-SAMR 3.2.2.4).
+	encAES, err := EncryptPasswordAES(key, nil, 0, "Password1!")
+	if err != nil {
+		t.Fatalf("encrypt_password_aes: %v", err)
+	}
+
+	if encAES.CipherLength != 528 || !bytes.Equal(encAES.Cipher[:32], unhex("87e569da61b6884feb4446adfc11786a6b6973d309fdd4e5e9d00bfa8b886814")) {
+		t.Errorf("encrypt_password_aes: unexpected cipher %x", encAES.Cipher[:32])
+	}
+
+	if !bytes.Equal(encAES.AuthData, unhex("5fe6ff9921e8c438fbdf102fd5040b025059d601559c784a1d8f0a5bc4402232"+
+		"b955761362e93f6e554359341aacc6bfdbab5ebd726deb17e053c81e459d57ca")) {
+		t.Errorf("encrypt_password_aes: unexpected auth data %x", encAES.AuthData)
+	}
+
+	// PBKDF2-HMAC-SHA512 of the old NT hash (SamrUnicodeChangePasswordUser4).
+	cek, err := ChangePasswordKey(ntowf, key, DefaultPBKDF2Iterations)
+	if err != nil || !bytes.Equal(cek, unhex("696ed011e7dfef6d1e3239bda43c3944")) {
+		t.Errorf("change_password_key: unexpected key %x, %v", cek, err)
+	}
+}
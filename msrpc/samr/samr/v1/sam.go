@@ -0,0 +1,510 @@
+package samr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/ntstatus"
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+)
+
+// Server is the SAM server object. Server wraps the SAMR client and
+// the server handle, and provides access to the domains and password
+// change operations.
+//
+//	srv, err := samr.NewServer(ctx, cli, "")
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer srv.Close(ctx)
+//
+//	dom, err := srv.OpenDomain(ctx, "DOMAIN")
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer dom.Close(ctx)
+//
+//	users, err := dom.Users(ctx, samr.UserNormalAccount)
+type Server struct {
+	// The SAMR client.
+	Client SamrClient
+	// The server name.
+	ServerName string
+	// The server handle.
+	Handle *Handle
+	// The features supported by the server (returned by SamrConnect5).
+	SupportedFeatures uint32
+}
+
+// NewServer function connects to the SAM server using SamrConnect5, or
+// SamrConnect if the former is not supported by the server.
+func NewServer(ctx context.Context, cli SamrClient, serverName string) (*Server, error) {
+
+	srv := &Server{Client: cli, ServerName: serverName}
+
+	resp, err := cli.Connect5(ctx, &Connect5Request{
+		ServerName:    serverName,
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+		InVersion:     1,
+		InRevisionInfo: &RevisionInfo{
+			Value: &RevisionInfo_V1{V1: &RevisionInfoV1{Revision: 3}},
+		},
+	})
+	if err == nil {
+		if v1, ok := resp.OutRevisionInfo.GetValue().(*RevisionInfoV1); ok && v1 != nil {
+			srv.SupportedFeatures = v1.SupportedFeatures
+		}
+		srv.Handle = resp.Server
+		return srv, nil
+	}
+
+	// fallback to the SamrConnect.
+	resp0, err0 := cli.Connect(ctx, &ConnectRequest{
+		ServerName:    serverName,
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+	})
+	if err0 != nil {
+		return nil, fmt.Errorf("samr: connect: %w", errors.Join(err, err0))
+	}
+
+	srv.Handle = resp0.Server
+	return srv, nil
+}
+
+// SupportsAES function returns true if the server supports the AES
+// password encryption.
+func (o *Server) SupportsAES() bool {
+	return o.SupportedFeatures&SupportedFeatureAES != 0
+}
+
+// Domains function returns the list of the domain names hosted by the server.
+func (o *Server) Domains(ctx context.Context) ([]string, error) {
+
+	var domains []string
+
+	err := enumerate(func(enum uint32) (uint32, *EnumerationBuffer, uint32, error) {
+		resp, err := o.Client.EnumerateDomainsInSAMServer(ctx, &EnumerateDomainsInSAMServerRequest{
+			Server:             o.Handle,
+			EnumerationContext: enum,
+		})
+		if resp == nil {
+			return 0, nil, 0, err
+		}
+		return resp.EnumerationContext, resp.Buffer, resp.CountReturned, err
+	}, func(e *RIDEnumeration) {
+		domains = append(domains, unicodeString(e.Name))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: enumerate domains: %w", err)
+	}
+
+	return domains, nil
+}
+
+// OpenDomain function looks up the domain SID and opens the domain.
+func (o *Server) OpenDomain(ctx context.Context, name string) (*Domain, error) {
+
+	resp, err := o.Client.LookupDomainInSAMServer(ctx, &LookupDomainInSAMServerRequest{
+		Server: o.Handle,
+		Name:   &dtyp.UnicodeString{Buffer: name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: lookup domain: %w", err)
+	}
+
+	return o.OpenDomainBySID(ctx, name, resp.DomainID)
+}
+
+// OpenBuiltinDomain function opens the builtin domain.
+func (o *Server) OpenBuiltinDomain(ctx context.Context) (*Domain, error) {
+	return o.OpenDomain(ctx, "Builtin")
+}
+
+// OpenDomainBySID function opens the domain with the SID.
+func (o *Server) OpenDomainBySID(ctx context.Context, name string, sid *dtyp.SID) (*Domain, error) {
+
+	resp, err := o.Client.OpenDomain(ctx, &OpenDomainRequest{
+		Server:        o.Handle,
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+		DomainID:      sid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: open domain: %w", err)
+	}
+
+	return &Domain{server: o, Name: name, SID: sid, Handle: resp.Domain}, nil
+}
+
+// ChangePassword function changes the user password. The SamrUnicodeChangePasswordUser4
+// is used if the server supports the AES encryption, otherwise SamrUnicodeChangePasswordUser2
+// is used.
+func (o *Server) ChangePassword(ctx context.Context, userName, oldPassword, newPassword string) error {
+
+	oldNTOWF, err := NTOWF(oldPassword)
+	if err != nil {
+		return fmt.Errorf("samr: change password: %w", err)
+	}
+
+	if o.SupportsAES() {
+		if err = o.changePasswordAES(ctx, userName, oldNTOWF, newPassword); err != nil {
+			return fmt.Errorf("samr: change password: %w", err)
+		}
+		return nil
+	}
+
+	newNTOWF, err := NTOWF(newPassword)
+	if err != nil {
+		return fmt.Errorf("samr: change password: %w", err)
+	}
+
+	newPasswordEncrypted, err := EncryptUserPassword(oldNTOWF, newPassword)
+	if err != nil {
+		return fmt.Errorf("samr: change password: %w", err)
+	}
+
+	oldNTOWFEncrypted, err := EncryptNTOWFPassword(newNTOWF, oldNTOWF)
+	if err != nil {
+		return fmt.Errorf("samr: change password: %w", err)
+	}
+
+	if _, err = o.Client.UnicodeChangePasswordUser2(ctx, &UnicodeChangePasswordUser2Request{
+		ServerName:                         o.serverName(),
+		UserName:                           &dtyp.UnicodeString{Buffer: userName},
+		NewPasswordEncryptedWithOldNT:      newPasswordEncrypted,
+		OldNTOWFPasswordEncryptedWithNewNT: oldNTOWFEncrypted,
+	}); err != nil {
+		return fmt.Errorf("samr: change password: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Server) changePasswordAES(ctx context.Context, userName string, oldNTOWF []byte, newPassword string) error {
+
+	salt, err := crypto.Nonce(16)
+	if err != nil {
+		return err
+	}
+
+	cek, err := ChangePasswordKey(oldNTOWF, salt, DefaultPBKDF2Iterations)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := EncryptPasswordAES(cek, salt, DefaultPBKDF2Iterations, newPassword)
+	if err != nil {
+		return err
+	}
+
+	_, err = o.Client.UnicodeChangePasswordUser4(ctx, &UnicodeChangePasswordUser4Request{
+		ServerName:        o.serverName(),
+		UserName:          &dtyp.UnicodeString{Buffer: userName},
+		EncryptedPassword: encrypted,
+	})
+
+	return err
+}
+
+func (o *Server) serverName() *dtyp.UnicodeString {
+	if o.ServerName == "" {
+		return nil
+	}
+	if !strings.HasPrefix(o.ServerName, "\\\\") {
+		return &dtyp.UnicodeString{Buffer: "\\\\" + o.ServerName}
+	}
+	return &dtyp.UnicodeString{Buffer: o.ServerName}
+}
+
+// Close function closes the server handle.
+func (o *Server) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.Client, o.Handle)
+}
+
+// Domain is the SAM domain object.
+type Domain struct {
+	server *Server
+	// The domain name.
+	Name string
+	// The domain SID.
+	SID *dtyp.SID
+	// The domain handle.
+	Handle *Handle
+}
+
+// Server function returns the domain server.
+func (o *Domain) Server() *Server {
+	return o.server
+}
+
+// AccountSID function returns the SID for the account relative identifier.
+func (o *Domain) AccountSID(rid uint32) *dtyp.SID {
+	return o.SID.AddRelativeID(rid)
+}
+
+// Users function returns the list of the users matching the user account control
+// filter (all users if uac is zero).
+func (o *Domain) Users(ctx context.Context, uac uint32) ([]*RIDEnumeration, error) {
+
+	var users []*RIDEnumeration
+
+	err := enumerate(func(enum uint32) (uint32, *EnumerationBuffer, uint32, error) {
+		resp, err := o.server.Client.EnumerateUsersInDomain(ctx, &EnumerateUsersInDomainRequest{
+			Domain:             o.Handle,
+			EnumerationContext: enum,
+			UserAccountControl: uac,
+		})
+		if resp == nil {
+			return 0, nil, 0, err
+		}
+		return resp.EnumerationContext, resp.Buffer, resp.CountReturned, err
+	}, func(e *RIDEnumeration) {
+		users = append(users, e)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: enumerate users: %w", err)
+	}
+
+	return users, nil
+}
+
+// Groups function returns the list of the domain groups.
+func (o *Domain) Groups(ctx context.Context) ([]*RIDEnumeration, error) {
+
+	var groups []*RIDEnumeration
+
+	err := enumerate(func(enum uint32) (uint32, *EnumerationBuffer, uint32, error) {
+		resp, err := o.server.Client.EnumerateGroupsInDomain(ctx, &EnumerateGroupsInDomainRequest{
+			Domain:             o.Handle,
+			EnumerationContext: enum,
+		})
+		if resp == nil {
+			return 0, nil, 0, err
+		}
+		return resp.EnumerationContext, resp.Buffer, resp.CountReturned, err
+	}, func(e *RIDEnumeration) {
+		groups = append(groups, e)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: enumerate groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// Aliases function returns the list of the domain aliases.
+func (o *Domain) Aliases(ctx context.Context) ([]*RIDEnumeration, error) {
+
+	var aliases []*RIDEnumeration
+
+	err := enumerate(func(enum uint32) (uint32, *EnumerationBuffer, uint32, error) {
+		resp, err := o.server.Client.EnumerateAliasesInDomain(ctx, &EnumerateAliasesInDomainRequest{
+			Domain:             o.Handle,
+			EnumerationContext: enum,
+		})
+		if resp == nil {
+			return 0, nil, 0, err
+		}
+		return resp.EnumerationContext, resp.Buffer, resp.CountReturned, err
+	}, func(e *RIDEnumeration) {
+		aliases = append(aliases, e)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: enumerate aliases: %w", err)
+	}
+
+	return aliases, nil
+}
+
+// LookupName function returns the relative identifier and the SID name use
+// for the account name.
+func (o *Domain) LookupName(ctx context.Context, name string) (uint32, uint32, error) {
+
+	resp, err := o.server.Client.LookupNamesInDomain(ctx, &LookupNamesInDomainRequest{
+		Domain: o.Handle,
+		Count:  1,
+		Names:  []*dtyp.UnicodeString{{Buffer: name}},
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("samr: lookup name %q: %w", name, err)
+	}
+
+	if resp.RelativeIDs == nil || len(resp.RelativeIDs.Element) == 0 || resp.Use == nil || len(resp.Use.Element) == 0 {
+		return 0, 0, fmt.Errorf("samr: lookup name %q: %w", name, ntstatus.StatusNoneMapped)
+	}
+
+	return resp.RelativeIDs.Element[0], resp.Use.Element[0], nil
+}
+
+// OpenUser function opens the user with the relative identifier.
+func (o *Domain) OpenUser(ctx context.Context, rid uint32) (*User, error) {
+
+	resp, err := o.server.Client.OpenUser(ctx, &OpenUserRequest{
+		Domain:        o.Handle,
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+		UserID:        rid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: open user: %w", err)
+	}
+
+	return &User{domain: o, RelativeID: rid, Handle: resp.UserHandle}, nil
+}
+
+// OpenUserByName function looks up and opens the user with the name.
+func (o *Domain) OpenUserByName(ctx context.Context, name string) (*User, error) {
+	rid, _, err := o.LookupName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return o.OpenUser(ctx, rid)
+}
+
+// CreateUser function creates the user account. The account type is one of
+// UserNormalAccount, UserWorkstationTrustAccount, UserServerTrustAccount,
+// UserInterdomainTrustAccount. The account is created disabled.
+func (o *Domain) CreateUser(ctx context.Context, name string, accountType uint32) (*User, error) {
+
+	if accountType == 0 {
+		accountType = UserNormalAccount
+	}
+
+	resp, err := o.server.Client.CreateUser2InDomain(ctx, &CreateUser2InDomainRequest{
+		Domain:        o.Handle,
+		Name:          &dtyp.UnicodeString{Buffer: name},
+		AccountType:   accountType,
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: create user: %w", err)
+	}
+
+	return &User{domain: o, RelativeID: resp.RelativeID, Handle: resp.UserHandle}, nil
+}
+
+// OpenGroup function opens the group with the relative identifier.
+func (o *Domain) OpenGroup(ctx context.Context, rid uint32) (*Group, error) {
+
+	resp, err := o.server.Client.OpenGroup(ctx, &OpenGroupRequest{
+		Domain:        o.Handle,
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+		GroupID:       rid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: open group: %w", err)
+	}
+
+	return &Group{domain: o, RelativeID: rid, Handle: resp.GroupHandle}, nil
+}
+
+// OpenGroupByName function looks up and opens the group with the name.
+func (o *Domain) OpenGroupByName(ctx context.Context, name string) (*Group, error) {
+	rid, _, err := o.LookupName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return o.OpenGroup(ctx, rid)
+}
+
+// CreateGroup function creates the group.
+func (o *Domain) CreateGroup(ctx context.Context, name string) (*Group, error) {
+
+	resp, err := o.server.Client.CreateGroupInDomain(ctx, &CreateGroupInDomainRequest{
+		Domain:        o.Handle,
+		Name:          &dtyp.UnicodeString{Buffer: name},
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: create group: %w", err)
+	}
+
+	return &Group{domain: o, RelativeID: resp.RelativeID, Handle: resp.GroupHandle}, nil
+}
+
+// OpenAlias function opens the alias with the relative identifier.
+func (o *Domain) OpenAlias(ctx context.Context, rid uint32) (*Alias, error) {
+
+	resp, err := o.server.Client.OpenAlias(ctx, &OpenAliasRequest{
+		Domain:        o.Handle,
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+		AliasID:       rid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: open alias: %w", err)
+	}
+
+	return &Alias{domain: o, RelativeID: rid, Handle: resp.AliasHandle}, nil
+}
+
+// OpenAliasByName function looks up and opens the alias with the name.
+func (o *Domain) OpenAliasByName(ctx context.Context, name string) (*Alias, error) {
+	rid, _, err := o.LookupName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return o.OpenAlias(ctx, rid)
+}
+
+// CreateAlias function creates the alias.
+func (o *Domain) CreateAlias(ctx context.Context, name string) (*Alias, error) {
+
+	resp, err := o.server.Client.CreateAliasInDomain(ctx, &CreateAliasInDomainRequest{
+		Domain:        o.Handle,
+		AccountName:   &dtyp.UnicodeString{Buffer: name},
+		DesiredAccess: dtyp.AccessMaskMaximumAllowed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: create alias: %w", err)
+	}
+
+	return &Alias{domain: o, RelativeID: resp.RelativeID, Handle: resp.AliasHandle}, nil
+}
+
+// Close function closes the domain handle.
+func (o *Domain) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.server.Client, o.Handle)
+}
+
+// enumerate function calls the enumeration method until the enumeration
+// context is exhausted. STATUS_MORE_ENTRIES is not treated as an error.
+func enumerate(fn func(uint32) (uint32, *EnumerationBuffer, uint32, error), each func(*RIDEnumeration)) error {
+
+	for enum := uint32(0); ; {
+
+		next, buf, count, err := fn(enum)
+		if err != nil && !errors.Is(err, ntstatus.StatusMoreEntries) {
+			return err
+		}
+
+		if buf != nil {
+			for _, e := range buf.Buffer {
+				each(e)
+			}
+		}
+
+		if err == nil || next == 0 || count == 0 {
+			return nil
+		}
+
+		enum = next
+	}
+}
+
+func closeHandle(ctx context.Context, cli SamrClient, h *Handle) error {
+	if h == nil {
+		return nil
+	}
+	if _, err := cli.CloseHandle(ctx, &CloseHandleRequest{SAMHandle: h}); err != nil {
+		return fmt.Errorf("samr: close handle: %w", err)
+	}
+	return nil
+}
+
+func unicodeString(s *dtyp.UnicodeString) string {
+	if s == nil {
+		return ""
+	}
+	return s.Buffer
+}
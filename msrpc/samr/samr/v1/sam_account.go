@@ -0,0 +1,372 @@
+package samr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+)
+
+// User is the SAM user object.
+type User struct {
+	domain *Domain
+	// The user relative identifier.
+	RelativeID uint32
+	// The user handle.
+	Handle *Handle
+}
+
+// Domain function returns the user domain.
+func (o *User) Domain() *Domain {
+	return o.domain
+}
+
+// SID function returns the user SID.
+func (o *User) SID() *dtyp.SID {
+	return o.domain.AccountSID(o.RelativeID)
+}
+
+// Info function returns the user information (UserAllInformation).
+func (o *User) Info(ctx context.Context) (*UserAllInformation, error) {
+
+	resp, err := o.domain.server.Client.QueryInformationUser2(ctx, &QueryInformationUser2Request{
+		UserHandle:           o.Handle,
+		UserInformationClass: UserInformationClassAllInformation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: query user information: %w", err)
+	}
+
+	info, ok := resp.Buffer.GetValue().(*UserAllInformation)
+	if !ok || info == nil {
+		return nil, fmt.Errorf("samr: query user information: unexpected information %T", resp.Buffer.GetValue())
+	}
+
+	return info, nil
+}
+
+// AccountControl function returns the user account control flags.
+func (o *User) AccountControl(ctx context.Context) (uint32, error) {
+
+	resp, err := o.domain.server.Client.QueryInformationUser2(ctx, &QueryInformationUser2Request{
+		UserHandle:           o.Handle,
+		UserInformationClass: UserInformationClassControlInformation,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("samr: query user account control: %w", err)
+	}
+
+	info, ok := resp.Buffer.GetValue().(*UserControlInformation)
+	if !ok || info == nil {
+		return 0, fmt.Errorf("samr: query user account control: unexpected information %T", resp.Buffer.GetValue())
+	}
+
+	return info.UserAccountControl, nil
+}
+
+// SetAccountControl function sets the user account control flags.
+func (o *User) SetAccountControl(ctx context.Context, uac uint32) error {
+
+	if _, err := o.domain.server.Client.SetInformationUser2(ctx, &SetInformationUser2Request{
+		UserHandle:           o.Handle,
+		UserInformationClass: UserInformationClassControlInformation,
+		Buffer: &UserInfoBuffer{
+			Value: &UserInfoBuffer_Control{Control: &UserControlInformation{UserAccountControl: uac}},
+		},
+	}); err != nil {
+		return fmt.Errorf("samr: set user account control: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAccountControl function sets the flags in set and clears the flags in
+// clear, leaving the rest of the user account control flags intact.
+//
+//	// enable the account.
+//	err := user.UpdateAccountControl(ctx, 0, samr.UserAccountDisabled)
+func (o *User) UpdateAccountControl(ctx context.Context, set, clear uint32) error {
+
+	uac, err := o.AccountControl(ctx)
+	if err != nil {
+		return err
+	}
+
+	return o.SetAccountControl(ctx, (uac|set)&^clear)
+}
+
+// SetPassword function sets the user password. The password is encrypted with
+// the AES (UserInternal7Information) if the server supports it, otherwise
+// RC4 (UserInternal5InformationNew) is used. The session key is taken from the
+// connection security context.
+func (o *User) SetPassword(ctx context.Context, password string, expired bool) error {
+
+	cli := o.domain.server.Client
+
+	key, ok := gssapi.GetEffectiveSessionKey(cli.Conn().Context())
+	if !ok {
+		return fmt.Errorf("samr: set password: unable to get session key")
+	}
+
+	req := &SetInformationUser2Request{
+		UserHandle: o.Handle,
+	}
+
+	if o.domain.server.SupportsAES() {
+
+		encrypted, err := EncryptPasswordAES(key, nil, 0, password)
+		if err != nil {
+			return fmt.Errorf("samr: set password: %w", err)
+		}
+
+		req.UserInformationClass = UserInformationClassInternal7Information
+		req.Buffer = &UserInfoBuffer{
+			Value: &UserInfoBuffer_Internal7{
+				Internal7: &UserInternal7Information{UserPassword: encrypted, PasswordExpired: expired},
+			},
+		}
+
+	} else {
+
+		encrypted, err := EncryptUserPasswordNew(key, password)
+		if err != nil {
+			return fmt.Errorf("samr: set password: %w", err)
+		}
+
+		var pwdExpired uint8
+		if expired {
+			pwdExpired = 1
+		}
+
+		req.UserInformationClass = UserInformationClassInternal5InformationNew
+		req.Buffer = &UserInfoBuffer{
+			Value: &UserInfoBuffer_Internal5New{
+				Internal5New: &UserInternal5InformationNew{UserPassword: encrypted, PasswordExpired: pwdExpired},
+			},
+		}
+	}
+
+	if _, err := cli.SetInformationUser2(ctx, req); err != nil {
+		return fmt.Errorf("samr: set password: %w", err)
+	}
+
+	return nil
+}
+
+// Groups function returns the groups the user is a member of.
+func (o *User) Groups(ctx context.Context) ([]*GroupMembership, error) {
+
+	resp, err := o.domain.server.Client.GetGroupsForUser(ctx, &GetGroupsForUserRequest{
+		UserHandle: o.Handle,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: get groups for user: %w", err)
+	}
+
+	if resp.Groups == nil {
+		return nil, nil
+	}
+
+	return resp.Groups.Groups, nil
+}
+
+// Delete function deletes the user. The user handle is closed by the server.
+func (o *User) Delete(ctx context.Context) error {
+
+	if _, err := o.domain.server.Client.DeleteUser(ctx, &DeleteUserRequest{
+		UserHandle: o.Handle,
+	}); err != nil {
+		return fmt.Errorf("samr: delete user: %w", err)
+	}
+
+	o.Handle = nil
+	return nil
+}
+
+// Close function closes the user handle.
+func (o *User) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.domain.server.Client, o.Handle)
+}
+
+// Group is the SAM group object.
+type Group struct {
+	domain *Domain
+	// The group relative identifier.
+	RelativeID uint32
+	// The group handle.
+	Handle *Handle
+}
+
+// Domain function returns the group domain.
+func (o *Group) Domain() *Domain {
+	return o.domain
+}
+
+// SID function returns the group SID.
+func (o *Group) SID() *dtyp.SID {
+	return o.domain.AccountSID(o.RelativeID)
+}
+
+// Members function returns the group members.
+func (o *Group) Members(ctx context.Context) ([]*GroupMembership, error) {
+
+	resp, err := o.domain.server.Client.GetMembersInGroup(ctx, &GetMembersInGroupRequest{
+		GroupHandle: o.Handle,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: get members in group: %w", err)
+	}
+
+	if resp.Members == nil {
+		return nil, nil
+	}
+
+	members := make([]*GroupMembership, len(resp.Members.Members))
+	for i := range resp.Members.Members {
+		members[i] = &GroupMembership{RelativeID: resp.Members.Members[i]}
+		if i < len(resp.Members.Attributes) {
+			members[i].Attributes = resp.Members.Attributes[i]
+		}
+	}
+
+	return members, nil
+}
+
+// AddMember function adds the account with the relative identifier to the group.
+// The default attributes (mandatory, enabled by default, enabled) are used if
+// attributes is zero.
+func (o *Group) AddMember(ctx context.Context, rid uint32, attributes uint32) error {
+
+	if attributes == 0 {
+		attributes = GroupMandatory | GroupEnabledByDefault | GroupEnabled
+	}
+
+	if _, err := o.domain.server.Client.AddMemberToGroup(ctx, &AddMemberToGroupRequest{
+		GroupHandle: o.Handle,
+		MemberID:    rid,
+		Attributes:  attributes,
+	}); err != nil {
+		return fmt.Errorf("samr: add member to group: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveMember function removes the account with the relative identifier from the group.
+func (o *Group) RemoveMember(ctx context.Context, rid uint32) error {
+
+	if _, err := o.domain.server.Client.RemoveMemberFromGroup(ctx, &RemoveMemberFromGroupRequest{
+		GroupHandle: o.Handle,
+		MemberID:    rid,
+	}); err != nil {
+		return fmt.Errorf("samr: remove member from group: %w", err)
+	}
+
+	return nil
+}
+
+// Delete function deletes the group. The group handle is closed by the server.
+func (o *Group) Delete(ctx context.Context) error {
+
+	if _, err := o.domain.server.Client.DeleteGroup(ctx, &DeleteGroupRequest{
+		GroupHandle: o.Handle,
+	}); err != nil {
+		return fmt.Errorf("samr: delete group: %w", err)
+	}
+
+	o.Handle = nil
+	return nil
+}
+
+// Close function closes the group handle.
+func (o *Group) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.domain.server.Client, o.Handle)
+}
+
+// Alias is the SAM alias (local group) object.
+type Alias struct {
+	domain *Domain
+	// The alias relative identifier.
+	RelativeID uint32
+	// The alias handle.
+	Handle *Handle
+}
+
+// Domain function returns the alias domain.
+func (o *Alias) Domain() *Domain {
+	return o.domain
+}
+
+// SID function returns the alias SID.
+func (o *Alias) SID() *dtyp.SID {
+	return o.domain.AccountSID(o.RelativeID)
+}
+
+// Members function returns the SIDs of the alias members.
+func (o *Alias) Members(ctx context.Context) ([]*dtyp.SID, error) {
+
+	resp, err := o.domain.server.Client.GetMembersInAlias(ctx, &GetMembersInAliasRequest{
+		AliasHandle: o.Handle,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("samr: get members in alias: %w", err)
+	}
+
+	if resp.Members == nil {
+		return nil, nil
+	}
+
+	members := make([]*dtyp.SID, 0, len(resp.Members.SIDs))
+	for _, sid := range resp.Members.SIDs {
+		if sid != nil && sid.SIDPointer != nil {
+			members = append(members, sid.SIDPointer)
+		}
+	}
+
+	return members, nil
+}
+
+// AddMember function adds the account SID to the alias.
+func (o *Alias) AddMember(ctx context.Context, sid *dtyp.SID) error {
+
+	if _, err := o.domain.server.Client.AddMemberToAlias(ctx, &AddMemberToAliasRequest{
+		AliasHandle: o.Handle,
+		MemberID:    sid,
+	}); err != nil {
+		return fmt.Errorf("samr: add member to alias: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveMember function removes the account SID from the alias.
+func (o *Alias) RemoveMember(ctx context.Context, sid *dtyp.SID) error {
+
+	if _, err := o.domain.server.Client.RemoveMemberFromAlias(ctx, &RemoveMemberFromAliasRequest{
+		AliasHandle: o.Handle,
+		MemberID:    sid,
+	}); err != nil {
+		return fmt.Errorf("samr: remove member from alias: %w", err)
+	}
+
+	return nil
+}
+
+// Delete function deletes the alias. The alias handle is closed by the server.
+func (o *Alias) Delete(ctx context.Context) error {
+
+	if _, err := o.domain.server.Client.DeleteAlias(ctx, &DeleteAliasRequest{
+		AliasHandle: o.Handle,
+	}); err != nil {
+		return fmt.Errorf("samr: delete alias: %w", err)
+	}
+
+	o.Handle = nil
+	return nil
+}
+
+// Close function closes the alias handle.
+func (o *Alias) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.domain.server.Client, o.Handle)
+}
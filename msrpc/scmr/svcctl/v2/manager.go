@@ -0,0 +1,339 @@
+package svcctl
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/erref/win32"
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+// The maximum buffer size accepted by RQueryServiceConfigW, RQueryServiceConfig2W
+// and RQueryServiceStatusEx.
+const maxConfigBufferSize = 0x2000
+
+// The maximum buffer size accepted by REnumServicesStatusExW and
+// RQueryServiceObjectSecurity.
+const maxEnumBufferSize = 0x40000
+
+// The size of the ENUM_SERVICE_STATUS_PROCESSW structure within the
+// REnumServicesStatusExW buffer.
+const enumServiceStatusProcessSize = 44
+
+// Manager is the service control manager object. Manager wraps the svcctl
+// client and the service control manager handle.
+//
+//	scm, err := svcctl.NewManager(ctx, cli, "")
+//	if err != nil {
+//		// handle error.
+//	}
+//	defer scm.Close(ctx)
+//
+//	svcs, err := scm.Services(ctx, svcctl.ServiceWin32, svcctl.ServiceStateAll)
+type Manager struct {
+	// The svcctl client.
+	Client SvcctlClient
+	// The service control manager handle.
+	Handle *Handle
+}
+
+// ServiceConfig is the service configuration.
+type ServiceConfig struct {
+	// The service type.
+	ServiceType uint32 `json:"service_type"`
+	// The service start type.
+	StartType uint32 `json:"start_type"`
+	// The service error control.
+	ErrorControl uint32 `json:"error_control"`
+	// The service binary path name.
+	BinaryPathName string `json:"binary_path_name"`
+	// The load ordering group.
+	LoadOrderGroup string `json:"load_order_group"`
+	// The tag identifier within the load ordering group.
+	TagID uint32 `json:"tag_id"`
+	// The names of the services and load ordering groups (prefixed with '+')
+	// the service depends on.
+	Dependencies []string `json:"dependencies"`
+	// The account name the service runs under.
+	ServiceStartName string `json:"service_start_name"`
+	// The service display name.
+	DisplayName string `json:"display_name"`
+	// The account password. The password is never returned by the server.
+	Password string `json:"-"`
+}
+
+// NewManager function opens the service control manager on the machine with
+// the maximum allowed access.
+func NewManager(ctx context.Context, cli SvcctlClient, machineName string) (*Manager, error) {
+	return NewManagerWithAccess(ctx, cli, machineName, dtyp.AccessMaskMaximumAllowed)
+}
+
+// NewManagerWithAccess function opens the service control manager on the machine
+// with the desired access.
+func NewManagerWithAccess(ctx context.Context, cli SvcctlClient, machineName string, access uint32) (*Manager, error) {
+
+	resp, err := cli.OpenSCMW(ctx, &OpenSCMWRequest{
+		MachineName:   machineName,
+		DatabaseName:  "ServicesActive",
+		DesiredAccess: access,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: open service manager: %w", err)
+	}
+
+	return &Manager{Client: cli, Handle: resp.SCM}, nil
+}
+
+// Close function closes the service control manager handle.
+func (o *Manager) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.Client, o.Handle)
+}
+
+// Services function returns the status of the services with the service type
+// and the state (ServiceActive, ServiceInactive or ServiceStateAll).
+func (o *Manager) Services(ctx context.Context, serviceType, state uint32) ([]*EnumServiceStatusProcessW, error) {
+	return o.GroupServices(ctx, serviceType, state, "")
+}
+
+// GroupServices function returns the status of the services that belong to the
+// load ordering group. All services are returned if group is empty.
+func (o *Manager) GroupServices(ctx context.Context, serviceType, state uint32, group string) ([]*EnumServiceStatusProcessW, error) {
+
+	var (
+		svcs   []*EnumServiceStatusProcessW
+		size   = uint32(0x4000)
+		resume uint32
+	)
+
+	for {
+
+		resp, err := o.Client.EnumServicesStatusExW(ctx, &EnumServicesStatusExWRequest{
+			ServiceManager: o.Handle,
+			InfoLevel:      EnumTypeProcessInfo,
+			ServiceType:    serviceType,
+			ServiceState:   state,
+			BufferLength:   size,
+			ResumeIndex:    resume,
+			GroupName:      group,
+		})
+		if err != nil && !isMoreData(err) {
+			return nil, fmt.Errorf("svcctl: enumerate services: %w", err)
+		}
+
+		page, perr := parseEnumServiceStatusProcess(resp.Buffer, resp.ServicesReturned)
+		if perr != nil {
+			return nil, fmt.Errorf("svcctl: enumerate services: %w", perr)
+		}
+
+		svcs = append(svcs, page...)
+
+		if err == nil {
+			break
+		}
+
+		if len(page) == 0 {
+			// the buffer is too small for a single entry.
+			if size >= maxEnumBufferSize {
+				return nil, fmt.Errorf("svcctl: enumerate services: %w", err)
+			}
+			size = min(max(resp.BytesNeededLength, size*2), maxEnumBufferSize)
+		}
+
+		resume = resp.ResumeIndex
+	}
+
+	return svcs, nil
+}
+
+// OpenService function opens the service with the maximum allowed access.
+func (o *Manager) OpenService(ctx context.Context, name string) (*Service, error) {
+	return o.OpenServiceWithAccess(ctx, name, dtyp.AccessMaskMaximumAllowed)
+}
+
+// OpenServiceWithAccess function opens the service with the desired access.
+func (o *Manager) OpenServiceWithAccess(ctx context.Context, name string, access uint32) (*Service, error) {
+
+	resp, err := o.Client.OpenServiceW(ctx, &OpenServiceWRequest{
+		ServiceManager: o.Handle,
+		ServiceName:    name,
+		DesiredAccess:  access,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: open service: %w", err)
+	}
+
+	return &Service{manager: o, Name: name, Handle: resp.Service}, nil
+}
+
+// CreateService function creates the service with the configuration and returns
+// the service opened with the full access. The password, if any, is encrypted
+// with the session key.
+//
+//	svc, err := scm.CreateService(ctx, "svc", &svcctl.ServiceConfig{
+//		ServiceType:    svcctl.ServiceWin32OwnProcess,
+//		StartType:      svcctl.ServiceDemandStart,
+//		ErrorControl:   svcctl.ServiceErrorNormal,
+//		BinaryPathName: `C:\Windows\svc.exe`,
+//	})
+func (o *Manager) CreateService(ctx context.Context, name string, config *ServiceConfig) (*Service, error) {
+
+	req := &CreateServiceWRequest{
+		ServiceManager:   o.Handle,
+		ServiceName:      name,
+		DisplayName:      config.DisplayName,
+		DesiredAccess:    ServiceAllAccess,
+		ServiceType:      config.ServiceType,
+		StartType:        config.StartType,
+		ErrorControl:     config.ErrorControl,
+		BinaryPathName:   config.BinaryPathName,
+		LoadOrderGroup:   config.LoadOrderGroup,
+		TagID:            config.TagID,
+		ServiceStartName: config.ServiceStartName,
+	}
+
+	var err error
+
+	if req.Dependencies, err = encodeMultiString(config.Dependencies); err != nil {
+		return nil, fmt.Errorf("svcctl: create service: %w", err)
+	}
+
+	if req.Password, err = o.encryptPassword(config.Password); err != nil {
+		return nil, fmt.Errorf("svcctl: create service: %w", err)
+	}
+
+	req.DependSize, req.PasswordSize = uint32(len(req.Dependencies)), uint32(len(req.Password))
+
+	resp, err := o.Client.CreateServiceW(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: create service: %w", err)
+	}
+
+	return &Service{manager: o, Name: name, Handle: resp.Service}, nil
+}
+
+// encryptPassword function encrypts the NUL-terminated password with the
+// session key as specified in MS-LSAD 5.1.2. The nil value is returned for
+// the empty password.
+func (o *Manager) encryptPassword(password string) ([]byte, error) {
+
+	if password == "" {
+		return nil, nil
+	}
+
+	key, ok := gssapi.GetEffectiveSessionKey(o.Client.Conn().Context())
+	if !ok {
+		return nil, fmt.Errorf("unable to get session key")
+	}
+
+	b, err := utf16le.Encode(password + "\x00")
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.EncryptSecret(key, b)
+}
+
+// parseEnumServiceStatusProcess function parses the ENUM_SERVICE_STATUS_PROCESSW
+// array returned by REnumServicesStatusExW. The string pointers are encoded
+// as offsets from the beginning of the buffer.
+func parseEnumServiceStatusProcess(b []byte, n uint32) ([]*EnumServiceStatusProcessW, error) {
+
+	if uint64(n)*enumServiceStatusProcessSize > uint64(len(b)) {
+		return nil, fmt.Errorf("invalid buffer length")
+	}
+
+	svcs := make([]*EnumServiceStatusProcessW, 0, n)
+
+	for i := 0; i < int(n); i++ {
+
+		e := b[i*enumServiceStatusProcessSize:]
+
+		name, err := stringAt(b, binary.LittleEndian.Uint32(e[0:]))
+		if err != nil {
+			return nil, err
+		}
+
+		displayName, err := stringAt(b, binary.LittleEndian.Uint32(e[4:]))
+		if err != nil {
+			return nil, err
+		}
+
+		svcs = append(svcs, &EnumServiceStatusProcessW{
+			ServiceName:          name,
+			DisplayName:          displayName,
+			ServiceStatusProcess: parseServiceStatusProcess(e[8:]),
+		})
+	}
+
+	return svcs, nil
+}
+
+// parseServiceStatusProcess function parses the SERVICE_STATUS_PROCESS structure.
+func parseServiceStatusProcess(b []byte) *ServiceStatusProcess {
+	return &ServiceStatusProcess{
+		ServiceType:             binary.LittleEndian.Uint32(b[0:]),
+		CurrentState:            binary.LittleEndian.Uint32(b[4:]),
+		ControlsAccepted:        binary.LittleEndian.Uint32(b[8:]),
+		Win32ExitCode:           binary.LittleEndian.Uint32(b[12:]),
+		ServiceSpecificExitCode: binary.LittleEndian.Uint32(b[16:]),
+		CheckPoint:              binary.LittleEndian.Uint32(b[20:]),
+		WaitHint:                binary.LittleEndian.Uint32(b[24:]),
+		ProcessID:               binary.LittleEndian.Uint32(b[28:]),
+		ServiceFlags:            binary.LittleEndian.Uint32(b[32:]),
+	}
+}
+
+// stringAt function returns the NUL-terminated UTF-16 string at the offset.
+// The empty string is returned for the zero offset.
+func stringAt(b []byte, off uint32) (string, error) {
+
+	if off == 0 {
+		return "", nil
+	}
+
+	if uint64(off) > uint64(len(b)) {
+		return "", fmt.Errorf("invalid string offset")
+	}
+
+	s := b[off:]
+	for i := 0; i+1 < len(s); i += 2 {
+		if s[i] == 0 && s[i+1] == 0 {
+			return utf16le.Decode(s[:i])
+		}
+	}
+
+	return utf16le.Decode(s[:len(s)&^1])
+}
+
+// encodeMultiString function encodes the strings as the double NUL-terminated
+// UTF-16 string list. The nil value is returned for the empty list.
+func encodeMultiString(ss []string) ([]byte, error) {
+
+	if len(ss) == 0 {
+		return nil, nil
+	}
+
+	return utf16le.Encode(strings.Join(ss, "\x00") + "\x00\x00")
+}
+
+// isMoreData function returns true if the error indicates that the buffer
+// is too small.
+func isMoreData(err error) bool {
+	return errors.Is(err, win32.ErrorMoreData) || errors.Is(err, win32.ErrorInsufficientBuffer)
+}
+
+func closeHandle(ctx context.Context, cli SvcctlClient, h *Handle) error {
+	if h == nil {
+		return nil
+	}
+	if _, err := cli.CloseService(ctx, &CloseServiceRequest{ServiceObject: h}); err != nil {
+		return fmt.Errorf("svcctl: close handle: %w", err)
+	}
+	return nil
+}
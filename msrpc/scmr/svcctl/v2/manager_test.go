@@ -0,0 +1,46 @@
+package svcctl
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/text/encoding/utf16le"
+)
+
+func TestParseEnumServiceStatusProcess(t *testing.T) {
+
+	names := []string{"svc0", "Service 0", "svc1", "Service 1"}
+
+	b := make([]byte, 2*enumServiceStatusProcessSize)
+	for i, name := range names {
+		binary.LittleEndian.PutUint32(b[(i/2)*enumServiceStatusProcessSize+(i%2)*4:], uint32(len(b)))
+		s, _ := utf16le.Encode(name + "\x00")
+		b = append(b, s...)
+	}
+
+	binary.LittleEndian.PutUint32(b[8+4:], ServiceRunning)
+	binary.LittleEndian.PutUint32(b[enumServiceStatusProcessSize+8+4:], ServiceStopped)
+
+	svcs, err := parseEnumServiceStatusProcess(b, 2)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if len(svcs) != 2 {
+		t.Fatalf("parse: expected 2 services, got %d", len(svcs))
+	}
+
+	for i, svc := range svcs {
+		if svc.ServiceName != names[i*2] || svc.DisplayName != names[i*2+1] {
+			t.Errorf("parse: unexpected names %q, %q", svc.ServiceName, svc.DisplayName)
+		}
+	}
+
+	if svcs[0].ServiceStatusProcess.CurrentState != ServiceRunning || svcs[1].ServiceStatusProcess.CurrentState != ServiceStopped {
+		t.Errorf("parse: unexpected states")
+	}
+
+	if _, err := parseEnumServiceStatusProcess(b[:enumServiceStatusProcessSize], 2); err == nil {
+		t.Errorf("parse: expected error for the short buffer")
+	}
+}
@@ -0,0 +1,332 @@
+package svcctl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultWaitInterval is the default service state polling interval.
+const DefaultWaitInterval = time.Second
+
+// Service is the service object.
+type Service struct {
+	manager *Manager
+	// The service name.
+	Name string
+	// The service handle.
+	Handle *Handle
+}
+
+// Manager function returns the service control manager.
+func (o *Service) Manager() *Manager {
+	return o.manager
+}
+
+// Status function returns the service status.
+func (o *Service) Status(ctx context.Context) (*ServiceStatusProcess, error) {
+
+	resp, err := o.manager.Client.QueryServiceStatusEx(ctx, &QueryServiceStatusExRequest{
+		Service:      o.Handle,
+		InfoLevel:    StatusTypeProcessInfo,
+		BufferLength: 36,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: query service status: %w", err)
+	}
+
+	if len(resp.Buffer) < 36 {
+		return nil, fmt.Errorf("svcctl: query service status: invalid buffer length")
+	}
+
+	return parseServiceStatusProcess(resp.Buffer), nil
+}
+
+// Config function returns the service configuration.
+func (o *Service) Config(ctx context.Context) (*ServiceConfig, error) {
+
+	var resp *QueryServiceConfigWResponse
+
+	err := grow(0x400, maxConfigBufferSize, func(size uint32) (uint32, error) {
+		var err error
+		if resp, err = o.manager.Client.QueryServiceConfigW(ctx, &QueryServiceConfigWRequest{
+			Service:      o.Handle,
+			BufferLength: size,
+		}); err != nil && resp != nil {
+			return resp.BytesNeededLength, err
+		}
+		return 0, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: query service config: %w", err)
+	}
+
+	cfg := resp.ServiceConfig
+	if cfg == nil {
+		return nil, fmt.Errorf("svcctl: query service config: empty response")
+	}
+
+	var deps []string
+	for _, dep := range strings.Split(cfg.Dependencies, "/") {
+		if dep != "" {
+			deps = append(deps, dep)
+		}
+	}
+
+	return &ServiceConfig{
+		ServiceType:      cfg.ServiceType,
+		StartType:        cfg.StartType,
+		ErrorControl:     cfg.ErrorControl,
+		BinaryPathName:   cfg.BinaryPathName,
+		LoadOrderGroup:   cfg.LoadOrderGroup,
+		TagID:            cfg.TagID,
+		Dependencies:     deps,
+		ServiceStartName: cfg.ServiceStartName,
+		DisplayName:      cfg.DisplayName,
+	}, nil
+}
+
+// ChangeConfig function changes the service configuration. The numeric fields
+// set to ServiceNoChange and the empty strings and lists are not changed. The
+// password, if any, is encrypted with the session key.
+func (o *Service) ChangeConfig(ctx context.Context, config *ServiceConfig) error {
+
+	req := &ChangeServiceConfigWRequest{
+		Service:          o.Handle,
+		ServiceType:      config.ServiceType,
+		StartType:        config.StartType,
+		ErrorControl:     config.ErrorControl,
+		BinaryPathName:   config.BinaryPathName,
+		LoadOrderGroup:   config.LoadOrderGroup,
+		TagID:            config.TagID,
+		ServiceStartName: config.ServiceStartName,
+		DisplayName:      config.DisplayName,
+	}
+
+	var err error
+
+	if req.Dependencies, err = encodeMultiString(config.Dependencies); err != nil {
+		return fmt.Errorf("svcctl: change service config: %w", err)
+	}
+
+	if req.Password, err = o.manager.encryptPassword(config.Password); err != nil {
+		return fmt.Errorf("svcctl: change service config: %w", err)
+	}
+
+	req.DependSize, req.PasswordSize = uint32(len(req.Dependencies)), uint32(len(req.Password))
+
+	if _, err := o.manager.Client.ChangeServiceConfigW(ctx, req); err != nil {
+		return fmt.Errorf("svcctl: change service config: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateConfig function reads the service configuration, applies the update
+// and changes only the fields modified by the update.
+//
+//	err := svc.UpdateConfig(ctx, func(cfg *svcctl.ServiceConfig) {
+//		cfg.StartType = svcctl.ServiceDisabled
+//	})
+func (o *Service) UpdateConfig(ctx context.Context, update func(*ServiceConfig)) error {
+
+	config, err := o.Config(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated := *config
+	updated.Dependencies = slices.Clone(config.Dependencies)
+
+	update(&updated)
+
+	change := &ServiceConfig{
+		ServiceType:  ServiceNoChange,
+		StartType:    ServiceNoChange,
+		ErrorControl: ServiceNoChange,
+		Password:     updated.Password,
+	}
+
+	if updated.ServiceType != config.ServiceType {
+		change.ServiceType = updated.ServiceType
+	}
+	if updated.StartType != config.StartType {
+		change.StartType = updated.StartType
+	}
+	if updated.ErrorControl != config.ErrorControl {
+		change.ErrorControl = updated.ErrorControl
+	}
+	if updated.BinaryPathName != config.BinaryPathName {
+		change.BinaryPathName = updated.BinaryPathName
+	}
+	if updated.LoadOrderGroup != config.LoadOrderGroup {
+		change.LoadOrderGroup = updated.LoadOrderGroup
+	}
+	if updated.TagID != config.TagID {
+		change.TagID = updated.TagID
+	}
+	if !slices.Equal(updated.Dependencies, config.Dependencies) {
+		change.Dependencies = updated.Dependencies
+	}
+	if updated.ServiceStartName != config.ServiceStartName {
+		change.ServiceStartName = updated.ServiceStartName
+	}
+	if updated.DisplayName != config.DisplayName {
+		change.DisplayName = updated.DisplayName
+	}
+
+	return o.ChangeConfig(ctx, change)
+}
+
+// Start function starts the service with the arguments.
+func (o *Service) Start(ctx context.Context, args ...string) error {
+
+	req := &StartServiceWRequest{
+		Service: o.Handle,
+		Argc:    uint32(len(args)),
+	}
+
+	for _, arg := range args {
+		req.Argv = append(req.Argv, &UnicodeString{StringPointer: arg})
+	}
+
+	if _, err := o.manager.Client.StartServiceW(ctx, req); err != nil {
+		return fmt.Errorf("svcctl: start service: %w", err)
+	}
+
+	return nil
+}
+
+// Control function sends the control code to the service and returns the
+// service status.
+func (o *Service) Control(ctx context.Context, control uint32) (*ServiceStatus, error) {
+
+	resp, err := o.manager.Client.ControlService(ctx, &ControlServiceRequest{
+		Service: o.Handle,
+		Control: control,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: control service: %w", err)
+	}
+
+	return resp.ServiceStatus, nil
+}
+
+// Stop function sends the stop control code to the service.
+func (o *Service) Stop(ctx context.Context) (*ServiceStatus, error) {
+	return o.Control(ctx, ServiceControlStop)
+}
+
+// WaitForState function polls the service status with the interval until
+// the service reaches the state or the context is done. DefaultWaitInterval
+// is used if interval is zero.
+//
+//	if err := svc.Start(ctx); err != nil {
+//		// handle error.
+//	}
+//
+//	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+//	defer cancel()
+//
+//	status, err := svc.WaitForState(ctx, svcctl.ServiceRunning, 0)
+func (o *Service) WaitForState(ctx context.Context, state uint32, interval time.Duration) (*ServiceStatusProcess, error) {
+
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+
+		status, err := o.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.CurrentState == state {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("svcctl: wait for service state: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// SecurityDescriptor function returns the self-relative service security
+// descriptor with the requested security information. The result can be
+// parsed using dtyp.SecurityDescriptor.Parse.
+func (o *Service) SecurityDescriptor(ctx context.Context, info uint32) ([]byte, error) {
+
+	var resp *QueryServiceObjectSecurityResponse
+
+	err := grow(0x400, maxEnumBufferSize, func(size uint32) (uint32, error) {
+		var err error
+		if resp, err = o.manager.Client.QueryServiceObjectSecurity(ctx, &QueryServiceObjectSecurityRequest{
+			Service:             o.Handle,
+			SecurityInformation: info,
+			BufferLength:        size,
+		}); err != nil && resp != nil {
+			return resp.BytesNeededLength, err
+		}
+		return 0, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: query service object security: %w", err)
+	}
+
+	return resp.SecurityDescriptor, nil
+}
+
+// SetSecurityDescriptor function sets the self-relative service security
+// descriptor parts identified by the security information.
+func (o *Service) SetSecurityDescriptor(ctx context.Context, info uint32, sd []byte) error {
+
+	if _, err := o.manager.Client.SetServiceObjectSecurity(ctx, &SetServiceObjectSecurityRequest{
+		Service:             o.Handle,
+		SecurityInformation: info,
+		SecurityDescriptor:  sd,
+		BufferLength:        uint32(len(sd)),
+	}); err != nil {
+		return fmt.Errorf("svcctl: set service object security: %w", err)
+	}
+
+	return nil
+}
+
+// Delete function marks the service for deletion. The service is deleted once
+// all service handles are closed.
+func (o *Service) Delete(ctx context.Context) error {
+
+	if _, err := o.manager.Client.DeleteService(ctx, &DeleteServiceRequest{
+		Service: o.Handle,
+	}); err != nil {
+		return fmt.Errorf("svcctl: delete service: %w", err)
+	}
+
+	return nil
+}
+
+// Close function closes the service handle.
+func (o *Service) Close(ctx context.Context) error {
+	return closeHandle(ctx, o.manager.Client, o.Handle)
+}
+
+// grow function calls fn with the buffer size, growing the buffer to the
+// size requested by the server until the call succeeds or the maximum
+// size is reached.
+func grow(size, maxSize uint32, fn func(uint32) (uint32, error)) error {
+	for {
+		needed, err := fn(size)
+		if err == nil || !isMoreData(err) || needed <= size || needed > maxSize {
+			return err
+		}
+		size = needed
+	}
+}
@@ -0,0 +1,252 @@
+package svcctl
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+)
+
+// The size of the SERVICE_TRIGGER structure within the RQueryServiceConfig2W buffer.
+const serviceTriggerSize = 20
+
+// The size of the SERVICE_TRIGGER_SPECIFIC_DATA_ITEM structure within the
+// RQueryServiceConfig2W buffer.
+const serviceTriggerDataItemSize = 12
+
+// config2 function returns the RQueryServiceConfig2W buffer for the information
+// level. The pointers within the buffer are encoded as the offsets from the
+// beginning of the buffer.
+func (o *Service) config2(ctx context.Context, level uint32) ([]byte, error) {
+
+	var resp *QueryServiceConfig2WResponse
+
+	err := grow(0x400, maxConfigBufferSize, func(size uint32) (uint32, error) {
+		var err error
+		if resp, err = o.manager.Client.QueryServiceConfig2W(ctx, &QueryServiceConfig2WRequest{
+			Service:      o.Handle,
+			InfoLevel:    level,
+			BufferLength: size,
+		}); err != nil && resp != nil {
+			return resp.BytesNeededLength, err
+		}
+		return 0, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: query service config2: %w", err)
+	}
+
+	return resp.Buffer, nil
+}
+
+// changeConfig2 function changes the service configuration for the information level.
+func (o *Service) changeConfig2(ctx context.Context, level uint32, value is_ConfigInfoW_ConfigInfoW) error {
+
+	if _, err := o.manager.Client.ChangeServiceConfig2W(ctx, &ChangeServiceConfig2WRequest{
+		Service: o.Handle,
+		Info: &ConfigInfoW{
+			InfoLevel:   level,
+			ConfigInfoW: &ConfigInfoW_ConfigInfoW{Value: value},
+		},
+	}); err != nil {
+		return fmt.Errorf("svcctl: change service config2: %w", err)
+	}
+
+	return nil
+}
+
+// Description function returns the service description.
+func (o *Service) Description(ctx context.Context) (string, error) {
+
+	b, err := o.config2(ctx, ServiceConfigDescription)
+	if err != nil {
+		return "", err
+	}
+
+	if len(b) < 4 {
+		return "", fmt.Errorf("svcctl: query service description: invalid buffer length")
+	}
+
+	return stringAt(b, binary.LittleEndian.Uint32(b))
+}
+
+// SetDescription function sets the service description.
+func (o *Service) SetDescription(ctx context.Context, description string) error {
+	return o.changeConfig2(ctx, ServiceConfigDescription, &ConfigInfoW_Description{
+		Description: &ServiceDescriptionW{Description: description},
+	})
+}
+
+// FailureActions function returns the actions performed by the service
+// control manager when the service fails.
+func (o *Service) FailureActions(ctx context.Context) (*ServiceFailureActionsW, error) {
+
+	b, err := o.config2(ctx, ServiceConfigFailureActions)
+	if err != nil {
+		return nil, err
+	}
+
+	fa, err := parseFailureActions(b)
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: query service failure actions: %w", err)
+	}
+
+	return fa, nil
+}
+
+// SetFailureActions function sets the actions performed by the service control
+// manager when the service fails.
+//
+//	err := svc.SetFailureActions(ctx, &svcctl.ServiceFailureActionsW{
+//		ResetPeriod: 86400,
+//		Actions: []*svcctl.Action{
+//			{Type: svcctl.ActionTypeRestart, Delay: 60000},
+//		},
+//	})
+func (o *Service) SetFailureActions(ctx context.Context, fa *ServiceFailureActionsW) error {
+	return o.changeConfig2(ctx, ServiceConfigFailureActions, &ConfigInfoW_FailureActions{
+		FailureActions: fa,
+	})
+}
+
+// Triggers function returns the service trigger events.
+func (o *Service) Triggers(ctx context.Context) (*ServiceTriggerInfo, error) {
+
+	b, err := o.config2(ctx, ServiceConfigTriggerInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	ti, err := parseTriggerInfo(b)
+	if err != nil {
+		return nil, fmt.Errorf("svcctl: query service triggers: %w", err)
+	}
+
+	return ti, nil
+}
+
+// SetTriggers function sets the service trigger events. The empty trigger
+// info removes all triggers.
+func (o *Service) SetTriggers(ctx context.Context, ti *ServiceTriggerInfo) error {
+	return o.changeConfig2(ctx, ServiceConfigTriggerInfo, &ConfigInfoW_TriggerInfo{
+		TriggerInfo: ti,
+	})
+}
+
+// parseFailureActions function parses the SERVICE_FAILURE_ACTIONS_WOW64 structure.
+func parseFailureActions(b []byte) (*ServiceFailureActionsW, error) {
+
+	if len(b) < 20 {
+		return nil, fmt.Errorf("invalid buffer length")
+	}
+
+	var (
+		fa  = &ServiceFailureActionsW{ResetPeriod: binary.LittleEndian.Uint32(b[0:])}
+		err error
+	)
+
+	if fa.RebootMessage, err = stringAt(b, binary.LittleEndian.Uint32(b[4:])); err != nil {
+		return nil, err
+	}
+
+	if fa.Command, err = stringAt(b, binary.LittleEndian.Uint32(b[8:])); err != nil {
+		return nil, err
+	}
+
+	n, off := binary.LittleEndian.Uint32(b[12:]), binary.LittleEndian.Uint32(b[16:])
+	if off == 0 {
+		return fa, nil
+	}
+
+	if uint64(off)+uint64(n)*8 > uint64(len(b)) {
+		return nil, fmt.Errorf("invalid actions offset")
+	}
+
+	for i := uint32(0); i < n; i++ {
+		a := b[off+i*8:]
+		fa.Actions = append(fa.Actions, &Action{
+			Type:  ActionType(binary.LittleEndian.Uint32(a[0:])),
+			Delay: binary.LittleEndian.Uint32(a[4:]),
+		})
+	}
+
+	fa.ActionsCount = uint32(len(fa.Actions))
+
+	return fa, nil
+}
+
+// parseTriggerInfo function parses the SERVICE_TRIGGER_INFO structure.
+func parseTriggerInfo(b []byte) (*ServiceTriggerInfo, error) {
+
+	if len(b) < 12 {
+		return nil, fmt.Errorf("invalid buffer length")
+	}
+
+	ti := &ServiceTriggerInfo{}
+
+	n, off := binary.LittleEndian.Uint32(b[0:]), binary.LittleEndian.Uint32(b[4:])
+	if off == 0 {
+		return ti, nil
+	}
+
+	if uint64(off)+uint64(n)*serviceTriggerSize > uint64(len(b)) {
+		return nil, fmt.Errorf("invalid triggers offset")
+	}
+
+	for i := uint32(0); i < n; i++ {
+
+		t := b[off+i*serviceTriggerSize:]
+
+		trigger := &ServiceTrigger{
+			TriggerType: binary.LittleEndian.Uint32(t[0:]),
+			Action:      binary.LittleEndian.Uint32(t[4:]),
+		}
+
+		if subtype := binary.LittleEndian.Uint32(t[8:]); subtype != 0 {
+			if uint64(subtype)+16 > uint64(len(b)) {
+				return nil, fmt.Errorf("invalid trigger subtype offset")
+			}
+			trigger.TriggerSubtype = &dtyp.GUID{
+				Data1: binary.LittleEndian.Uint32(b[subtype:]),
+				Data2: binary.LittleEndian.Uint16(b[subtype+4:]),
+				Data3: binary.LittleEndian.Uint16(b[subtype+6:]),
+				Data4: append([]byte{}, b[subtype+8:subtype+16]...),
+			}
+		}
+
+		items, itemsOff := binary.LittleEndian.Uint32(t[12:]), binary.LittleEndian.Uint32(t[16:])
+		if itemsOff != 0 {
+
+			if uint64(itemsOff)+uint64(items)*serviceTriggerDataItemSize > uint64(len(b)) {
+				return nil, fmt.Errorf("invalid trigger data items offset")
+			}
+
+			for j := uint32(0); j < items; j++ {
+
+				d := b[itemsOff+j*serviceTriggerDataItemSize:]
+
+				item := &ServiceTriggerSpecificDataItem{
+					DataType:   binary.LittleEndian.Uint32(d[0:]),
+					DataLength: binary.LittleEndian.Uint32(d[4:]),
+				}
+
+				if dataOff := binary.LittleEndian.Uint32(d[8:]); dataOff != 0 {
+					if uint64(dataOff)+uint64(item.DataLength) > uint64(len(b)) {
+						return nil, fmt.Errorf("invalid trigger data offset")
+					}
+					item.Data = append([]byte{}, b[dataOff:dataOff+item.DataLength]...)
+				}
+
+				trigger.DataItems = append(trigger.DataItems, item)
+			}
+		}
+
+		trigger.DataItemsCount = uint32(len(trigger.DataItems))
+		ti.Triggers = append(ti.Triggers, trigger)
+	}
+
+	ti.TriggersCount = uint32(len(ti.Triggers))
+
+	return ti, nil
+}
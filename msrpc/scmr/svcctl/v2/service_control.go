@@ -0,0 +1,107 @@
+package svcctl
+
+// The service manager access rights.
+const (
+	ManagerConnect          uint32 = 0x00000001
+	ManagerCreateService    uint32 = 0x00000002
+	ManagerEnumerateService uint32 = 0x00000004
+	ManagerLock             uint32 = 0x00000008
+	ManagerQueryLockStatus  uint32 = 0x00000010
+	ManagerModifyBootConfig uint32 = 0x00000020
+	ManagerAllAccess        uint32 = 0x000F003F
+)
+
+// The service access rights.
+const (
+	ServiceQueryConfig         uint32 = 0x00000001
+	ServiceChangeConfig        uint32 = 0x00000002
+	ServiceQueryStatus         uint32 = 0x00000004
+	ServiceEnumerateDependents uint32 = 0x00000008
+	ServiceStart               uint32 = 0x00000010
+	ServiceStop                uint32 = 0x00000020
+	ServicePauseContinue       uint32 = 0x00000040
+	ServiceInterrogate         uint32 = 0x00000080
+	ServiceUserDefinedControl  uint32 = 0x00000100
+	ServiceAllAccess           uint32 = 0x000F01FF
+)
+
+// The service types.
+const (
+	ServiceKernelDriver       uint32 = 0x00000001
+	ServiceFileSystemDriver   uint32 = 0x00000002
+	ServiceWin32OwnProcess    uint32 = 0x00000010
+	ServiceWin32ShareProcess  uint32 = 0x00000020
+	ServiceInteractiveProcess uint32 = 0x00000100
+	ServiceDriver             uint32 = ServiceKernelDriver | ServiceFileSystemDriver | 0x00000008
+	ServiceWin32              uint32 = ServiceWin32OwnProcess | ServiceWin32ShareProcess
+)
+
+// The service start types.
+const (
+	ServiceBootStart   uint32 = 0x00000000
+	ServiceSystemStart uint32 = 0x00000001
+	ServiceAutoStart   uint32 = 0x00000002
+	ServiceDemandStart uint32 = 0x00000003
+	ServiceDisabled    uint32 = 0x00000004
+)
+
+// The service error control values.
+const (
+	ServiceErrorIgnore   uint32 = 0x00000000
+	ServiceErrorNormal   uint32 = 0x00000001
+	ServiceErrorSevere   uint32 = 0x00000002
+	ServiceErrorCritical uint32 = 0x00000003
+)
+
+// ServiceNoChange is the value of the numeric service configuration field
+// that must not be changed.
+const ServiceNoChange uint32 = 0xFFFFFFFF
+
+// The service states.
+const (
+	ServiceStopped         uint32 = 0x00000001
+	ServiceStartPending    uint32 = 0x00000002
+	ServiceStopPending     uint32 = 0x00000003
+	ServiceRunning         uint32 = 0x00000004
+	ServiceContinuePending uint32 = 0x00000005
+	ServicePausePending    uint32 = 0x00000006
+	ServicePaused          uint32 = 0x00000007
+)
+
+// The service states for the enumeration.
+const (
+	ServiceActive   uint32 = 0x00000001
+	ServiceInactive uint32 = 0x00000002
+	ServiceStateAll uint32 = ServiceActive | ServiceInactive
+)
+
+// The service control codes.
+const (
+	ServiceControlStop        uint32 = 0x00000001
+	ServiceControlPause       uint32 = 0x00000002
+	ServiceControlContinue    uint32 = 0x00000003
+	ServiceControlInterrogate uint32 = 0x00000004
+	ServiceControlParamChange uint32 = 0x00000006
+)
+
+// The service configuration information levels (RQueryServiceConfig2W and
+// RChangeServiceConfig2W).
+const (
+	ServiceConfigDescription        uint32 = 0x00000001
+	ServiceConfigFailureActions     uint32 = 0x00000002
+	ServiceConfigDelayedAutoStart   uint32 = 0x00000003
+	ServiceConfigFailureActionsFlag uint32 = 0x00000004
+	ServiceConfigServiceSIDInfo     uint32 = 0x00000005
+	ServiceConfigRequiredPrivileges uint32 = 0x00000006
+	ServiceConfigPreshutdownInfo    uint32 = 0x00000007
+	ServiceConfigTriggerInfo        uint32 = 0x00000008
+	ServiceConfigPreferredNode      uint32 = 0x00000009
+)
+
+// The security information flags.
+const (
+	OwnerSecurityInformation uint32 = 0x00000001
+	GroupSecurityInformation uint32 = 0x00000002
+	DACLSecurityInformation  uint32 = 0x00000004
+	SACLSecurityInformation  uint32 = 0x00000008
+)
@@ -0,0 +1,506 @@
+package itaskschedulerservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc"
+	"github.com/oiweiwei/go-msrpc/msrpc/dtyp"
+	"github.com/oiweiwei/go-msrpc/msrpc/tsch"
+	atsvc "github.com/oiweiwei/go-msrpc/msrpc/tsch/atsvc/v1"
+	sasec "github.com/oiweiwei/go-msrpc/msrpc/tsch/sasec/v1"
+)
+
+// The task registration flags.
+const (
+	TaskValidateOnly               uint32 = 0x00000001
+	TaskCreate                     uint32 = 0x00000002
+	TaskUpdate                     uint32 = 0x00000004
+	TaskCreateOrUpdate             uint32 = TaskCreate | TaskUpdate
+	TaskDisable                    uint32 = 0x00000008
+	TaskDontAddPrincipalACE        uint32 = 0x00000010
+	TaskIgnoreRegistrationTriggers uint32 = 0x00000020
+)
+
+// The task logon types (TASK_LOGON_TYPE).
+const (
+	TaskLogonNone                       uint32 = 0x00000000
+	TaskLogonPassword                   uint32 = 0x00000001
+	TaskLogonS4U                        uint32 = 0x00000002
+	TaskLogonInteractiveToken           uint32 = 0x00000003
+	TaskLogonGroup                      uint32 = 0x00000004
+	TaskLogonServiceAccount             uint32 = 0x00000005
+	TaskLogonInteractiveTokenOrPassword uint32 = 0x00000006
+)
+
+// The task states.
+const (
+	TaskStateUnknown  uint32 = 0x00000000
+	TaskStateDisabled uint32 = 0x00000001
+	TaskStateQueued   uint32 = 0x00000002
+	TaskStateReady    uint32 = 0x00000003
+	TaskStateRunning  uint32 = 0x00000004
+)
+
+// TaskEnumHidden is the enumeration flag to include the hidden tasks.
+const TaskEnumHidden uint32 = 0x00000001
+
+// The flag to return the task state for SchRpcGetTaskInfo.
+const schFlagState uint32 = 0x00000002
+
+// The number of the entries requested with a single enumeration call.
+const enumBatchSize = 100
+
+// The success HRESULT values returned by the server.
+const (
+	sFalse              = 0x00000001
+	schedSTaskHasNotRun = 0x00041303
+)
+
+// ErrNotSupported is returned when the operation is not supported by
+// the server, for example, when only ATSvc interface is available.
+var ErrNotSupported = errors.New("tsch: operation is not supported by the server")
+
+// Scheduler is the task scheduler facade. Scheduler uses the ITaskSchedulerService
+// interface and falls back to the ATSvc and SASec interfaces on the older servers,
+// where only the registration, enumeration and deletion of the tasks are supported.
+//
+//	sched, err := itaskschedulerservice.NewScheduler(ctx, cc, dcerpc.WithSeal(), dcerpc.WithTargetName(target))
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	task := tsch.NewTask()
+//	task.Actions.Exec = append(task.Actions.Exec, &tsch.ExecAction{Command: `C:\Windows\System32\cmd.exe`})
+//
+//	path, err := sched.Register(ctx, `\task`, task, nil)
+//	if err != nil {
+//		// handle error.
+//	}
+//
+//	guid, err := sched.Run(ctx, path)
+type Scheduler struct {
+	// The task scheduler service client. (nil if not available).
+	Client TaskSchedulerServiceClient
+	// The highest version supported by the task scheduler service.
+	Version uint32
+	// The ATSvc client used when the task scheduler service is not available.
+	ATSvc atsvc.ATSvcClient
+	// The SASec client used to set the ATSvc task credentials.
+	SASec sasec.SasecClient
+	// The server name used with the ATSvc and SASec clients.
+	ServerName string
+}
+
+// RegisterOptions is the task registration options.
+type RegisterOptions struct {
+	// The registration flags. (TaskCreateOrUpdate by default).
+	Flags uint32
+	// The task security descriptor in SDDL format.
+	SDDL string
+	// The logon type. (TaskLogonNone by default).
+	LogonType uint32
+	// The user the task runs as.
+	User string
+	// The user password.
+	Password string
+}
+
+// InstanceInfo is the running task instance information.
+type InstanceInfo struct {
+	// The task path.
+	Path string `json:"path"`
+	// The task state.
+	State uint32 `json:"state"`
+	// The name of the current action.
+	CurrentAction string `json:"current_action"`
+	// The status information.
+	Info string `json:"info"`
+	// The instances running together with the instance.
+	GroupInstances []*dtyp.GUID `json:"group_instances"`
+	// The process identifier of the task engine.
+	EnginePID uint32 `json:"engine_pid"`
+}
+
+// LastRunInfo is the last task run information.
+type LastRunInfo struct {
+	// The last run time. (zero if the task has not run yet).
+	Time time.Time `json:"time"`
+	// The last return code.
+	ReturnCode uint32 `json:"return_code"`
+}
+
+// NewScheduler function binds the task scheduler service. If the binding fails,
+// the ATSvc and SASec interfaces are bound instead.
+func NewScheduler(ctx context.Context, cc dcerpc.Conn, opts ...dcerpc.Option) (*Scheduler, error) {
+
+	cli, err := NewTaskSchedulerServiceClient(ctx, cc, opts...)
+	if err == nil {
+		resp, verr := cli.HighestVersion(ctx, &HighestVersionRequest{})
+		if verr == nil {
+			return &Scheduler{Client: cli, Version: resp.Version}, nil
+		}
+		err = verr
+	}
+
+	at, atErr := atsvc.NewATSvcClient(ctx, cc, opts...)
+	if atErr != nil {
+		return nil, fmt.Errorf("tsch: new scheduler: %w", errors.Join(err, atErr))
+	}
+
+	sched := &Scheduler{ATSvc: at}
+
+	if sa, err := sasec.NewSasecClient(ctx, cc, opts...); err == nil {
+		sched.SASec = sa
+	}
+
+	return sched, nil
+}
+
+// Register function registers the task at the path and returns the actual
+// task path. The server generates the path if path is empty.
+func (o *Scheduler) Register(ctx context.Context, path string, task *tsch.Task, opts *RegisterOptions) (string, error) {
+
+	if opts == nil {
+		opts = &RegisterOptions{}
+	}
+
+	if o.Client == nil {
+		return o.registerJob(ctx, task, opts)
+	}
+
+	xml, err := task.Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	req := &RegisterTaskRequest{
+		Path:      path,
+		XML:       xml,
+		Flags:     opts.Flags,
+		SDDL:      opts.SDDL,
+		LogonType: opts.LogonType,
+	}
+
+	if req.Flags == 0 {
+		req.Flags = TaskCreateOrUpdate
+	}
+
+	if opts.User != "" {
+		req.CredsCount = 1
+		req.Creds = []*TaskUserCred{{UserID: opts.User, Password: opts.Password}}
+	}
+
+	resp, err := o.Client.RegisterTask(ctx, req)
+	if err != nil {
+		if resp != nil && resp.ErrorInfo != nil {
+			return "", fmt.Errorf("tsch: register task: line %d, column %d, node %q, value %q: %w",
+				resp.ErrorInfo.Line, resp.ErrorInfo.Column, resp.ErrorInfo.Node, resp.ErrorInfo.Value, err)
+		}
+		return "", fmt.Errorf("tsch: register task: %w", err)
+	}
+
+	return resp.ActualPath, nil
+}
+
+// Task function returns the task definition.
+func (o *Scheduler) Task(ctx context.Context, path string) (*tsch.Task, error) {
+
+	if o.Client == nil {
+		return nil, ErrNotSupported
+	}
+
+	resp, err := o.Client.RetrieveTask(ctx, &RetrieveTaskRequest{Path: path})
+	if err != nil {
+		return nil, fmt.Errorf("tsch: retrieve task: %w", err)
+	}
+
+	return tsch.ParseTask(resp.XML)
+}
+
+// Run function runs the task with the arguments and returns the task instance
+// identifier.
+func (o *Scheduler) Run(ctx context.Context, path string, args ...string) (*dtyp.GUID, error) {
+
+	if o.Client == nil {
+		return nil, ErrNotSupported
+	}
+
+	resp, err := o.Client.Run(ctx, &RunRequest{
+		Path:      path,
+		ArgsCount: uint32(len(args)),
+		Args:      args,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tsch: run task: %w", err)
+	}
+
+	return resp.GUID, nil
+}
+
+// Stop function stops all instances of the task.
+func (o *Scheduler) Stop(ctx context.Context, path string) error {
+
+	if o.Client == nil {
+		return ErrNotSupported
+	}
+
+	if _, err := o.Client.Stop(ctx, &StopRequest{Path: path}); err != nil {
+		return fmt.Errorf("tsch: stop task: %w", err)
+	}
+
+	return nil
+}
+
+// StopInstance function stops the task instance.
+func (o *Scheduler) StopInstance(ctx context.Context, guid *dtyp.GUID) error {
+
+	if o.Client == nil {
+		return ErrNotSupported
+	}
+
+	if _, err := o.Client.StopInstance(ctx, &StopInstanceRequest{GUID: guid}); err != nil {
+		return fmt.Errorf("tsch: stop instance: %w", err)
+	}
+
+	return nil
+}
+
+// Delete function deletes the task or the empty folder.
+func (o *Scheduler) Delete(ctx context.Context, path string) error {
+
+	if o.Client == nil {
+		return o.deleteJob(ctx, path)
+	}
+
+	if _, err := o.Client.Delete(ctx, &DeleteRequest{Path: path}); err != nil {
+		return fmt.Errorf("tsch: delete: %w", err)
+	}
+
+	return nil
+}
+
+// Enable function enables or disables the task.
+func (o *Scheduler) Enable(ctx context.Context, path string, enabled bool) error {
+
+	if o.Client == nil {
+		return ErrNotSupported
+	}
+
+	req := &EnableTaskRequest{Path: path}
+	if enabled {
+		req.Enabled = 1
+	}
+
+	if _, err := o.Client.EnableTask(ctx, req); err != nil {
+		return fmt.Errorf("tsch: enable task: %w", err)
+	}
+
+	return nil
+}
+
+// State function returns whether the task is enabled and the task state.
+func (o *Scheduler) State(ctx context.Context, path string) (bool, uint32, error) {
+
+	if o.Client == nil {
+		return false, TaskStateUnknown, ErrNotSupported
+	}
+
+	resp, err := o.Client.GetTaskInfo(ctx, &GetTaskInfoRequest{Path: path, Flags: schFlagState})
+	if err != nil {
+		return false, TaskStateUnknown, fmt.Errorf("tsch: get task info: %w", err)
+	}
+
+	return resp.Enabled != 0, resp.State, nil
+}
+
+// CreateFolder function creates the folder with the security descriptor in
+// SDDL format. The security descriptor is inherited if sddl is empty.
+func (o *Scheduler) CreateFolder(ctx context.Context, path string, sddl string) error {
+
+	if o.Client == nil {
+		return ErrNotSupported
+	}
+
+	if _, err := o.Client.CreateFolder(ctx, &CreateFolderRequest{Path: path, SDDL: sddl}); err != nil {
+		return fmt.Errorf("tsch: create folder: %w", err)
+	}
+
+	return nil
+}
+
+// Folders function returns the names of the subfolders of the folder.
+func (o *Scheduler) Folders(ctx context.Context, path string, flags uint32) ([]string, error) {
+
+	if o.Client == nil {
+		return nil, ErrNotSupported
+	}
+
+	return enumerate(func(start uint32) ([]string, uint32, error) {
+		resp, err := o.Client.EnumFolders(ctx, &EnumFoldersRequest{
+			Path:           path,
+			Flags:          flags,
+			StartIndex:     start,
+			RequestedCount: enumBatchSize,
+		})
+		if err != nil && (resp == nil || resp.Return != sFalse) {
+			return nil, 0, fmt.Errorf("tsch: enumerate folders: %w", err)
+		}
+		return resp.Names, resp.StartIndex, nil
+	})
+}
+
+// Tasks function returns the names of the tasks in the folder.
+func (o *Scheduler) Tasks(ctx context.Context, path string, flags uint32) ([]string, error) {
+
+	if o.Client == nil {
+		return o.jobs(ctx)
+	}
+
+	return enumerate(func(start uint32) ([]string, uint32, error) {
+		resp, err := o.Client.EnumTasks(ctx, &EnumTasksRequest{
+			Path:           path,
+			Flags:          flags,
+			StartIndex:     start,
+			RequestedCount: enumBatchSize,
+		})
+		if err != nil && (resp == nil || resp.Return != sFalse) {
+			return nil, 0, fmt.Errorf("tsch: enumerate tasks: %w", err)
+		}
+		return resp.Names, resp.StartIndex, nil
+	})
+}
+
+// Walk function calls fn for every task path in the folder and its subfolders.
+//
+//	err := sched.Walk(ctx, `\`, 0, func(path string) error {
+//		fmt.Println(path)
+//		return nil
+//	})
+func (o *Scheduler) Walk(ctx context.Context, root string, flags uint32, fn func(path string) error) error {
+
+	tasks, err := o.Tasks(ctx, root, flags)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if err := fn(joinPath(root, task)); err != nil {
+			return err
+		}
+	}
+
+	if o.Client == nil {
+		// the ATSvc tasks are not organized in folders.
+		return nil
+	}
+
+	folders, err := o.Folders(ctx, root, flags)
+	if err != nil {
+		return err
+	}
+
+	for _, folder := range folders {
+		if err := o.Walk(ctx, joinPath(root, folder), flags, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Instances function returns the identifiers of the running instances of the
+// task. The instances of all tasks are returned if path is empty.
+func (o *Scheduler) Instances(ctx context.Context, path string, flags uint32) ([]*dtyp.GUID, error) {
+
+	if o.Client == nil {
+		return nil, ErrNotSupported
+	}
+
+	resp, err := o.Client.EnumInstances(ctx, &EnumInstancesRequest{Path: path, Flags: flags})
+	if err != nil {
+		return nil, fmt.Errorf("tsch: enumerate instances: %w", err)
+	}
+
+	return resp.GUIDs, nil
+}
+
+// InstanceInfo function returns the running task instance information.
+func (o *Scheduler) InstanceInfo(ctx context.Context, guid *dtyp.GUID) (*InstanceInfo, error) {
+
+	if o.Client == nil {
+		return nil, ErrNotSupported
+	}
+
+	resp, err := o.Client.GetInstanceInfo(ctx, &GetInstanceInfoRequest{GUID: guid})
+	if err != nil {
+		return nil, fmt.Errorf("tsch: get instance info: %w", err)
+	}
+
+	return &InstanceInfo{
+		Path:           resp.Path,
+		State:          resp.State,
+		CurrentAction:  resp.CurrentAction,
+		Info:           resp.Info,
+		GroupInstances: resp.GroupInstances,
+		EnginePID:      resp.EnginePID,
+	}, nil
+}
+
+// LastRunInfo function returns the time and the return code of the last task
+// run. The zero time is returned if the task has not run yet.
+func (o *Scheduler) LastRunInfo(ctx context.Context, path string) (*LastRunInfo, error) {
+
+	if o.Client == nil {
+		return nil, ErrNotSupported
+	}
+
+	resp, err := o.Client.GetLastRunInfo(ctx, &GetLastRunInfoRequest{Path: path})
+	if err != nil {
+		if resp != nil && resp.Return == schedSTaskHasNotRun {
+			return &LastRunInfo{}, nil
+		}
+		return nil, fmt.Errorf("tsch: get last run info: %w", err)
+	}
+
+	info := &LastRunInfo{ReturnCode: resp.LastReturnCode}
+
+	if st := resp.LastRuntime; st != nil && st.Year != 0 {
+		info.Time = time.Date(int(st.Year), time.Month(st.Month), int(st.Day),
+			int(st.Hour), int(st.Minute), int(st.Second), int(st.Milliseconds)*int(time.Millisecond), time.UTC)
+	}
+
+	return info, nil
+}
+
+// enumerate function collects the names returned by the paged enumeration.
+// The fn function returns the page and the next start index.
+func enumerate(fn func(uint32) ([]string, uint32, error)) ([]string, error) {
+
+	var names []string
+
+	for start := uint32(0); ; {
+
+		page, next, err := fn(start)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, page...)
+
+		if len(page) < enumBatchSize || next == start {
+			break
+		}
+
+		start = next
+	}
+
+	return names, nil
+}
+
+func joinPath(folder, name string) string {
+	return strings.TrimSuffix(folder, `\`) + `\` + name
+}
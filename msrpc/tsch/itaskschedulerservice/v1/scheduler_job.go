@@ -0,0 +1,262 @@
+package itaskschedulerservice
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/msrpc/tsch"
+	atsvc "github.com/oiweiwei/go-msrpc/msrpc/tsch/atsvc/v1"
+	sasec "github.com/oiweiwei/go-msrpc/msrpc/tsch/sasec/v1"
+)
+
+// The ATSvc task flags.
+const (
+	JobRunPeriodically uint8 = 0x01
+	JobExecError       uint8 = 0x02
+	JobRunsToday       uint8 = 0x04
+	JobAddCurrentDate  uint8 = 0x08
+	JobNonInteractive  uint8 = 0x10
+)
+
+// The days of week as used by the task XML.
+var daysOfWeek = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// NewJobInfo function converts the task definition to the ATSvc task. The task
+// must have exactly one exec action and at most one time or calendar trigger.
+// The time trigger runs the task once, the daily, weekly and monthly (by days)
+// calendar triggers run the task periodically. The task without the trigger
+// runs at the current time of the next day.
+func NewJobInfo(task *tsch.Task) (*tsch.ATInfo, error) {
+
+	if task.Actions == nil || len(task.Actions.Exec) != 1 || len(task.Actions.ComHandler) != 0 {
+		return nil, fmt.Errorf("tsch: job: exactly one exec action is supported")
+	}
+
+	exec := task.Actions.Exec[0]
+
+	info := &tsch.ATInfo{Command: exec.Command, Flags: JobNonInteractive}
+	if exec.Arguments != "" {
+		info.Command += " " + exec.Arguments
+	}
+
+	var (
+		start    string
+		calendar *tsch.CalendarTrigger
+	)
+
+	if tr := task.Triggers; tr != nil {
+
+		if len(tr.Boot)+len(tr.Registration)+len(tr.Idle)+len(tr.Event)+len(tr.Logon)+len(tr.SessionStateChange) > 0 ||
+			len(tr.Time)+len(tr.Calendar) > 1 {
+			return nil, fmt.Errorf("tsch: job: only single time or calendar trigger is supported")
+		}
+
+		if len(tr.Time) > 0 {
+			start = tr.Time[0].StartBoundary
+		}
+
+		if len(tr.Calendar) > 0 {
+			calendar, start = tr.Calendar[0], tr.Calendar[0].StartBoundary
+		}
+	}
+
+	at := time.Now()
+	if start != "" {
+		var err error
+		if at, err = parseBoundary(start); err != nil {
+			return nil, fmt.Errorf("tsch: job: %w", err)
+		}
+	}
+
+	info.JobTime = uint64((time.Duration(at.Hour())*time.Hour +
+		time.Duration(at.Minute())*time.Minute + time.Duration(at.Second())*time.Second) / time.Millisecond)
+
+	if calendar == nil {
+		return info, nil
+	}
+
+	info.Flags |= JobRunPeriodically
+
+	switch {
+	case calendar.ScheduleByDay != nil:
+		if calendar.ScheduleByDay.DaysInterval > 1 {
+			return nil, fmt.Errorf("tsch: job: days interval is not supported")
+		}
+		info.DaysOfWeek = 0x7F
+	case calendar.ScheduleByWeek != nil:
+		if calendar.ScheduleByWeek.WeeksInterval > 1 {
+			return nil, fmt.Errorf("tsch: job: weeks interval is not supported")
+		}
+		for _, day := range calendar.ScheduleByWeek.DaysOfWeek {
+			i := indexOf(daysOfWeek, day)
+			if i < 0 {
+				return nil, fmt.Errorf("tsch: job: invalid day of week %q", day)
+			}
+			info.DaysOfWeek |= 1 << i
+		}
+	case calendar.ScheduleByMonth != nil && calendar.ScheduleByMonth.DaysOfMonth != nil:
+		if len(calendar.ScheduleByMonth.Months) != 0 && len(calendar.ScheduleByMonth.Months) != 12 {
+			return nil, fmt.Errorf("tsch: job: months are not supported")
+		}
+		for _, day := range calendar.ScheduleByMonth.DaysOfMonth.Day {
+			d, err := strconv.Atoi(day)
+			if err != nil || d < 1 || d > 31 {
+				return nil, fmt.Errorf("tsch: job: invalid day of month %q", day)
+			}
+			info.DaysOfMonth |= 1 << (d - 1)
+		}
+	default:
+		return nil, fmt.Errorf("tsch: job: calendar schedule is not supported")
+	}
+
+	return info, nil
+}
+
+// registerJob function registers the task using NetrJobAdd and sets the task
+// credentials using SASetAccountInformation. The job path is AtN, where N is
+// the job identifier.
+func (o *Scheduler) registerJob(ctx context.Context, task *tsch.Task, opts *RegisterOptions) (string, error) {
+
+	if o.ATSvc == nil {
+		return "", ErrNotSupported
+	}
+
+	info, err := NewJobInfo(task)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := o.ATSvc.JobAdd(ctx, &atsvc.JobAddRequest{ServerName: o.ServerName, ATInfo: info})
+	if err != nil {
+		return "", fmt.Errorf("tsch: job add: %w", err)
+	}
+
+	path := "At" + strconv.FormatUint(uint64(resp.JobID), 10)
+
+	if opts.User == "" {
+		return path, nil
+	}
+
+	if o.SASec == nil {
+		return path, fmt.Errorf("tsch: set account information: %w", ErrNotSupported)
+	}
+
+	if _, err := o.SASec.SetAccountInformation(ctx, &sasec.SetAccountInformationRequest{
+		Handle:   o.ServerName,
+		JobName:  path + ".job",
+		Account:  opts.User,
+		Password: opts.Password,
+	}); err != nil {
+		return path, fmt.Errorf("tsch: set account information: %w", err)
+	}
+
+	return path, nil
+}
+
+// deleteJob function deletes the ATSvc task with the path AtN.
+func (o *Scheduler) deleteJob(ctx context.Context, path string) error {
+
+	if o.ATSvc == nil {
+		return ErrNotSupported
+	}
+
+	id, err := jobID(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := o.ATSvc.JobDelete(ctx, &atsvc.JobDeleteRequest{
+		ServerName: o.ServerName,
+		MinJobID:   id,
+		MaxJobID:   id,
+	}); err != nil {
+		return fmt.Errorf("tsch: job delete: %w", err)
+	}
+
+	return nil
+}
+
+// jobs function returns the paths of the ATSvc tasks.
+func (o *Scheduler) jobs(ctx context.Context) ([]string, error) {
+
+	if o.ATSvc == nil {
+		return nil, ErrNotSupported
+	}
+
+	var paths []string
+
+	for resume := uint32(0); ; {
+
+		resp, err := o.ATSvc.JobEnum(ctx, &atsvc.JobEnumRequest{
+			ServerName:             o.ServerName,
+			EnumContainer:          &atsvc.ATEnumContainer{},
+			PreferredMaximumLength: 0xFFFFFFFF,
+			Resume:                 resume,
+		})
+		if err != nil && !isMoreData(resp) {
+			return nil, fmt.Errorf("tsch: job enum: %w", err)
+		}
+
+		if resp.EnumContainer == nil || len(resp.EnumContainer.Buffer) == 0 {
+			break
+		}
+
+		for _, job := range resp.EnumContainer.Buffer {
+			paths = append(paths, "At"+strconv.FormatUint(uint64(job.JobID), 10))
+		}
+
+		if err == nil || resp.Resume == 0 || resp.Resume == resume {
+			break
+		}
+
+		resume = resp.Resume
+	}
+
+	return paths, nil
+}
+
+// isMoreData function returns true if the NetrJobEnum response indicates
+// that more entries are available (ERROR_MORE_DATA).
+func isMoreData(resp *atsvc.JobEnumResponse) bool {
+	return resp != nil && resp.Return == 0x000000EA
+}
+
+// jobID function parses the job identifier from the path AtN.
+func jobID(path string) (uint32, error) {
+
+	name := path[strings.LastIndex(path, `\`)+1:]
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".job"), ".JOB")
+
+	if len(name) < 3 || !strings.EqualFold(name[:2], "At") {
+		return 0, fmt.Errorf("tsch: invalid job path %q", path)
+	}
+
+	id, err := strconv.ParseUint(name[2:], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("tsch: invalid job path %q", path)
+	}
+
+	return uint32(id), nil
+}
+
+// parseBoundary function parses the StartBoundary value.
+func parseBoundary(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid start boundary %q", s)
+}
+
+func indexOf(ss []string, s string) int {
+	for i := range ss {
+		if strings.EqualFold(ss[i], s) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,544 @@
+package tsch
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TaskNamespace is the task definition XML namespace (section 2.5).
+const TaskNamespace = "http://schemas.microsoft.com/windows/2004/02/mit/task"
+
+// The task definition schema versions.
+const (
+	TaskVersion1_2 = "1.2"
+	TaskVersion1_3 = "1.3"
+	TaskVersion1_4 = "1.4"
+)
+
+// The principal logon types.
+const (
+	LogonTypeS4U                        = "S4U"
+	LogonTypePassword                   = "Password"
+	LogonTypeInteractiveToken           = "InteractiveToken"
+	LogonTypeInteractiveTokenOrPassword = "InteractiveTokenOrPassword"
+)
+
+// The principal run levels.
+const (
+	RunLevelLeastPrivilege   = "LeastPrivilege"
+	RunLevelHighestAvailable = "HighestAvailable"
+)
+
+// The multiple instances policies.
+const (
+	MultipleInstancesParallel     = "Parallel"
+	MultipleInstancesQueue        = "Queue"
+	MultipleInstancesIgnoreNew    = "IgnoreNew"
+	MultipleInstancesStopExisting = "StopExisting"
+)
+
+// The action contexts.
+const (
+	ActionContextAuthor = "Author"
+)
+
+// Task is the task definition (section 2.5). The Task can be marshaled to and
+// unmarshaled from the task XML accepted and returned by the SchRpcRegisterTask
+// and SchRpcRetrieveTask methods.
+//
+//	task := tsch.NewTask()
+//	task.Actions.Exec = append(task.Actions.Exec, &tsch.ExecAction{
+//		Command:   `C:\Windows\System32\cmd.exe`,
+//		Arguments: `/c whoami`,
+//	})
+//
+//	xml, err := task.Marshal()
+type Task struct {
+	XMLName xml.Name `xml:"http://schemas.microsoft.com/windows/2004/02/mit/task Task"`
+	// The schema version.
+	Version string `xml:"version,attr,omitempty" json:"version,omitempty"`
+	// The registration information.
+	RegistrationInfo *RegistrationInfo `xml:"RegistrationInfo,omitempty" json:"registration_info,omitempty"`
+	// The triggers.
+	Triggers *Triggers `xml:"Triggers,omitempty" json:"triggers,omitempty"`
+	// The principals (only one principal is supported).
+	Principals *Principals `xml:"Principals,omitempty" json:"principals,omitempty"`
+	// The settings.
+	Settings *Settings `xml:"Settings,omitempty" json:"settings,omitempty"`
+	// The actions.
+	Actions *Actions `xml:"Actions" json:"actions"`
+}
+
+// RegistrationInfo is the task registration information.
+type RegistrationInfo struct {
+	URI                string `xml:"URI,omitempty" json:"uri,omitempty"`
+	SecurityDescriptor string `xml:"SecurityDescriptor,omitempty" json:"security_descriptor,omitempty"`
+	Source             string `xml:"Source,omitempty" json:"source,omitempty"`
+	Date               string `xml:"Date,omitempty" json:"date,omitempty"`
+	Author             string `xml:"Author,omitempty" json:"author,omitempty"`
+	Version            string `xml:"Version,omitempty" json:"version,omitempty"`
+	Description        string `xml:"Description,omitempty" json:"description,omitempty"`
+	Documentation      string `xml:"Documentation,omitempty" json:"documentation,omitempty"`
+}
+
+// Triggers is the list of the task triggers grouped by the trigger type.
+type Triggers struct {
+	Boot               []*BootTrigger               `xml:"BootTrigger,omitempty" json:"boot,omitempty"`
+	Registration       []*RegistrationTrigger       `xml:"RegistrationTrigger,omitempty" json:"registration,omitempty"`
+	Idle               []*IdleTrigger               `xml:"IdleTrigger,omitempty" json:"idle,omitempty"`
+	Time               []*TimeTrigger               `xml:"TimeTrigger,omitempty" json:"time,omitempty"`
+	Event              []*EventTrigger              `xml:"EventTrigger,omitempty" json:"event,omitempty"`
+	Logon              []*LogonTrigger              `xml:"LogonTrigger,omitempty" json:"logon,omitempty"`
+	SessionStateChange []*SessionStateChangeTrigger `xml:"SessionStateChangeTrigger,omitempty" json:"session_state_change,omitempty"`
+	Calendar           []*CalendarTrigger           `xml:"CalendarTrigger,omitempty" json:"calendar,omitempty"`
+}
+
+// TriggerBase contains the elements common to all triggers.
+type TriggerBase struct {
+	ID                 string      `xml:"id,attr,omitempty" json:"id,omitempty"`
+	Enabled            *bool       `xml:"Enabled,omitempty" json:"enabled,omitempty"`
+	StartBoundary      string      `xml:"StartBoundary,omitempty" json:"start_boundary,omitempty"`
+	EndBoundary        string      `xml:"EndBoundary,omitempty" json:"end_boundary,omitempty"`
+	Repetition         *Repetition `xml:"Repetition,omitempty" json:"repetition,omitempty"`
+	ExecutionTimeLimit string      `xml:"ExecutionTimeLimit,omitempty" json:"execution_time_limit,omitempty"`
+}
+
+// Repetition is the trigger repetition pattern.
+type Repetition struct {
+	Interval          string `xml:"Interval" json:"interval"`
+	Duration          string `xml:"Duration,omitempty" json:"duration,omitempty"`
+	StopAtDurationEnd *bool  `xml:"StopAtDurationEnd,omitempty" json:"stop_at_duration_end,omitempty"`
+}
+
+// BootTrigger starts the task when the system is booted.
+type BootTrigger struct {
+	TriggerBase
+	Delay string `xml:"Delay,omitempty" json:"delay,omitempty"`
+}
+
+// RegistrationTrigger starts the task when the task is registered.
+type RegistrationTrigger struct {
+	TriggerBase
+	Delay string `xml:"Delay,omitempty" json:"delay,omitempty"`
+}
+
+// IdleTrigger starts the task when the system becomes idle.
+type IdleTrigger struct {
+	TriggerBase
+}
+
+// TimeTrigger starts the task at the StartBoundary time.
+type TimeTrigger struct {
+	TriggerBase
+	RandomDelay string `xml:"RandomDelay,omitempty" json:"random_delay,omitempty"`
+}
+
+// EventTrigger starts the task when the event matching the subscription query
+// is logged.
+type EventTrigger struct {
+	TriggerBase
+	Subscription        string        `xml:"Subscription" json:"subscription"`
+	Delay               string        `xml:"Delay,omitempty" json:"delay,omitempty"`
+	PeriodOfOccurrence  string        `xml:"PeriodOfOccurrence,omitempty" json:"period_of_occurrence,omitempty"`
+	NumberOfOccurrences int           `xml:"NumberOfOccurrences,omitempty" json:"number_of_occurrences,omitempty"`
+	MatchingElement     string        `xml:"MatchingElement,omitempty" json:"matching_element,omitempty"`
+	ValueQueries        *ValueQueries `xml:"ValueQueries,omitempty" json:"value_queries,omitempty"`
+}
+
+// ValueQueries is the list of the named XPath queries for the event trigger.
+type ValueQueries struct {
+	Value []*NamedValue `xml:"Value" json:"value"`
+}
+
+// NamedValue is the name-value pair.
+type NamedValue struct {
+	Name  string `xml:"name,attr" json:"name"`
+	Value string `xml:",chardata" json:"value"`
+}
+
+// LogonTrigger starts the task when the user logs on.
+type LogonTrigger struct {
+	TriggerBase
+	UserID string `xml:"UserId,omitempty" json:"user_id,omitempty"`
+	Delay  string `xml:"Delay,omitempty" json:"delay,omitempty"`
+}
+
+// The session state change values.
+const (
+	StateChangeConsoleConnect    = "ConsoleConnect"
+	StateChangeConsoleDisconnect = "ConsoleDisconnect"
+	StateChangeRemoteConnect     = "RemoteConnect"
+	StateChangeRemoteDisconnect  = "RemoteDisconnect"
+	StateChangeSessionLock       = "SessionLock"
+	StateChangeSessionUnlock     = "SessionUnlock"
+)
+
+// SessionStateChangeTrigger starts the task when the session state changes.
+type SessionStateChangeTrigger struct {
+	TriggerBase
+	UserID      string `xml:"UserId,omitempty" json:"user_id,omitempty"`
+	Delay       string `xml:"Delay,omitempty" json:"delay,omitempty"`
+	StateChange string `xml:"StateChange" json:"state_change"`
+}
+
+// CalendarTrigger starts the task on the calendar schedule. Exactly one
+// schedule must be set.
+type CalendarTrigger struct {
+	TriggerBase
+	RandomDelay              string                    `xml:"RandomDelay,omitempty" json:"random_delay,omitempty"`
+	ScheduleByDay            *ScheduleByDay            `xml:"ScheduleByDay,omitempty" json:"schedule_by_day,omitempty"`
+	ScheduleByWeek           *ScheduleByWeek           `xml:"ScheduleByWeek,omitempty" json:"schedule_by_week,omitempty"`
+	ScheduleByMonth          *ScheduleByMonth          `xml:"ScheduleByMonth,omitempty" json:"schedule_by_month,omitempty"`
+	ScheduleByMonthDayOfWeek *ScheduleByMonthDayOfWeek `xml:"ScheduleByMonthDayOfWeek,omitempty" json:"schedule_by_month_day_of_week,omitempty"`
+}
+
+// ScheduleByDay runs the task every DaysInterval days.
+type ScheduleByDay struct {
+	DaysInterval int `xml:"DaysInterval,omitempty" json:"days_interval,omitempty"`
+}
+
+// ScheduleByWeek runs the task on the days of week every WeeksInterval weeks.
+type ScheduleByWeek struct {
+	WeeksInterval int        `xml:"WeeksInterval,omitempty" json:"weeks_interval,omitempty"`
+	DaysOfWeek    DaysOfWeek `xml:"DaysOfWeek,omitempty" json:"days_of_week,omitempty"`
+}
+
+// ScheduleByMonth runs the task on the days of the months.
+type ScheduleByMonth struct {
+	DaysOfMonth *DaysOfMonth `xml:"DaysOfMonth,omitempty" json:"days_of_month,omitempty"`
+	Months      Months       `xml:"Months,omitempty" json:"months,omitempty"`
+}
+
+// ScheduleByMonthDayOfWeek runs the task on the days of week of the weeks of
+// the months.
+type ScheduleByMonthDayOfWeek struct {
+	Weeks      *Weeks     `xml:"Weeks,omitempty" json:"weeks,omitempty"`
+	DaysOfWeek DaysOfWeek `xml:"DaysOfWeek,omitempty" json:"days_of_week,omitempty"`
+	Months     Months     `xml:"Months,omitempty" json:"months,omitempty"`
+}
+
+// DaysOfMonth is the list of the days of the month (1-31 or "Last").
+type DaysOfMonth struct {
+	Day []string `xml:"Day" json:"day"`
+}
+
+// Weeks is the list of the weeks of the month (1-4 or "Last").
+type Weeks struct {
+	Week []string `xml:"Week" json:"week"`
+}
+
+// DaysOfWeek is the list of the days of week ("Monday", "Tuesday", ...).
+// The days are encoded as the empty elements.
+type DaysOfWeek []string
+
+// MarshalXML function encodes the days of week as the empty elements.
+func (o DaysOfWeek) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalEmptyElements(e, start, o)
+}
+
+// UnmarshalXML function decodes the days of week from the empty elements.
+func (o *DaysOfWeek) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalEmptyElements(d, (*[]string)(o))
+}
+
+// Months is the list of the months ("January", "February", ...). The months
+// are encoded as the empty elements.
+type Months []string
+
+// MarshalXML function encodes the months as the empty elements.
+func (o Months) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalEmptyElements(e, start, o)
+}
+
+// UnmarshalXML function decodes the months from the empty elements.
+func (o *Months) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalEmptyElements(d, (*[]string)(o))
+}
+
+// Principals is the list of the task principals.
+type Principals struct {
+	Principal []*Principal `xml:"Principal" json:"principal"`
+}
+
+// Principal is the security context the task runs under.
+type Principal struct {
+	ID                  string              `xml:"id,attr,omitempty" json:"id,omitempty"`
+	DisplayName         string              `xml:"DisplayName,omitempty" json:"display_name,omitempty"`
+	UserID              string              `xml:"UserId,omitempty" json:"user_id,omitempty"`
+	GroupID             string              `xml:"GroupId,omitempty" json:"group_id,omitempty"`
+	LogonType           string              `xml:"LogonType,omitempty" json:"logon_type,omitempty"`
+	RunLevel            string              `xml:"RunLevel,omitempty" json:"run_level,omitempty"`
+	ProcessTokenSIDType string              `xml:"ProcessTokenSidType,omitempty" json:"process_token_sid_type,omitempty"`
+	RequiredPrivileges  *RequiredPrivileges `xml:"RequiredPrivileges,omitempty" json:"required_privileges,omitempty"`
+}
+
+// RequiredPrivileges is the list of the privileges required by the task.
+type RequiredPrivileges struct {
+	Privilege []string `xml:"Privilege" json:"privilege"`
+}
+
+// Settings is the task settings.
+type Settings struct {
+	AllowStartOnDemand              *bool                `xml:"AllowStartOnDemand,omitempty" json:"allow_start_on_demand,omitempty"`
+	RestartOnFailure                *RestartOnFailure    `xml:"RestartOnFailure,omitempty" json:"restart_on_failure,omitempty"`
+	MultipleInstancesPolicy         string               `xml:"MultipleInstancesPolicy,omitempty" json:"multiple_instances_policy,omitempty"`
+	DisallowStartIfOnBatteries      *bool                `xml:"DisallowStartIfOnBatteries,omitempty" json:"disallow_start_if_on_batteries,omitempty"`
+	StopIfGoingOnBatteries          *bool                `xml:"StopIfGoingOnBatteries,omitempty" json:"stop_if_going_on_batteries,omitempty"`
+	AllowHardTerminate              *bool                `xml:"AllowHardTerminate,omitempty" json:"allow_hard_terminate,omitempty"`
+	StartWhenAvailable              *bool                `xml:"StartWhenAvailable,omitempty" json:"start_when_available,omitempty"`
+	NetworkProfileName              string               `xml:"NetworkProfileName,omitempty" json:"network_profile_name,omitempty"`
+	RunOnlyIfNetworkAvailable       *bool                `xml:"RunOnlyIfNetworkAvailable,omitempty" json:"run_only_if_network_available,omitempty"`
+	WakeToRun                       *bool                `xml:"WakeToRun,omitempty" json:"wake_to_run,omitempty"`
+	Enabled                         *bool                `xml:"Enabled,omitempty" json:"enabled,omitempty"`
+	Hidden                          *bool                `xml:"Hidden,omitempty" json:"hidden,omitempty"`
+	DeleteExpiredTaskAfter          string               `xml:"DeleteExpiredTaskAfter,omitempty" json:"delete_expired_task_after,omitempty"`
+	IdleSettings                    *IdleSettings        `xml:"IdleSettings,omitempty" json:"idle_settings,omitempty"`
+	NetworkSettings                 *NetworkSettings     `xml:"NetworkSettings,omitempty" json:"network_settings,omitempty"`
+	ExecutionTimeLimit              string               `xml:"ExecutionTimeLimit,omitempty" json:"execution_time_limit,omitempty"`
+	Priority                        *int                 `xml:"Priority,omitempty" json:"priority,omitempty"`
+	RunOnlyIfIdle                   *bool                `xml:"RunOnlyIfIdle,omitempty" json:"run_only_if_idle,omitempty"`
+	UseUnifiedSchedulingEngine      *bool                `xml:"UseUnifiedSchedulingEngine,omitempty" json:"use_unified_scheduling_engine,omitempty"`
+	DisallowStartOnRemoteAppSession *bool                `xml:"DisallowStartOnRemoteAppSession,omitempty" json:"disallow_start_on_remote_app_session,omitempty"`
+	MaintenanceSettings             *MaintenanceSettings `xml:"MaintenanceSettings,omitempty" json:"maintenance_settings,omitempty"`
+	Volatile                        *bool                `xml:"Volatile,omitempty" json:"volatile,omitempty"`
+}
+
+// RestartOnFailure is the task restart policy.
+type RestartOnFailure struct {
+	Interval string `xml:"Interval" json:"interval"`
+	Count    int    `xml:"Count" json:"count"`
+}
+
+// IdleSettings is the task idle settings.
+type IdleSettings struct {
+	Duration      string `xml:"Duration,omitempty" json:"duration,omitempty"`
+	WaitTimeout   string `xml:"WaitTimeout,omitempty" json:"wait_timeout,omitempty"`
+	StopOnIdleEnd *bool  `xml:"StopOnIdleEnd,omitempty" json:"stop_on_idle_end,omitempty"`
+	RestartOnIdle *bool  `xml:"RestartOnIdle,omitempty" json:"restart_on_idle,omitempty"`
+}
+
+// NetworkSettings is the network the task requires.
+type NetworkSettings struct {
+	Name string `xml:"Name,omitempty" json:"name,omitempty"`
+	ID   string `xml:"Id,omitempty" json:"id,omitempty"`
+}
+
+// MaintenanceSettings is the task automatic maintenance settings.
+type MaintenanceSettings struct {
+	Period    string `xml:"Period" json:"period"`
+	Deadline  string `xml:"Deadline,omitempty" json:"deadline,omitempty"`
+	Exclusive *bool  `xml:"Exclusive,omitempty" json:"exclusive,omitempty"`
+}
+
+// Actions is the list of the task actions grouped by the action type.
+type Actions struct {
+	Context    string              `xml:"Context,attr,omitempty" json:"context,omitempty"`
+	Exec       []*ExecAction       `xml:"Exec,omitempty" json:"exec,omitempty"`
+	ComHandler []*ComHandlerAction `xml:"ComHandler,omitempty" json:"com_handler,omitempty"`
+}
+
+// ExecAction runs the command.
+type ExecAction struct {
+	ID               string `xml:"id,attr,omitempty" json:"id,omitempty"`
+	Command          string `xml:"Command" json:"command"`
+	Arguments        string `xml:"Arguments,omitempty" json:"arguments,omitempty"`
+	WorkingDirectory string `xml:"WorkingDirectory,omitempty" json:"working_directory,omitempty"`
+}
+
+// ComHandlerAction runs the COM handler.
+type ComHandlerAction struct {
+	ID      string `xml:"id,attr,omitempty" json:"id,omitempty"`
+	ClassID string `xml:"ClassId" json:"class_id"`
+	Data    string `xml:"Data,omitempty" json:"data,omitempty"`
+}
+
+// NewTask function returns the empty task definition of version 1.2 with the
+// author action context.
+func NewTask() *Task {
+	return &Task{
+		Version: TaskVersion1_2,
+		Actions: &Actions{Context: ActionContextAuthor},
+	}
+}
+
+// ParseTask function parses the task XML.
+func ParseTask(s string) (*Task, error) {
+
+	d := xml.NewDecoder(strings.NewReader(s))
+	// the XML is already decoded, but the declaration usually states UTF-16.
+	d.CharsetReader = func(charset string, r io.Reader) (io.Reader, error) { return r, nil }
+
+	task := &Task{}
+	if err := d.Decode(task); err != nil {
+		return nil, fmt.Errorf("tsch: parse task: %w", err)
+	}
+
+	return task, nil
+}
+
+// Marshal function returns the task XML.
+func (o *Task) Marshal() (string, error) {
+
+	b, err := xml.MarshalIndent(o, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("tsch: marshal task: %w", err)
+	}
+
+	return `<?xml version="1.0" encoding="UTF-16"?>` + "\n" + string(b), nil
+}
+
+// FormatDuration function formats the duration as the XML schema duration
+// (for example, PT1H30M).
+func FormatDuration(d time.Duration) string {
+
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+
+	b.WriteByte('P')
+
+	if days := d / (24 * time.Hour); days > 0 {
+		b.WriteString(strconv.FormatInt(int64(days), 10) + "D")
+		d -= days * 24 * time.Hour
+	}
+
+	if d > 0 {
+		b.WriteByte('T')
+		if h := d / time.Hour; h > 0 {
+			b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+			d -= h * time.Hour
+		}
+		if m := d / time.Minute; m > 0 {
+			b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+			d -= m * time.Minute
+		}
+		if d > 0 {
+			b.WriteString(strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+
+	return b.String()
+}
+
+// ParseDuration function parses the XML schema duration. The years and months
+// are converted using 365 and 30 days respectively.
+func ParseDuration(s string) (time.Duration, error) {
+
+	orig := s
+
+	s, neg := strings.CutPrefix(s, "-")
+
+	s, ok := strings.CutPrefix(s, "P")
+	if !ok || s == "" {
+		return 0, fmt.Errorf("tsch: invalid duration %q", orig)
+	}
+
+	var (
+		d      time.Duration
+		inTime bool
+	)
+
+	for s != "" {
+
+		if s[0] == 'T' {
+			inTime, s = true, s[1:]
+			continue
+		}
+
+		i := strings.IndexAny(s, "YMWDHS")
+		if i <= 0 {
+			return 0, fmt.Errorf("tsch: invalid duration %q", orig)
+		}
+
+		n, err := strconv.ParseFloat(s[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("tsch: invalid duration %q", orig)
+		}
+
+		var unit time.Duration
+		switch {
+		case s[i] == 'Y' && !inTime:
+			unit = 365 * 24 * time.Hour
+		case s[i] == 'M' && !inTime:
+			unit = 30 * 24 * time.Hour
+		case s[i] == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case s[i] == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case s[i] == 'H' && inTime:
+			unit = time.Hour
+		case s[i] == 'M' && inTime:
+			unit = time.Minute
+		case s[i] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("tsch: invalid duration %q", orig)
+		}
+
+		d, s = d+time.Duration(n*float64(unit)), s[i+1:]
+	}
+
+	if neg {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// FormatTime function formats the time as the StartBoundary or EndBoundary value.
+func FormatTime(t time.Time) string {
+	return t.Format("2006-01-02T15:04:05")
+}
+
+func marshalEmptyElements(e *xml.Encoder, start xml.StartElement, names []string) error {
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		el := xml.StartElement{Name: xml.Name{Local: name}}
+		if err := e.EncodeToken(el); err != nil {
+			return err
+		}
+		if err := e.EncodeToken(el.End()); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func unmarshalEmptyElements(d *xml.Decoder, names *[]string) error {
+
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			*names = append(*names, tok.Name.Local)
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
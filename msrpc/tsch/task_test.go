@@ -0,0 +1,88 @@
+package tsch
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTaskMarshal(t *testing.T) {
+
+	enabled := true
+
+	task := NewTask()
+	task.RegistrationInfo = &RegistrationInfo{Author: "author", Description: "description"}
+	task.Triggers = &Triggers{
+		Calendar: []*CalendarTrigger{{
+			TriggerBase: TriggerBase{StartBoundary: "2024-01-01T10:00:00", Enabled: &enabled},
+			ScheduleByWeek: &ScheduleByWeek{
+				WeeksInterval: 1,
+				DaysOfWeek:    DaysOfWeek{"Monday", "Friday"},
+			},
+		}},
+	}
+	task.Principals = &Principals{Principal: []*Principal{{ID: "Author", UserID: "S-1-5-18", RunLevel: RunLevelHighestAvailable}}}
+	task.Actions.Exec = append(task.Actions.Exec, &ExecAction{Command: "cmd.exe", Arguments: "/c whoami"})
+
+	xml, err := task.Marshal()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if !strings.Contains(xml, `<Task xmlns="`+TaskNamespace+`" version="1.2">`) {
+		t.Fatalf("marshal: unexpected task element: %s", xml)
+	}
+
+	if !strings.Contains(xml, "<Monday></Monday>") {
+		t.Fatalf("marshal: unexpected days of week: %s", xml)
+	}
+
+	parsed, err := ParseTask(xml)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if len(parsed.Triggers.Calendar) != 1 || parsed.Triggers.Calendar[0].ScheduleByWeek == nil {
+		t.Fatalf("parse: calendar trigger is missing")
+	}
+
+	days := parsed.Triggers.Calendar[0].ScheduleByWeek.DaysOfWeek
+	if len(days) != 2 || days[0] != "Monday" || days[1] != "Friday" {
+		t.Errorf("parse: unexpected days of week %v", days)
+	}
+
+	if parsed.Triggers.Calendar[0].StartBoundary != "2024-01-01T10:00:00" {
+		t.Errorf("parse: unexpected start boundary %q", parsed.Triggers.Calendar[0].StartBoundary)
+	}
+
+	if len(parsed.Actions.Exec) != 1 || parsed.Actions.Exec[0].Arguments != "/c whoami" || parsed.Actions.Context != ActionContextAuthor {
+		t.Errorf("parse: unexpected actions %+v", parsed.Actions)
+	}
+}
+
+func TestDuration(t *testing.T) {
+
+	for _, d := range []time.Duration{
+		0,
+		time.Minute,
+		90 * time.Minute,
+		3*24*time.Hour + 5*time.Second,
+	} {
+		s := FormatDuration(d)
+		parsed, err := ParseDuration(s)
+		if err != nil {
+			t.Fatalf("parse duration %q: %v", s, err)
+		}
+		if parsed != d {
+			t.Errorf("duration %q: expected %v, got %v", s, d, parsed)
+		}
+	}
+
+	if d, err := ParseDuration("P1W"); err != nil || d != 7*24*time.Hour {
+		t.Errorf("parse duration P1W: %v, %v", d, err)
+	}
+
+	if _, err := ParseDuration("PT1D"); err == nil {
+		t.Errorf("parse duration PT1D: expected error")
+	}
+}
@@ -83,3 +83,16 @@ func (i *Initiator) SessionKey() []byte {
 
 	return key
 }
+
+// ExportedKeys function returns the keys used by the security context
+// (see gssapi.AttributeExportedKeys).
+func (i *Initiator) ExportedKeys() []*gssapi.ExportedKey {
+
+	attr, ok := gssapi.GetAttribute(i.ctx, gssapi.AttributeExportedKeys, i.opts...)
+	if !ok {
+		return nil
+	}
+
+	keys, _ := attr.([]*gssapi.ExportedKey)
+	return keys
+}
@@ -0,0 +1,282 @@
+package smb2
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
+	"github.com/oiweiwei/go-smb2.fork"
+)
+
+// The format of the previous version (snapshot) token.
+const gmtTokenFormat = "@GMT-2006.01.02-15.04.05"
+
+// GMTToken function returns the previous version token ("@GMT-YYYY.MM.DD-HH.MM.SS")
+// for the snapshot created at time `t`.
+func GMTToken(t time.Time) string {
+	return t.UTC().Format(gmtTokenFormat)
+}
+
+// FileShare is the file share (for example C$, ADMIN$ or SYSVOL) mounted
+// over the SMB2 session. The paths are relative to the share root and can
+// use both '/' and '\' as separators.
+type FileShare struct {
+	share *smb2.Share
+	// The share name.
+	name string
+	// The previous version token prepended to the paths.
+	token string
+}
+
+// Mount function mounts the file share over the named pipe session. The
+// share reuses the named pipe SMB2 session (and the security context), so
+// no additional authentication is performed. The share must be closed
+// before the named pipe.
+func (pipe *NamedPipe) Mount(ctx context.Context, shareName string) (*FileShare, error) {
+
+	if pipe.Session == nil {
+		return nil, fmt.Errorf("mount share: %s: session is not established", shareName)
+	}
+
+	addr := ""
+	if pipe.Address != "" {
+		addr = fmt.Sprintf("%s:%d", pipe.Address, pipe.Port)
+	}
+
+	return mount(ctx, pipe.Session, shareName, addr, pipe.Observer)
+}
+
+// Mount function mounts the file share over the established SMB2 session.
+func Mount(ctx context.Context, session *smb2.Session, shareName string) (*FileShare, error) {
+	return mount(ctx, session, shareName, "", nil)
+}
+
+func mount(ctx context.Context, session *smb2.Session, shareName, addr string, o trace.Observer) (*FileShare, error) {
+
+	ev := &trace.Event{Kind: trace.KindSMBTreeConnect, Protocol: "smb2", Address: addr, Share: shareName}
+
+	_, end := trace.Start(ctx, o, ev)
+
+	share, err := session.WithContext(ctx).Mount(shareName)
+
+	end(err)
+
+	if err != nil {
+		return nil, fmt.Errorf("mount share: %s: %w", shareName, err)
+	}
+
+	return &FileShare{share: share, name: shareName}, nil
+}
+
+// Name function returns the share name.
+func (s *FileShare) Name() string {
+	return s.name
+}
+
+// WithContext function returns the file share that uses the context `ctx`
+// for the subsequent requests.
+func (s *FileShare) WithContext(ctx context.Context) *FileShare {
+	return &FileShare{share: s.share.WithContext(ctx), name: s.name, token: s.token}
+}
+
+// PreviousVersion function returns the read-only view of the share as of the
+// snapshot (shadow copy) created at time `t`. The snapshot time must match
+// the snapshot creation time exactly (see the Previous Versions tab of the
+// file properties). The modifying operations on the view fail with the
+// fs.ErrPermission.
+//
+// The token is sent as the leading path component and must be resolved by
+// the server (for example, Samba vfs_shadow_copy2). The servers that accept
+// only the SMB2_CREATE_TIMEWARP_TOKEN create context report the path as not
+// found, since go-smb2.fork does not expose the create contexts.
+func (s *FileShare) PreviousVersion(t time.Time) *FileShare {
+	return &FileShare{share: s.share, name: s.name, token: GMTToken(t)}
+}
+
+// readOnly function returns the permission error if the share is the
+// previous version view.
+func (s *FileShare) readOnly(op, name string) error {
+	if s.token != "" {
+		return &fs.PathError{Op: op, Path: name, Err: fs.ErrPermission}
+	}
+	return nil
+}
+
+// path function returns the SMB2 path for the name.
+func (s *FileShare) path(name string) string {
+
+	name = strings.Trim(strings.ReplaceAll(name, "/", "\\"), "\\")
+	if name == "." {
+		name = ""
+	}
+
+	if s.token == "" {
+		return name
+	}
+
+	if name == "" {
+		return s.token
+	}
+
+	return s.token + "\\" + name
+}
+
+// Open function opens the file or directory for reading.
+func (s *FileShare) Open(name string) (*File, error) {
+	return s.OpenFile(name, os.O_RDONLY, 0)
+}
+
+// Create function creates or truncates the file.
+func (s *FileShare) Create(name string) (*File, error) {
+	return s.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+// OpenFile function opens the file with the flags `flag` (os.O_RDONLY,
+// os.O_RDWR, os.O_CREATE, ...).
+func (s *FileShare) OpenFile(name string, flag int, perm os.FileMode) (*File, error) {
+
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		if err := s.readOnly("open", name); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := s.share.OpenFile(s.path(name), flag, perm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &File{File: f}, nil
+}
+
+// Stat function returns the file information.
+func (s *FileShare) Stat(name string) (fs.FileInfo, error) {
+	return s.share.Stat(s.path(name))
+}
+
+// ReadDir function returns the directory entries sorted by name.
+func (s *FileShare) ReadDir(name string) ([]fs.DirEntry, error) {
+
+	infos, err := s.share.ReadDir(s.path(name))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]fs.DirEntry, len(infos))
+	for i := range infos {
+		entries[i] = fs.FileInfoToDirEntry(infos[i])
+	}
+
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int { return strings.Compare(a.Name(), b.Name()) })
+
+	return entries, nil
+}
+
+// ReadFile function reads the file contents.
+func (s *FileShare) ReadFile(name string) ([]byte, error) {
+	return s.share.ReadFile(s.path(name))
+}
+
+// WriteFile function writes the data to the file, the file is created if
+// it does not exist.
+func (s *FileShare) WriteFile(name string, data []byte, perm os.FileMode) error {
+	if err := s.readOnly("write", name); err != nil {
+		return err
+	}
+	return s.share.WriteFile(s.path(name), data, perm)
+}
+
+// Mkdir function creates the directory.
+func (s *FileShare) Mkdir(name string, perm os.FileMode) error {
+	if err := s.readOnly("mkdir", name); err != nil {
+		return err
+	}
+	return s.share.Mkdir(s.path(name), perm)
+}
+
+// Remove function removes the file or the empty directory.
+func (s *FileShare) Remove(name string) error {
+	if err := s.readOnly("remove", name); err != nil {
+		return err
+	}
+	return s.share.Remove(s.path(name))
+}
+
+// FS function returns the io/fs.FS view of the share. The view implements
+// the fs.StatFS, fs.ReadDirFS and fs.ReadFileFS interfaces.
+func (s *FileShare) FS() fs.FS {
+	return &shareFS{s}
+}
+
+// Close function unmounts the share (and all its views).
+func (s *FileShare) Close() error {
+	if err := s.share.Umount(); err != nil {
+		return fmt.Errorf("unmount share: %s: %w", s.name, err)
+	}
+	return nil
+}
+
+// File is the file opened on the file share.
+type File struct {
+	*smb2.File
+}
+
+// ReadDir function returns the directory entries (see fs.ReadDirFile).
+func (f *File) ReadDir(n int) ([]fs.DirEntry, error) {
+
+	infos, err := f.Readdir(n)
+
+	entries := make([]fs.DirEntry, len(infos))
+	for i := range infos {
+		entries[i] = fs.FileInfoToDirEntry(infos[i])
+	}
+
+	return entries, err
+}
+
+// shareFS is the io/fs.FS view of the file share.
+type shareFS struct {
+	s *FileShare
+}
+
+// validPath function returns the path error if the name is not valid
+// io/fs path.
+func validPath(op, name string) error {
+	if !fs.ValidPath(name) || strings.Contains(name, "\\") {
+		return &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return nil
+}
+
+func (fsys *shareFS) Open(name string) (fs.File, error) {
+	if err := validPath("open", name); err != nil {
+		return nil, err
+	}
+	return fsys.s.Open(name)
+}
+
+func (fsys *shareFS) Stat(name string) (fs.FileInfo, error) {
+	if err := validPath("stat", name); err != nil {
+		return nil, err
+	}
+	return fsys.s.Stat(name)
+}
+
+func (fsys *shareFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if err := validPath("readdir", name); err != nil {
+		return nil, err
+	}
+	return fsys.s.ReadDir(name)
+}
+
+func (fsys *shareFS) ReadFile(name string) ([]byte, error) {
+	if err := validPath("readfile", name); err != nil {
+		return nil, err
+	}
+	return fsys.s.ReadFile(name)
+}
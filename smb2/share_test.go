@@ -0,0 +1,67 @@
+package smb2
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestFileSharePath(t *testing.T) {
+
+	ts := time.Date(2024, 3, 9, 17, 5, 1, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	if token := GMTToken(ts); token != "@GMT-2024.03.09-15.05.01" {
+		t.Fatalf("gmt token: unexpected token: %s", token)
+	}
+
+	s := &FileShare{name: "C$"}
+
+	for _, tc := range []struct {
+		share *FileShare
+		name  string
+		path  string
+	}{
+		{s, "Windows/System32/config/SAM", `Windows\System32\config\SAM`},
+		{s, `\Windows\NTDS\ntds.dit`, `Windows\NTDS\ntds.dit`},
+		{s, ".", ""},
+		{s.PreviousVersion(ts), "Windows/NTDS/ntds.dit", `@GMT-2024.03.09-15.05.01\Windows\NTDS\ntds.dit`},
+		{s.PreviousVersion(ts), ".", "@GMT-2024.03.09-15.05.01"},
+	} {
+		if p := tc.share.path(tc.name); p != tc.path {
+			t.Errorf("path: %s: expected %q, got %q", tc.name, tc.path, p)
+		}
+	}
+}
+
+func TestFileSharePreviousVersion(t *testing.T) {
+
+	s := (&FileShare{name: "C$"}).PreviousVersion(time.Date(2024, 3, 9, 15, 5, 1, 0, time.UTC))
+
+	for op, err := range map[string]error{
+		"create": func() error { _, err := s.Create("a.txt"); return err }(),
+		"append": func() error { _, err := s.OpenFile("a.txt", os.O_WRONLY|os.O_APPEND, 0); return err }(),
+		"write":  s.WriteFile("a.txt", []byte("a"), 0644),
+		"mkdir":  s.Mkdir("a", 0755),
+		"remove": s.Remove("a.txt"),
+	} {
+		if !errors.Is(err, fs.ErrPermission) {
+			t.Errorf("%s: expected permission error, got %v", op, err)
+		}
+	}
+}
+
+func TestFileShareFS(t *testing.T) {
+
+	fsys := (&FileShare{name: "C$"}).FS()
+
+	for _, name := range []string{"/Windows", "Windows/../..", `Windows\System32`, "Windows/"} {
+		if _, err := fs.Stat(fsys, name); !errors.Is(err, fs.ErrInvalid) {
+			t.Errorf("stat: %s: expected invalid path error, got %v", name, err)
+		}
+		if _, err := fs.ReadDir(fsys, name); !errors.Is(err, fs.ErrInvalid) {
+			t.Errorf("readdir: %s: expected invalid path error, got %v", name, err)
+		}
+	}
+}
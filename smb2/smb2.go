@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/oiweiwei/go-msrpc/dcerpc/trace"
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 	"github.com/oiweiwei/go-smb2.fork"
 	"github.com/rs/zerolog"
@@ -85,6 +86,9 @@ type NamedPipe struct {
 	ShareName       string
 	Name            string
 	Share           *smb2.Share
+	// The observer for session setup, tree connect and open events, if
+	// nil, the global observer is used (see trace.SetObserver).
+	Observer trace.Observer
 }
 
 func (pipe *NamedPipe) Dialect() Dialect {
@@ -104,6 +108,15 @@ func (pipe *NamedPipe) ApplicationKey() []byte {
 	return pipe.Share.ApplicationKey()
 }
 
+// ExportedKeys function returns the keys used by the SMB2 session
+// security context.
+func (pipe *NamedPipe) ExportedKeys() []*gssapi.ExportedKey {
+	if i, ok := pipe.Dialer.Initiator.(*Initiator); ok {
+		return i.ExportedKeys()
+	}
+	return nil
+}
+
 const ErrNotActive = "An instance of a named pipe cannot be found in the listening state"
 
 func (pipe *NamedPipe) dial(ctx context.Context, addr string) (net.Conn, error) {
@@ -122,28 +135,74 @@ func (pipe *NamedPipe) Connect(ctx context.Context) error {
 		return fmt.Errorf("dial smb server: %s: %w", addr, err)
 	}
 
-	pipe.Session, err = pipe.Dialer.DialContext(ctx, conn)
-	if err != nil {
+	if err = pipe.sessionSetup(ctx, addr, conn); err != nil {
 		return fmt.Errorf("open smb session: %w", err)
 	}
 
-	pipe.Share, err = pipe.Session.Mount(pipe.ShareName)
-	if err != nil {
+	if err = pipe.treeConnect(ctx, addr); err != nil {
 		return fmt.Errorf("mount share: %w", err)
 	}
 
-	for {
-		pipe.File, err = pipe.Share.OpenFile(pipe.Name, os.O_RDWR, 0666)
-		if err != nil {
+	if err = pipe.open(ctx, addr); err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+
+	return nil
+}
+
+// sessionSetup function establishes the SMB2 session.
+func (pipe *NamedPipe) sessionSetup(ctx context.Context, addr string, conn net.Conn) error {
+
+	ev := &trace.Event{Kind: trace.KindSMBSession, Protocol: "smb2", Address: addr}
+
+	_, end := trace.Start(ctx, pipe.Observer, ev)
+
+	session, err := pipe.Dialer.DialContext(ctx, conn)
+	if err == nil {
+		pipe.Session, ev.Dialect = session, session.NegotiatedDialect()
+	}
+
+	end(err)
+	return err
+}
+
+// treeConnect function connects to the share.
+func (pipe *NamedPipe) treeConnect(ctx context.Context, addr string) error {
+
+	ev := &trace.Event{Kind: trace.KindSMBTreeConnect, Protocol: "smb2", Address: addr, Share: pipe.ShareName}
+
+	_, end := trace.Start(ctx, pipe.Observer, ev)
+
+	share, err := pipe.Session.Mount(pipe.ShareName)
+	if err == nil {
+		pipe.Share = share
+	}
+
+	end(err)
+	return err
+}
+
+// open function opens the named pipe, the open is retried while the pipe
+// instance is not available.
+func (pipe *NamedPipe) open(ctx context.Context, addr string) error {
+
+	ev := &trace.Event{Kind: trace.KindSMBOpen, Protocol: "smb2", Address: addr, Share: pipe.ShareName, Pipe: pipe.Name}
+
+	_, end := trace.Start(ctx, pipe.Observer, ev)
+
+	var err error
+
+	for ; ; ev.Retries++ {
+		if pipe.File, err = pipe.Share.OpenFile(pipe.Name, os.O_RDWR, 0666); err != nil {
 			if strings.Contains(err.Error(), ErrNotActive) {
 				pipe.Logger.Err(err).Msgf("open share file %s", pipe.Name)
 				continue
 			}
-			return fmt.Errorf("open file: %w", err)
 		}
 		break
 	}
 
+	end(err)
 	return err
 }
 
@@ -0,0 +1,102 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+)
+
+// AEAD_AES_256_CBC_HMAC_SHA512_Encrypt function encrypts the message using
+// the AEAD-AES-256-CBC-HMAC-SHA512 construction used by the SAMR and LSAD.
+// The encryption and MAC keys are derived from the content encryption key
+// using the labels, the message is PKCS#7 padded and encrypted with the iv.
+// The function returns the cipher text and the authentication data.
+func AEAD_AES_256_CBC_HMAC_SHA512_Encrypt(cek, encLabel, macLabel, iv, m []byte) ([]byte, []byte, error) {
+
+	encKey, macKey, err := aeadKeys(cek, encLabel, macLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	block, err := aes.NewCipher(encKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("aead_aes_256_cbc_hmac_sha512: %w", err)
+	}
+
+	if len(iv) != aes.BlockSize {
+		return nil, nil, fmt.Errorf("aead_aes_256_cbc_hmac_sha512: invalid iv length")
+	}
+
+	pad := aes.BlockSize - len(m)%aes.BlockSize
+
+	c := make([]byte, len(m), len(m)+pad)
+	copy(c, m)
+	for i := 0; i < pad; i++ {
+		c = append(c, byte(pad))
+	}
+
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(c, c)
+
+	authData, err := HMAC(macKey, sha512.New, []byte{0x01}, iv, c, []byte{0x01})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, authData, nil
+}
+
+// AEAD_AES_256_CBC_HMAC_SHA512_Decrypt function verifies the authentication data
+// and decrypts the cipher text encrypted with AEAD_AES_256_CBC_HMAC_SHA512_Encrypt.
+func AEAD_AES_256_CBC_HMAC_SHA512_Decrypt(cek, encLabel, macLabel, iv, c, authData []byte) ([]byte, error) {
+
+	encKey, macKey, err := aeadKeys(cek, encLabel, macLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := HMAC(macKey, sha512.New, []byte{0x01}, iv, c, []byte{0x01})
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal(expected, authData) {
+		return nil, errors.New("aead_aes_256_cbc_hmac_sha512: authentication data mismatch")
+	}
+
+	block, err := aes.NewCipher(encKey)
+	if err != nil {
+		return nil, fmt.Errorf("aead_aes_256_cbc_hmac_sha512: %w", err)
+	}
+
+	if len(iv) != aes.BlockSize || len(c) == 0 || len(c)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("aead_aes_256_cbc_hmac_sha512: invalid iv or cipher text length")
+	}
+
+	m := make([]byte, len(c))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(m, c)
+
+	pad := int(m[len(m)-1])
+	if pad == 0 || pad > aes.BlockSize {
+		return nil, fmt.Errorf("aead_aes_256_cbc_hmac_sha512: invalid padding")
+	}
+
+	return m[:len(m)-pad], nil
+}
+
+func aeadKeys(cek, encLabel, macLabel []byte) ([]byte, []byte, error) {
+
+	encKey, err := HMAC(cek, sha512.New, encLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	macKey, err := HMAC(cek, sha512.New, macLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encKey[:32], macKey, nil
+}
@@ -0,0 +1,72 @@
+package crypto
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// EncryptSecret function encrypts the secret with the session key as
+// specified in MS-LSAD 5.1.2. The secret is prefixed with the length and
+// version, padded to the DES block size and encrypted using DES in ECB mode,
+// every block is encrypted with the next 7 bytes of the key. The same
+// encryption is used for the LSA secrets and the service passwords.
+func EncryptSecret(key []byte, b []byte) ([]byte, error) {
+
+	// length, version, value padded to the DES block size.
+	buf := make([]byte, 8+(len(b)+7)&^7)
+	binary.LittleEndian.PutUint32(buf[0:], uint32(len(b)))
+	binary.LittleEndian.PutUint32(buf[4:], 1)
+	copy(buf[8:], b)
+
+	return cryptSecret(key, buf, true)
+}
+
+// DecryptSecret function decrypts the secret encrypted with the session key
+// as specified in MS-LSAD 5.1.2.
+func DecryptSecret(key []byte, b []byte) ([]byte, error) {
+
+	buf, err := cryptSecret(key, b, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buf) < 8 {
+		return nil, fmt.Errorf("decrypt secret: invalid length")
+	}
+
+	if ver := binary.LittleEndian.Uint32(buf[4:]); ver != 1 {
+		return nil, fmt.Errorf("decrypt secret: invalid version %d", ver)
+	}
+
+	l := binary.LittleEndian.Uint32(buf[0:])
+	if uint64(l) > uint64(len(buf)-8) {
+		return nil, fmt.Errorf("decrypt secret: invalid length")
+	}
+
+	return buf[8 : 8+l], nil
+}
+
+// cryptSecret function encrypts or decrypts the buffer using DES in ECB mode,
+// every block is processed with the next 7 bytes of the key.
+func cryptSecret(key []byte, b []byte, encrypt bool) ([]byte, error) {
+
+	if len(key) < 7 {
+		return nil, fmt.Errorf("invalid session key length")
+	}
+
+	out := make([]byte, len(b))
+
+	for i, k := 0, 0; i < len(b); i, k = i+8, k+7 {
+
+		if k+7 > len(key) {
+			k = len(key) - k
+		}
+
+		blk := make([]byte, 8)
+		copy(blk, b[i:])
+
+		copy(out[i:], DES_ECB(key[k:k+7], blk, encrypt))
+	}
+
+	return out, nil
+}
@@ -602,6 +602,73 @@ func VerifySignatureEx(ctx context.Context, tokEx *MessageTokenEx, opts ...Optio
 	return mechEx.VerifySignatureEx(ctx, tokEx)
 }
 
+// ExportSecurityContext function returns the token that contains the keys
+// and the sequence numbers of the established security context
+// (gss_export_sec_context). The token is the secret and must be protected
+// as the session key.
+func ExportSecurityContext(ctx context.Context, _ ...Option) ([]byte, error) {
+
+	cc := fromContext(ctx)
+	if cc == nil || cc.Mechanism == nil || cc.Status != Complete {
+		return nil, ErrNoContext
+	}
+
+	exp, ok := (interface{})(cc.Mechanism).(ContextExporter)
+	if !ok {
+		return nil, ErrUnavailable
+	}
+
+	return exp.ExportSecurityContext(ctx)
+}
+
+// ImportSecurityContext function establishes the security context from
+// the token returned by ExportSecurityContext (gss_import_sec_context). The
+// mechanism is selected with the WithMechanismType option, the `isServer`
+// flag selects the role of the imported context, so the context exported
+// by the initiator can be imported by the acceptor and vice versa.
+func ImportSecurityContext(ctx context.Context, b []byte, isServer bool, opts ...Option) error {
+
+	cc := fromContext(ctx)
+	if cc == nil {
+		return ErrNoContext
+	}
+
+	cfg := MakeOptions(append(cc.DefaultOptions, opts...)...)
+
+	cc.Compatibility = cfg.Compatibility
+	cc.QoP = cfg.QoP
+	cc.Capabilities = cfg.Capabilities
+	cc.ContextTTL = cfg.ContextTTL
+	cc.TargetName = cfg.TargetName
+	cc.MechanismConfigs = cfg.MechanismConfigs
+	cc.IsServer = isServer
+
+	f := GetMechanism(ctx, cfg.MechanismType)
+	if f == nil || (cfg.MechanismType != nil && !f.Type().Equal(cfg.MechanismType)) {
+		return ContextError(ctx, BadMech, ErrBadMech)
+	}
+
+	mech, err := f.New(ctx)
+	if err != nil {
+		return ContextError(ctx, Failure, err)
+	}
+
+	imp, ok := (interface{})(mech).(ContextExporter)
+	if !ok {
+		return ContextError(ctx, Unavailable, ErrUnavailable)
+	}
+
+	if err := imp.ImportSecurityContext(ctx, b); err != nil {
+		return ContextError(ctx, Failure, err)
+	}
+
+	if cc.Mechanism = mech; cc.Capabilities == 0 {
+		cc.Capabilities = mech.Capabilities(ctx)
+	}
+
+	return ContextComplete(ctx)
+}
+
 // GetAttribute function retrieves the attribute from the security context.
 func GetAttribute(ctx context.Context, attrName string, _ ...Option) (any, bool) {
 
@@ -615,6 +682,23 @@ func GetAttribute(ctx context.Context, attrName string, _ ...Option) (any, bool)
 	return attr, ok
 }
 
+// GetEffectiveSessionKey function returns the session key used by the RPC
+// interfaces (ie LSA, SAMR) to encrypt the secret data. For the named pipes
+// the SMB effective session key is returned, otherwise the session key of
+// the security context.
+func GetEffectiveSessionKey(ctx context.Context, opts ...Option) ([]byte, bool) {
+
+	for _, attrName := range []string{AttributeSMBEffectiveSessionKey, AttributeSessionKey} {
+		if attr, ok := GetAttribute(ctx, attrName, opts...); ok {
+			if key, _ := attr.([]byte); len(key) > 0 {
+				return key, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
 // SetAttribute function sets the attribute to the current security context.
 func SetAttribute(ctx context.Context, attrName string, attrValue any, _ ...Option) {
 
@@ -113,8 +113,25 @@ var (
 	AttributeSMBApplicationKey = "smb_application_key"
 	// The effective session key ie for the LSA.
 	AttributeSMBEffectiveSessionKey = "smb_effective_session_key"
+	// The keys used by the established security context that can be
+	// exported to the traffic analysis tools ([]*ExportedKey).
+	AttributeExportedKeys = "exported_keys"
 )
 
+// ExportedKey is the key used by the security context in the form of the
+// keytab entry.
+type ExportedKey struct {
+	// The principal name components.
+	Principal []string
+	// The principal realm (domain).
+	Realm string
+	// The encryption type (RFC 3961), the NT hash is exported as
+	// RC4-HMAC (23) key.
+	EncType int32
+	// The key value.
+	Key []byte
+}
+
 // The GSSAPI call option.
 type Config struct {
 	// The security compatibility parameter. (NTLM).
@@ -86,3 +86,16 @@ type MechanismEx interface {
 	// VerifySignature token.
 	VerifySignatureEx(context.Context, *MessageTokenEx) error
 }
+
+// ContextExporter is the optional interface implemented by the mechanism
+// that can transfer the established security context (see
+// ExportSecurityContext).
+type ContextExporter interface {
+	// ExportSecurityContext returns the token that contains the keys and
+	// the sequence numbers of the established security context.
+	ExportSecurityContext(context.Context) ([]byte, error)
+	// ImportSecurityContext establishes the security context from the
+	// token returned by ExportSecurityContext. The token exported by the
+	// initiator can be imported by the acceptor and vice versa.
+	ImportSecurityContext(context.Context, []byte) error
+}
@@ -110,6 +110,14 @@ func (a *Authentifier) makeClient(ctx context.Context) (*client.Client, error) {
 			a.Config.GetKRB5Config(), a.Config.ClientSettings()...)
 	}
 
+	if len(a.Config.EncryptionTypes) > 0 {
+		etypes := parseETypes(a.Config.EncryptionTypes, true)
+		if len(etypes) == 0 {
+			return nil, fmt.Errorf("no supported encryption types: %v", a.Config.EncryptionTypes)
+		}
+		setEnctype(cli, etypes...)
+	}
+
 	switch cred := credential.V8ToV9(a.Config.Credential).(type) {
 	case credential.Password:
 		cli.Credentials = creds.WithPassword(cred.Password())
@@ -148,10 +156,10 @@ func (a *Authentifier) makeClient(ctx context.Context) (*client.Client, error) {
 	return cli, nil
 }
 
-func setEnctype(cli *client.Client, etype int32) {
-	cli.Config.LibDefaults.DefaultTGSEnctypeIDs = []int32{etype}
-	cli.Config.LibDefaults.DefaultTktEnctypeIDs = []int32{etype}
-	cli.Config.LibDefaults.DefaultTktEnctypeIDs = []int32{etype}
+func setEnctype(cli *client.Client, etypes ...int32) {
+	cli.Config.LibDefaults.DefaultTGSEnctypeIDs = etypes
+	cli.Config.LibDefaults.DefaultTktEnctypeIDs = etypes
+	cli.Config.LibDefaults.PermittedEnctypeIDs = etypes
 }
 
 // makeSecurityService function sets up the security service for
@@ -174,6 +182,13 @@ func (a *Authentifier) makeSecurityService(ctx context.Context) error {
 		}
 	}
 
+	return a.makeCiphers(ctx)
+}
+
+// makeCiphers function sets up the inbound and outbound ciphers for the
+// security service key.
+func (a *Authentifier) makeCiphers(ctx context.Context) error {
+
 	eType, err := krb_crypto.GetEtype(a.state.Key.KeyType)
 	if err != nil {
 		return fmt.Errorf("get etype: %w", err)
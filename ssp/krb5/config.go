@@ -47,6 +47,11 @@ type Config struct {
 
 	// common.
 	DCEStyle bool
+	// EncryptionTypes is the list of the encryption type names (for
+	// example, "aes256-cts-hmac-sha384-192") used for the ticket and
+	// session key negotiation. Overrides the default_tkt_enctypes,
+	// default_tgs_enctypes and permitted_enctypes of the kerberos config.
+	EncryptionTypes []string
 
 	// service settings.
 
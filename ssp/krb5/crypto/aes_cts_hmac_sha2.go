@@ -0,0 +1,325 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/oiweiwei/go-msrpc/ssp/crypto"
+	"github.com/oiweiwei/gokrb5.fork/v9/crypto/common"
+	"github.com/oiweiwei/gokrb5.fork/v9/iana/keyusage"
+)
+
+// AESCTSHMACSHA2 represents the integrity/confidentiality routines
+// for the aes128-cts-hmac-sha256-128 and aes256-cts-hmac-sha384-192 (RFC 8009).
+//
+// Unlike the RFC 3962 encryption types, the integrity checksum is computed
+// over the cipher state and the ciphertext (encrypt-then-MAC). As in the MIT
+// implementation of the IOV wrap, the signed buffers are included into the
+// checksum in the order of the buffers: the sealed buffers as ciphertext and
+// the sign-only buffers (DCE/RPC header signing) as plaintext.
+type AESCTSHMACSHA2 struct {
+	*AESCTSHMACSHA1
+}
+
+func NewAESSHA2Cipher(ctx context.Context, setting CipherSetting) (Cipher, error) {
+	return &AESCTSHMACSHA2{&AESCTSHMACSHA1{setting: setting}}, nil
+}
+
+// rrc function returns the right rotation count, which covers the encrypted
+// header and the checksum.
+func (c *AESCTSHMACSHA2) rrc() int {
+	return 16 /* E"header" */ + c.setting.Type.GetHMACBitLength()/8
+}
+
+func (c *AESCTSHMACSHA2) Wrap(ctx context.Context, seqNum uint64, payload []byte, conf bool) ([]byte, error) {
+	if !conf {
+		b, err := c.wrapIntegrity(ctx, seqNum, payload)
+		if err != nil {
+			return nil, fmt.Errorf("aes-cts-hmac-sha2: wrap: %w", err)
+		}
+		return b, nil
+	}
+
+	b, err := c.wrap(ctx, seqNum, [][]byte{payload}, [][]byte{payload}, false)
+	if err != nil {
+		return nil, fmt.Errorf("aes-cts-hmac-sha2: wrap: %w", err)
+	}
+	return b, nil
+}
+
+func (c *AESCTSHMACSHA2) WrapEx(ctx context.Context, seqNum uint64, forSign, forSeal [][]byte) ([]byte, error) {
+	b, err := c.wrap(ctx, seqNum, forSign, forSeal, true)
+	if err != nil {
+		return nil, fmt.Errorf("aes-cts-hmac-sha2: wrap_ex: %w", err)
+	}
+	return b, nil
+}
+
+func (c *AESCTSHMACSHA2) wrap(ctx context.Context, seqNum uint64, forSign, forSeal [][]byte, isEx bool) ([]byte, error) {
+
+	eB, hdr := bytes.NewBuffer(nil), c.WrapHeader(ctx, seqNum)
+
+	// gen confounder.
+	confounder := make([]byte, c.setting.Type.GetConfounderByteSize())
+	if _, err := rand.Read(confounder); err != nil {
+		return nil, fmt.Errorf("read confounder: %w", err)
+	}
+
+	ec := EC
+
+	if !isEx /* it's a Wrap call, compute EC according to RFC 4121, section 4.3.2. */ {
+		sz, block := 0, c.setting.Type.GetMessageBlockByteSize()
+		for _, b := range forSeal {
+			sz += len(b)
+		}
+		if ec = (block - (sz % block)) % block; sz == 0 {
+			ec = 16 // if the payload is empty, EC is 16 (the block size).
+		}
+	}
+
+	// gen ec.
+	ecB := bytes.Repeat([]byte{0xFF}, ec)
+	// set ec value.
+	binary.BigEndian.PutUint16(hdr[4:6], uint16(ec))
+
+	key, err := c.DeriveEncryptionKey()
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+
+	if err := crypto.WriteHash(eB, confounder, forSeal, ecB, hdr); err != nil {
+		return nil, fmt.Errorf("write encryption buffers: %w", err)
+	}
+
+	_, b, err := c.setting.Type.EncryptData(key, eB.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("encrypt data: %w", err)
+	}
+
+	// split { E"confounder" | E"data" | E"ec | header" }.
+	eConfounder, b := b[:len(confounder)], b[len(confounder):]
+	for i := range forSeal {
+		b = b[copy(forSeal[i], b):]
+	}
+
+	iH, err := c.IntegrityHash()
+	if err != nil {
+		return nil, fmt.Errorf("make integrity hash: %w", err)
+	}
+
+	// the checksum is computed over the cipher state and the ciphertext
+	// (RFC 8009, section 5): { E"confounder" | signed buffers | E"ec | header" },
+	// the sealed buffers of forSign hold the ciphertext at this point.
+	if err := crypto.WriteHash(iH, make([]byte, aes.BlockSize) /* cipher state */, eConfounder, forSign, b); err != nil {
+		return nil, fmt.Errorf("write hash: %w", err)
+	}
+
+	// rotate { E"confounder" | E"data" | E"ec | header" | mic } ->
+	//        { E"ec | header" | mic | E"confounder" | E"data" }
+	sgn := make([]byte, 0, ec+c.rrc()+len(confounder))
+	sgn = append(iH.Sum(append(sgn, b...)), eConfounder...)
+
+	// set rrc value.
+	binary.BigEndian.PutUint16(hdr[6:8], uint16(c.rrc()))
+
+	return append(hdr, sgn...), nil
+}
+
+func (c *AESCTSHMACSHA2) Unwrap(ctx context.Context, seqNum uint64, payload []byte, sgn []byte) ([]byte, bool, error) {
+
+	var err error
+
+	if len(sgn) == 0 {
+		if sgn, payload, err = c.ParseSignature(ctx, payload); err != nil {
+			return nil, false, fmt.Errorf("aes-cts-hmac-sha2: unwrap: parse token: %w", err)
+		}
+	}
+
+	unwrap := c.unwrapEx
+	if len(sgn) > 2 && sgn[2]&CFXFlagSealed == 0 {
+		unwrap = c.unwrapIntegrity
+	}
+
+	ok, err := unwrap(ctx, seqNum, [][]byte{payload}, [][]byte{payload}, sgn)
+	if err != nil {
+		return nil, false, fmt.Errorf("aes-cts-hmac-sha2: unwrap: %w", err)
+	}
+	return sgn, ok, nil
+}
+
+// ParseSignature parses the header of the signature and returns the header and the remaining of the signature.
+func (c *AESCTSHMACSHA2) ParseSignature(ctx context.Context, payload []byte) ([]byte, []byte, error) {
+
+	if len(payload) < 16 || payload[2]&CFXFlagSealed != 0 {
+		return c.AESCTSHMACSHA1.ParseSignature(ctx, payload)
+	}
+
+	// { header | mic } for the token without confidentiality.
+	sgn := 16 /* hdr */ + int(binary.BigEndian.Uint16(payload[6:]))
+	if len(payload) < sgn {
+		return nil, nil, fmt.Errorf("invalid payload size: %d < %d", len(payload), sgn)
+	}
+
+	return payload[:sgn], payload[sgn:], nil
+}
+
+// wrapIntegrity function returns the wrap token without confidentiality
+// (RFC 4121, section 4.2.4): the checksum is computed over the plaintext and
+// the header with zero EC and RRC, and rotated in front of the plaintext.
+func (c *AESCTSHMACSHA2) wrapIntegrity(ctx context.Context, seqNum uint64, payload []byte) ([]byte, error) {
+
+	hdr := c.WrapHeader(ctx, seqNum)
+	hdr[2] &^= CFXFlagSealed
+
+	cksum, err := c.integrityChecksum(payload, hdr)
+	if err != nil {
+		return nil, err
+	}
+
+	// set ec and rrc values, rotate { data | mic } -> { mic | data }.
+	binary.BigEndian.PutUint16(hdr[4:6], uint16(len(cksum)))
+	binary.BigEndian.PutUint16(hdr[6:8], uint16(len(cksum)))
+
+	return append(hdr, cksum...), nil
+}
+
+func (c *AESCTSHMACSHA2) unwrapIntegrity(ctx context.Context, seqNum uint64, forSign, _ [][]byte, sgn []byte) (bool, error) {
+
+	if len(sgn) < 16 {
+		return false, fmt.Errorf("invalid signature size: %d < 16", len(sgn))
+	}
+
+	hdr := append([]byte(nil), sgn[:16]...)
+
+	rrc, ec := int(binary.BigEndian.Uint16(hdr[6:])), int(binary.BigEndian.Uint16(hdr[4:]))
+
+	if cksumSize := c.setting.Type.GetHMACBitLength() / 8; ec != cksumSize || rrc != ec || len(sgn) != 16+ec {
+		return false, fmt.Errorf("invalid signature: ec %d, rrc %d, size %d", ec, rrc, len(sgn))
+	}
+
+	// zero ec and rrc values.
+	clear(hdr[4:8])
+
+	cksum, err := c.integrityChecksum(forSign, hdr)
+	if err != nil {
+		return false, err
+	}
+
+	return hmac.Equal(cksum, sgn[16:]), nil
+}
+
+// integrityChecksum function returns the checksum of the wrap token without
+// confidentiality, the checksum key is derived with the seal key usage.
+func (c *AESCTSHMACSHA2) integrityChecksum(data any, hdr []byte) ([]byte, error) {
+
+	ecU := keyusage.GSSAPI_INITIATOR_SEAL
+	if !c.setting.IsLocal {
+		ecU = keyusage.GSSAPI_ACCEPTOR_SEAL
+	}
+
+	ckH, err := c.newHash(common.GetUsageKc(uint32(ecU)))
+	if err != nil {
+		return nil, fmt.Errorf("make checksum hash: %w", err)
+	}
+
+	if err := crypto.WriteHash(ckH, data, hdr); err != nil {
+		return nil, fmt.Errorf("write hash: %w", err)
+	}
+
+	return ckH.Sum(nil), nil
+}
+
+func (c *AESCTSHMACSHA2) UnwrapEx(ctx context.Context, seqNum uint64, forSign, forSeal [][]byte, sgn []byte) (bool, error) {
+	ok, err := c.unwrapEx(ctx, seqNum, forSign, forSeal, sgn)
+	if err != nil {
+		return ok, fmt.Errorf("aes-cts-hmac-sha2: unwrap_ex: %w", err)
+	}
+	return ok, nil
+}
+
+func (c *AESCTSHMACSHA2) unwrapEx(ctx context.Context, seqNum uint64, forSign, forSeal [][]byte, sgn []byte) (bool, error) {
+
+	if len(sgn) < 16 {
+		return false, fmt.Errorf("invalid signature size: %d < 16", len(sgn))
+	}
+
+	// buffer for decryption.
+	eB, hdr := bytes.NewBuffer(nil), sgn[:16]
+
+	rrc, ec := int(binary.BigEndian.Uint16(hdr[6:])), int(binary.BigEndian.Uint16(hdr[4:]))
+
+	confSize, cksumSize := c.setting.Type.GetConfounderByteSize(), c.setting.Type.GetHMACBitLength()/8
+
+	sgnSize := ec + rrc + len(hdr) + confSize
+
+	if len(sgn) < sgnSize {
+		return false, fmt.Errorf("invalid signature size: %d < %d", len(sgn), sgnSize)
+	}
+
+	if err := crypto.WriteHash(eB, sgn[16:sgnSize], forSeal); err != nil {
+		return false, fmt.Errorf("write encryption buffers: %w", err)
+	}
+
+	// rotate { E"ec | header" | mic | E"confounder" | E"data" } ->
+	//        { E"confounder" | E"data" | E"ec | header" | mic }
+	b := Rotate(eB.Bytes(), -(rrc + ec))
+
+	if len(b) < confSize+ec+16+cksumSize {
+		return false, fmt.Errorf("invalid token size: %d < %d", len(b), confSize+ec+16+cksumSize)
+	}
+
+	// trim mic.
+	b, cksum := b[:len(b)-cksumSize], b[len(b)-cksumSize:]
+
+	iH, err := c.IntegrityHash()
+	if err != nil {
+		return false, fmt.Errorf("make integrity hash: %w", err)
+	}
+
+	// the sealed buffers of forSign hold the ciphertext at this point.
+	if err := crypto.WriteHash(iH, make([]byte, aes.BlockSize) /* cipher state */, b[:confSize], forSign, b[len(b)-ec-16:]); err != nil {
+		return false, fmt.Errorf("write hash: %w", err)
+	}
+
+	if !hmac.Equal(iH.Sum(nil), cksum) {
+		return false, nil
+	}
+
+	key, err := c.DeriveEncryptionKey()
+	if err != nil {
+		return false, fmt.Errorf("derive key: %w", err)
+	}
+
+	if b, err = c.setting.Type.DecryptData(key, b); err != nil {
+		return false, fmt.Errorf("decrypt data: %w", err)
+	}
+
+	b = b[confSize:]
+	for i := range forSeal {
+		// decrypt the encrypred data.
+		b = b[copy(forSeal[i], b):]
+	}
+
+	return true, nil
+}
+
+func (c *AESCTSHMACSHA2) MakeSignature(ctx context.Context, seqNum uint64, forSgn [][]byte) ([]byte, error) {
+	b, err := c.makeSignature(ctx, seqNum, forSgn)
+	if err != nil {
+		return nil, fmt.Errorf("aes-cts-hmac-sha2: make signature: %w", err)
+	}
+	return b, nil
+}
+
+func (c *AESCTSHMACSHA2) Size(ctx context.Context, conf bool) int {
+	sz := (16 /* hdr */ + c.setting.Type.GetHMACBitLength()/8 /* cksum */)
+	if conf {
+		sz += (16 /* confounder */ + 16 /* E"header" */ + 16 /* ec */)
+	}
+	return sz
+}
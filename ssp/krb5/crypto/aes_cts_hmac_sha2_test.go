@@ -0,0 +1,178 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	krb_crypto "github.com/oiweiwei/gokrb5.fork/v9/crypto"
+	"github.com/oiweiwei/gokrb5.fork/v9/crypto/common"
+	"github.com/oiweiwei/gokrb5.fork/v9/iana/etypeID"
+	"github.com/oiweiwei/gokrb5.fork/v9/iana/keyusage"
+	"github.com/oiweiwei/gokrb5.fork/v9/types"
+)
+
+func TestAESCTSHMACSHA2WrapEx(t *testing.T) {
+
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		id int32
+		// RFC 8009, Appendix A: the base key and the ciphertext (AES
+		// output and HMAC output) of 000102030405 for the key usage 2.
+		key, ciphertext string
+	}{
+		{
+			etypeID.AES128_CTS_HMAC_SHA256_128,
+			"3705d96080c17728a0e800eab6e0d23c",
+			"84d7f30754ed987bab0bf3506beb09cfb55402cef7e6877ce99e247e52d16ed4421dfdf8976c",
+		},
+		{
+			etypeID.AES256_CTS_HMAC_SHA384_192,
+			"6d404d37faf79f9df0d33568d320669800eb4836472ea8a026d16b7182460c52",
+			"4ed7b37c2bcac8f74f23c1cf07e62bc7b75fb3f637b9f559c7f664f69eab7b6092237526ea0d1f61cb20d69d10f2",
+		},
+	} {
+
+		eType, err := krb_crypto.GetEtype(tc.id)
+		if err != nil {
+			t.Fatalf("get etype %d: %v", tc.id, err)
+		}
+
+		keyValue, _ := hex.DecodeString(tc.key)
+		ciphertext, _ := hex.DecodeString(tc.ciphertext)
+
+		if b, err := eType.DecryptMessage(keyValue, ciphertext, 2); err != nil || !bytes.Equal(b, []byte{0, 1, 2, 3, 4, 5}) {
+			t.Fatalf("%d: rfc 8009 vector: %x, %v", tc.id, b, err)
+		}
+
+		key := types.EncryptionKey{KeyType: tc.id, KeyValue: keyValue}
+
+		local, _ := NewCipher(ctx, CipherSetting{Type: eType, Key: key, IsLocal: true, DCEStyle: true})
+		remote, _ := NewCipher(ctx, CipherSetting{Type: eType, Key: key, IsLocal: true, DCEStyle: true})
+
+		hdr, body, trailer := []byte("header"), []byte("the quick brown fox jumps over the lazy dog"), []byte("trailer")
+		plain := append([]byte(nil), body...)
+
+		sgn, err := local.WrapEx(ctx, 1, [][]byte{body}, [][]byte{body})
+		if err != nil {
+			t.Fatalf("%d: wrap_ex: %v", tc.id, err)
+		}
+
+		if len(sgn) != local.Size(ctx, true) {
+			t.Errorf("%d: wrap_ex: unexpected signature size %d != %d", tc.id, len(sgn), local.Size(ctx, true))
+		}
+
+		// { header | E"ec | header" | mic | E"confounder" } + E"data" ->
+		// { E"confounder" | E"data" | E"ec | header" | mic } is the RFC 8009
+		// ciphertext of { confounder | data | ec | header (rrc = 0) }.
+		rrc := int(binary.BigEndian.Uint16(sgn[6:8]))
+		ct := append(append(append([]byte(nil), sgn[16+rrc+EC:]...), body...), sgn[16:16+rrc+EC]...)
+
+		b, err := eType.DecryptMessage(keyValue, ct, keyusage.GSSAPI_INITIATOR_SEAL)
+		if err != nil {
+			t.Fatalf("%d: wrap_ex: decrypt message: %v", tc.id, err)
+		}
+
+		expected := append(append(append([]byte(nil), plain...), bytes.Repeat([]byte{0xFF}, EC)...), sgn[:16]...)
+		expected[len(expected)-10], expected[len(expected)-9] = 0, 0
+
+		if !bytes.Equal(b, expected) {
+			t.Fatalf("%d: wrap_ex: unexpected plaintext %x", tc.id, b)
+		}
+
+		ok, err := remote.UnwrapEx(ctx, 1, [][]byte{body}, [][]byte{body}, sgn)
+		if err != nil || !ok {
+			t.Fatalf("%d: unwrap_ex: %v, %v", tc.id, ok, err)
+		}
+
+		if !bytes.Equal(body, plain) {
+			t.Errorf("%d: unwrap_ex: unexpected body %q", tc.id, body)
+		}
+
+		// the header-signed call: the sign-only buffers are included into
+		// the checksum in the order of the buffers.
+		sgn, err = local.WrapEx(ctx, 2, [][]byte{hdr, body, trailer}, [][]byte{body})
+		if err != nil {
+			t.Fatalf("%d: wrap_ex: header sign: %v", tc.id, err)
+		}
+
+		ki, _ := eType.DeriveKey(keyValue, common.GetUsageKi(keyusage.GSSAPI_INITIATOR_SEAL))
+		cksumSize := eType.GetHMACBitLength() / 8
+
+		// { header | E"ec | header" | mic | E"confounder" }.
+		eTrailer, mic, eConfounder := sgn[16:16+EC+16], sgn[16+EC+16:16+EC+16+cksumSize], sgn[16+EC+16+cksumSize:]
+
+		h := hmac.New(eType.GetHashFunc(), ki)
+		for _, b := range [][]byte{make([]byte, 16), eConfounder, hdr, body, trailer, eTrailer} {
+			h.Write(b)
+		}
+
+		if !bytes.Equal(h.Sum(nil)[:cksumSize], mic) {
+			t.Errorf("%d: wrap_ex: header sign: unexpected checksum %x", tc.id, mic)
+		}
+
+		ok, err = remote.UnwrapEx(ctx, 2, [][]byte{hdr, body, trailer}, [][]byte{body}, sgn)
+		if err != nil || !ok || !bytes.Equal(body, plain) {
+			t.Fatalf("%d: unwrap_ex: header sign: %v, %v", tc.id, ok, err)
+		}
+
+		sgn, _ = local.WrapEx(ctx, 3, [][]byte{hdr, body, trailer}, [][]byte{body})
+		hdr[0] ^= 0x01
+
+		if ok, err := remote.UnwrapEx(ctx, 3, [][]byte{hdr, body, trailer}, [][]byte{body}, sgn); err != nil || ok {
+			t.Errorf("%d: unwrap_ex: header sign: tampered header is accepted: %v", tc.id, err)
+		}
+
+		// the wrap token without confidentiality (RFC 4121, section 4.2.4).
+		payload := append([]byte(nil), plain...)
+
+		token, err := local.Wrap(ctx, 4, payload, false)
+		if err != nil {
+			t.Fatalf("%d: wrap: integrity: %v", tc.id, err)
+		}
+
+		if !bytes.Equal(payload, plain) || len(token) != local.Size(ctx, false) || token[2]&CFXFlagSealed != 0 {
+			t.Fatalf("%d: wrap: integrity: unexpected token %x", tc.id, token)
+		}
+
+		kc, _ := eType.DeriveKey(keyValue, common.GetUsageKc(keyusage.GSSAPI_INITIATOR_SEAL))
+		zero := append([]byte(nil), token[:16]...)
+		clear(zero[4:8])
+
+		h = hmac.New(eType.GetHashFunc(), kc)
+		h.Write(payload)
+		h.Write(zero)
+
+		if !bytes.Equal(h.Sum(nil)[:cksumSize], token[16:]) {
+			t.Errorf("%d: wrap: integrity: unexpected checksum %x", tc.id, token[16:])
+		}
+
+		if _, ok, err := remote.Unwrap(ctx, 4, append(token, payload...), nil); err != nil || !ok {
+			t.Errorf("%d: unwrap: integrity: %v, %v", tc.id, ok, err)
+		}
+
+		payload[0] ^= 0x01
+
+		if _, ok, err := remote.Unwrap(ctx, 4, payload, token); err != nil || ok {
+			t.Errorf("%d: unwrap: integrity: tampered payload is accepted: %v", tc.id, err)
+		}
+
+		payload = append([]byte(nil), plain...)
+
+		token, err = local.Wrap(ctx, 5, payload, true)
+		if err != nil {
+			t.Fatalf("%d: wrap: %v", tc.id, err)
+		}
+
+		token = append(token, payload...)
+		token[len(token)-1] ^= 0x01
+
+		if _, ok, err := remote.Unwrap(ctx, 5, token, nil); err != nil || ok {
+			t.Errorf("%d: unwrap: tampered token is accepted: %v", tc.id, err)
+		}
+	}
+}
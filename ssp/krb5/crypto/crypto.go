@@ -36,6 +36,8 @@ func NewCipher(ctx context.Context, setting CipherSetting) (Cipher, error) {
 	switch setting.Type.GetETypeID() {
 	case etypeID.AES128_CTS_HMAC_SHA1_96, etypeID.AES256_CTS_HMAC_SHA1_96:
 		return NewAESCipher(ctx, setting)
+	case etypeID.AES128_CTS_HMAC_SHA256_128, etypeID.AES256_CTS_HMAC_SHA384_192:
+		return NewAESSHA2Cipher(ctx, setting)
 	case etypeID.RC4_HMAC:
 		return NewRC4Cipher(ctx, setting)
 	case etypeID.DES_CBC_MD5, etypeID.DES_CBC_CRC:
@@ -3,8 +3,11 @@ package krb5
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/oiweiwei/gokrb5.fork/v9/iana/flags"
+	"github.com/oiweiwei/gokrb5.fork/v9/types"
 
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 )
@@ -134,6 +137,7 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.Config.SName)
+		gssapi.SetAttribute(ctx, gssapi.AttributeExportedKeys, m.exportedKeys())
 
 		if !m.Config.DCEStyle && m.Config.FlagIsSet(gssapi.MutualAuthn) {
 			// return empty apreply for non-dce style mutual authentication.
@@ -158,6 +162,7 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.Config.SName)
+		gssapi.SetAttribute(ctx, gssapi.AttributeExportedKeys, m.exportedKeys())
 
 		return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
 	}
@@ -165,6 +170,104 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 	return &gssapi.Token{Payload: b}, gssapi.ContextContinueNeeded(ctx)
 }
 
+// exportedKeys function returns the ticket session key and the negotiated
+// sub-session key (if any).
+func (m *Mechanism) exportedKeys() []*gssapi.ExportedKey {
+
+	var (
+		sname []string
+		realm string
+	)
+
+	if m.APReq != nil {
+		sname, realm = m.APReq.Ticket.SName.NameString, m.APReq.Ticket.Realm
+	}
+
+	keys := []*gssapi.ExportedKey{}
+
+	if len(m.SessionKey.KeyValue) > 0 {
+		keys = append(keys, &gssapi.ExportedKey{Principal: sname, Realm: realm, EncType: m.SessionKey.KeyType, Key: m.SessionKey.KeyValue})
+	}
+
+	if m.state != nil && m.state.IsSubKey && len(m.state.Key.KeyValue) > 0 {
+		keys = append(keys, &gssapi.ExportedKey{Principal: sname, Realm: realm, EncType: m.state.Key.KeyType, Key: m.state.Key.KeyValue})
+	}
+
+	return keys
+}
+
+// exportedContext is the established security context state.
+type exportedContext struct {
+	KeyType                 int32  `json:"key_type"`
+	Key                     []byte `json:"key"`
+	IsSubKey                bool   `json:"is_sub_key,omitempty"`
+	DCEStyle                bool   `json:"dce_style,omitempty"`
+	Flags                   []int  `json:"flags,omitempty"`
+	InitiatorSequenceNumber uint64 `json:"initiator_sequence_number"`
+	AcceptorSequenceNumber  uint64 `json:"acceptor_sequence_number"`
+}
+
+// ExportSecurityContext function returns the security service key and the
+// sequence numbers of the established security context.
+func (m *Mechanism) ExportSecurityContext(ctx context.Context) ([]byte, error) {
+
+	if m.state == nil {
+		return nil, fmt.Errorf("krb5: export security context: %w", gssapi.ErrNoContext)
+	}
+
+	exp := &exportedContext{
+		KeyType:                 m.state.Key.KeyType,
+		Key:                     m.state.Key.KeyValue,
+		IsSubKey:                m.state.IsSubKey,
+		DCEStyle:                m.Config.DCEStyle,
+		Flags:                   m.Config.Flags,
+		InitiatorSequenceNumber: m.state.OutboundSequenceNumber,
+		AcceptorSequenceNumber:  m.state.InboundSequenceNumber,
+	}
+
+	if m.Config.IsServer {
+		exp.InitiatorSequenceNumber, exp.AcceptorSequenceNumber = exp.AcceptorSequenceNumber, exp.InitiatorSequenceNumber
+	}
+
+	b, err := json.Marshal(exp)
+	if err != nil {
+		return nil, fmt.Errorf("krb5: export security context: %w", err)
+	}
+
+	return b, nil
+}
+
+// ImportSecurityContext function establishes the security context from the
+// exported security service key and sequence numbers.
+func (m *Mechanism) ImportSecurityContext(ctx context.Context, b []byte) error {
+
+	exp := &exportedContext{}
+	if err := json.Unmarshal(b, exp); err != nil {
+		return fmt.Errorf("krb5: import security context: %w", err)
+	}
+
+	m.Config.DCEStyle, m.Config.Flags = exp.DCEStyle, exp.Flags
+
+	m.state = &SecurityService{
+		Key:                    types.EncryptionKey{KeyType: exp.KeyType, KeyValue: exp.Key},
+		IsSubKey:               exp.IsSubKey,
+		OutboundSequenceNumber: exp.InitiatorSequenceNumber,
+		InboundSequenceNumber:  exp.AcceptorSequenceNumber,
+	}
+
+	if m.Config.IsServer {
+		m.state.OutboundSequenceNumber, m.state.InboundSequenceNumber = m.state.InboundSequenceNumber, m.state.OutboundSequenceNumber
+	}
+
+	if err := m.makeCiphers(ctx); err != nil {
+		return fmt.Errorf("krb5: import security context: %w", err)
+	}
+
+	gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
+
+	return nil
+}
+
 func (m *Mechanism) Capabilities(ctx context.Context) gssapi.Cap {
 
 	caps := gssapi.Cap(0)
@@ -215,6 +318,7 @@ func (m *Mechanism) Accept(ctx context.Context, tok *gssapi.Token) (*gssapi.Toke
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.Config.SName)
+		gssapi.SetAttribute(ctx, gssapi.AttributeExportedKeys, m.exportedKeys())
 
 		return &gssapi.Token{}, gssapi.ContextComplete(ctx)
 	}
@@ -228,6 +332,7 @@ func (m *Mechanism) Accept(ctx context.Context, tok *gssapi.Token) (*gssapi.Toke
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.Config.SName)
+		gssapi.SetAttribute(ctx, gssapi.AttributeExportedKeys, m.exportedKeys())
 
 		return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
 	}
@@ -241,6 +346,7 @@ func (m *Mechanism) Accept(ctx context.Context, tok *gssapi.Token) (*gssapi.Toke
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.ExportedSessionKey)
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.Config.SName)
+		gssapi.SetAttribute(ctx, gssapi.AttributeExportedKeys, m.exportedKeys())
 
 		return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
 	}
@@ -433,6 +539,7 @@ func (m *Mechanism) VerifySignatureEx(ctx context.Context, tokEx *gssapi.Message
 }
 
 var (
-	_ gssapi.Mechanism   = (*Mechanism)(nil)
-	_ gssapi.MechanismEx = (*Mechanism)(nil)
+	_ gssapi.Mechanism       = (*Mechanism)(nil)
+	_ gssapi.MechanismEx     = (*Mechanism)(nil)
+	_ gssapi.ContextExporter = (*Mechanism)(nil)
 )
@@ -68,3 +68,22 @@ func (a *SecureCredential) Encrypt(ctx context.Context, cred []byte) ([]byte, er
 
 	return crypto.DES_ECB(a.key[7:14], crypto.DES_ECB(a.key[:7], cred, true), true), nil
 }
+
+// Decrypt function decrypts the data encrypted with the session key (such as
+// the user session key returned in the validation information). AES-CFB8 is used
+// if AES is negotiated, otherwise RC4.
+func (a *SecureCredential) Decrypt(ctx context.Context, b []byte) ([]byte, error) {
+
+	if a.caps.IsSet(CapAES_SHA2) {
+		return crypto.AES_CFB(a.key, make([]byte, 16), b, true), nil
+	}
+
+	out := make([]byte, len(b))
+	copy(out, b)
+
+	if err := crypto.RC4K(a.key, out); err != nil {
+		return nil, fmt.Errorf("decrypt: %v", err)
+	}
+
+	return out, nil
+}
@@ -322,7 +322,9 @@ func (a *Authentifier) VerifyAuthenticate(ctx context.Context, b []byte) error {
 
 	resp, err := a.Config.Verifier.VerifyChallenge(ctx, a.session, cred, nonce, am)
 	if err != nil {
-		if am.UserName == "" {
+		// the remote verifier does not depend on the credential lookup, the
+		// retry would repeat the same network logon.
+		if _, remote := a.Config.Verifier.(*RemoteVerifier); am.UserName == "" || remote {
 			return fmt.Errorf("ntlm: init: verify authenticate: verify challenge response: %w", err)
 		}
 		cred = credential.New(am.UserName,
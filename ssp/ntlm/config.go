@@ -117,6 +117,12 @@ type Config struct {
 	// The flag that indicates whether all the input buffers must be used to
 	// build a signature. (DO NOT USE IT).
 	NoSignAllBuffers bool
+	// The flag that indicates whether the NT hash of the client credential
+	// must be exported as the RC4-HMAC key for the traffic analysis tools
+	// (see gssapi.AttributeExportedKeys). The NT hash is the long-term key
+	// that allows to decrypt any session of the credential, so it is not
+	// exported by default.
+	ExportNTHash bool
 
 	ServerConfigFlags Flag
 	// The NetBIOS computer name of the server.
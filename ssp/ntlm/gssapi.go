@@ -3,7 +3,9 @@ package ntlm
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/oiweiwei/go-msrpc/ssp/credential"
@@ -104,17 +106,24 @@ func (Mechanism) New(ctx context.Context) (gssapi.Mechanism, error) {
 	}
 
 	if cc.CredentialDatabase != nil {
-		if db, ok := cc.CredentialDatabase.Value().(credential.Database); ok {
-			if cc.CredentialDatabase.AllowAnonymous() {
-				c.AllowAnonymous = true
-			}
-			if cc.CredentialDatabase.AllowGuest() {
-				c.AllowGuest = true
-			}
+		if cc.CredentialDatabase.AllowAnonymous() {
+			c.AllowAnonymous = true
+		}
+		if cc.CredentialDatabase.AllowGuest() {
+			c.AllowGuest = true
+		}
+		switch db := cc.CredentialDatabase.Value().(type) {
+		case credential.Database:
 			c.Verifier = &LocalVerifier{
 				Config:   c,
 				Database: db,
 			}
+		case NetworkLogon:
+			// the credentials are verified by the domain controller.
+			c.Verifier = &RemoteVerifier{
+				Config:       c,
+				NetworkLogon: db,
+			}
 		}
 	} else {
 		if c.Verifier == nil {
@@ -209,6 +218,7 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 
 		gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.SessionKey())
 		gssapi.SetAttribute(ctx, gssapi.AttributeTarget, m.TargetName())
+		gssapi.SetAttribute(ctx, gssapi.AttributeExportedKeys, m.exportedKeys(ctx))
 
 		return &gssapi.Token{Payload: b}, gssapi.ContextComplete(ctx)
 	}
@@ -221,6 +231,116 @@ func (m *Mechanism) Init(ctx context.Context, tok *gssapi.Token) (*gssapi.Token,
 	return &gssapi.Token{Payload: b}, gssapi.ContextContinueNeeded(ctx)
 }
 
+// exportedKeys function returns the NT hash of the credential as RC4-HMAC
+// key (the NT hash is the key that allows to decrypt the session, as the
+// session key is derived from it), if the export is enabled with the
+// ExportNTHash flag.
+func (m *Mechanism) exportedKeys(ctx context.Context) []*gssapi.ExportedKey {
+
+	if !m.Config.ExportNTHash {
+		return nil
+	}
+
+	key, err := (&V1{}).NTOWF(ctx, m.Config.Credential)
+	if err != nil || len(key) == 0 {
+		return nil
+	}
+
+	return []*gssapi.ExportedKey{{
+		Principal: []string{m.Config.Credential.UserName()},
+		Realm:     m.Config.Credential.DomainName(),
+		EncType:   23,
+		Key:       key,
+	}}
+}
+
+// exportedContext is the established security context state.
+type exportedContext struct {
+	ExportedSessionKey      []byte `json:"exported_session_key"`
+	ExtendedSessionSecurity bool   `json:"extended_session_security,omitempty"`
+	UseLMKey                bool   `json:"use_lm_key,omitempty"`
+	KeyExchange             bool   `json:"key_exchange,omitempty"`
+	KeySize                 int    `json:"key_size"`
+	Integrity               bool   `json:"integrity,omitempty"`
+	Confidentiality         bool   `json:"confidentiality,omitempty"`
+	Datagram                bool   `json:"datagram,omitempty"`
+	NoSignAllBuffers        bool   `json:"no_sign_all_buffers,omitempty"`
+	ClientSequenceNumber    uint32 `json:"client_sequence_number"`
+	ServerSequenceNumber    uint32 `json:"server_sequence_number"`
+}
+
+// ExportSecurityContext function returns the exported session key, the
+// negotiated session parameters and the sequence numbers of the established
+// security context. The context must be exported before the first message
+// is sealed, since the state of the RC4 cipher is not exported.
+func (m *Mechanism) ExportSecurityContext(ctx context.Context) ([]byte, error) {
+
+	if m.state == nil || m.session == nil {
+		return nil, fmt.Errorf("ntlm: export security context: %w", gssapi.ErrNoContext)
+	}
+
+	exp := &exportedContext{
+		ExportedSessionKey:      m.state.ExportedSessionKey,
+		ExtendedSessionSecurity: m.session.ExtendedSessionSecurity,
+		UseLMKey:                m.session.UseLMKey,
+		KeyExchange:             m.session.KeyExchange,
+		KeySize:                 m.session.KeySize,
+		Integrity:               m.Config.Integrity,
+		Confidentiality:         m.Config.Confidentiality,
+		Datagram:                m.Config.Datagram,
+		NoSignAllBuffers:        m.Config.NoSignAllBuffers,
+		ClientSequenceNumber:    m.state.OutboundSequenceNumber,
+		ServerSequenceNumber:    m.state.InboundSequenceNumber,
+	}
+
+	if m.Config.IsServer {
+		exp.ClientSequenceNumber, exp.ServerSequenceNumber = exp.ServerSequenceNumber, exp.ClientSequenceNumber
+	}
+
+	b, err := json.Marshal(exp)
+	if err != nil {
+		return nil, fmt.Errorf("ntlm: export security context: %w", err)
+	}
+
+	return b, nil
+}
+
+// ImportSecurityContext function establishes the security context from the
+// exported session key and session parameters.
+func (m *Mechanism) ImportSecurityContext(ctx context.Context, b []byte) error {
+
+	exp := &exportedContext{}
+	if err := json.Unmarshal(b, exp); err != nil {
+		return fmt.Errorf("ntlm: import security context: %w", err)
+	}
+
+	m.Reset()
+
+	m.Config.Integrity, m.Config.Confidentiality = exp.Integrity, exp.Confidentiality
+	m.Config.Datagram, m.Config.NoSignAllBuffers = exp.Datagram, exp.NoSignAllBuffers
+
+	m.session = &SecurityParameters{
+		ExtendedSessionSecurity: exp.ExtendedSessionSecurity,
+		UseLMKey:                exp.UseLMKey,
+		KeyExchange:             exp.KeyExchange,
+		Datagram:                exp.Datagram,
+		KeySize:                 exp.KeySize,
+	}
+
+	if err := m.makeSecurityService(ctx, exp.ExportedSessionKey); err != nil {
+		return fmt.Errorf("ntlm: import security context: %w", err)
+	}
+
+	m.state.OutboundSequenceNumber, m.state.InboundSequenceNumber = exp.ClientSequenceNumber, exp.ServerSequenceNumber
+	if m.Config.IsServer {
+		m.state.OutboundSequenceNumber, m.state.InboundSequenceNumber = exp.ServerSequenceNumber, exp.ClientSequenceNumber
+	}
+
+	gssapi.SetAttribute(ctx, gssapi.AttributeSessionKey, m.SessionKey())
+
+	return nil
+}
+
 func (m *Mechanism) Capabilities(ctx context.Context) gssapi.Cap {
 	return m.Config.Capabilities()
 }
@@ -534,6 +654,7 @@ func (m *Mechanism) VerifySignatureEx(ctx context.Context, tokEx *gssapi.Message
 }
 
 var (
-	_ gssapi.Mechanism   = (*Mechanism)(nil)
-	_ gssapi.MechanismEx = (*Mechanism)(nil)
+	_ gssapi.Mechanism       = (*Mechanism)(nil)
+	_ gssapi.MechanismEx     = (*Mechanism)(nil)
+	_ gssapi.ContextExporter = (*Mechanism)(nil)
 )
@@ -0,0 +1,26 @@
+package ntlm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+func TestExportedKeys(t *testing.T) {
+
+	m := &Mechanism{&Authentifier{Config: &Config{
+		Credential: credential.NewFromPassword("Domain\\User", "Password"),
+	}}}
+
+	if keys := m.exportedKeys(context.Background()); len(keys) != 0 {
+		t.Fatalf("exported keys: the NT hash is exported by default")
+	}
+
+	m.Config.ExportNTHash = true
+
+	keys := m.exportedKeys(context.Background())
+	if len(keys) != 1 || keys[0].EncType != 23 || len(keys[0].Key) != 16 {
+		t.Fatalf("exported keys: unexpected keys %v", keys)
+	}
+}
@@ -35,12 +35,12 @@ func (v *LocalVerifier) VerifyChallenge(ctx context.Context, session *SecurityPa
 		ntlm = &V2{Config: v.Config, SecurityParameters: session}
 	}
 
-	cred, ok := v.Lookup(ctx, cred)
+	found, ok := v.Lookup(ctx, cred)
 	if !ok {
 		return nil, fmt.Errorf("ntlm: local verifyer: credential not found for %s", cred.DomainName()+"\\"+cred.UserName())
 	}
 
-	resp, err := ntlm.AuthenticateResponse(ctx, cred, am, nonce)
+	resp, err := ntlm.AuthenticateResponse(ctx, found, am, nonce)
 	if err != nil {
 		return nil, fmt.Errorf("ntlm: local provider: authenticate verification failed: %w", err)
 	}
@@ -52,3 +52,81 @@ func (v *LocalVerifier) VerifyChallenge(ctx context.Context, session *SecurityPa
 
 	return resp, nil
 }
+
+// The NetworkLogon interface represents the authority (usually the domain
+// controller) that validates the challenge response on behalf of the server
+// and returns the user session key, so that the server does not need to know
+// the user credentials.
+type NetworkLogon interface {
+	// NetworkLogon function validates the authenticate message against the
+	// server challenge for the user identified by the credential.
+	NetworkLogon(context.Context, Credential, []byte, *AuthenticateMessage) (*NetworkLogonInfo, error)
+}
+
+// The network logon information returned by the authority.
+type NetworkLogonInfo struct {
+	// The user session key (session base key).
+	UserSessionKey []byte
+	// The LM session key (first 8 bytes of LMOWF).
+	LMSessionKey []byte
+	// The effective user name.
+	UserName string
+	// The logon domain name.
+	DomainName string
+}
+
+// The RemoteVerifier verifies the challenge response using the remote
+// authority (see NetworkLogon) and derives the key exchange key from
+// the returned user session key.
+type RemoteVerifier struct {
+	Config       *Config
+	NetworkLogon NetworkLogon
+}
+
+func (v *RemoteVerifier) VerifyChallenge(ctx context.Context, session *SecurityParameters, cred Credential, nonce []byte, am *AuthenticateMessage) (*ChallengeResponse, error) {
+
+	var (
+		ntlm NTLMVersion
+	)
+
+	ntlm = &V1{Config: v.Config, SecurityParameters: session}
+
+	if len(am.NTChallengeResponse) > 24 {
+		ntlm = &V2{Config: v.Config, SecurityParameters: session}
+	}
+
+	if am.UserName == "" {
+		if !v.Config.AllowAnonymous {
+			return nil, fmt.Errorf("ntlm: remote verifier: anonymous access is not allowed")
+		}
+		// anonymous authentication is verified locally.
+		resp, err := ntlm.AuthenticateResponse(ctx, credential.Anonymous(), am, nonce)
+		if err != nil {
+			return nil, fmt.Errorf("ntlm: remote verifier: authenticate verification failed: %w", err)
+		}
+		return resp, nil
+	}
+
+	info, err := v.NetworkLogon.NetworkLogon(ctx, cred, nonce, am)
+	if err != nil {
+		return nil, fmt.Errorf("ntlm: remote verifier: network logon: %w", err)
+	}
+
+	if len(info.UserSessionKey) != 16 {
+		return nil, fmt.Errorf("ntlm: remote verifier: invalid user session key")
+	}
+
+	resp := &ChallengeResponse{
+		NT:             am.NTChallengeResponse,
+		LM:             am.LMChallengeResponse,
+		SessionBaseKey: info.UserSessionKey,
+		KeyLM:          info.LMSessionKey,
+	}
+
+	resp.KeyExchangeKey, err = ntlm.KeyExchangeKey(ctx, &ChallengeMessage{ServerChallenge: nonce}, resp)
+	if err != nil {
+		return nil, fmt.Errorf("ntlm: remote verifier: key exchange key failed: %w", err)
+	}
+
+	return resp, nil
+}
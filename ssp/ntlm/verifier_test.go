@@ -0,0 +1,119 @@
+package ntlm
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oiweiwei/go-msrpc/ssp/credential"
+)
+
+// testNetworkLogon verifies the challenge response using the local
+// database the same way as the domain controller would.
+type testNetworkLogon struct {
+	db credential.Database
+	// the number of the network logon calls.
+	calls int
+}
+
+func (l *testNetworkLogon) NetworkLogon(ctx context.Context, cred Credential, nonce []byte, am *AuthenticateMessage) (*NetworkLogonInfo, error) {
+
+	l.calls++
+
+	v := &LocalVerifier{Config: &Config{}, Database: l.db}
+
+	resp, err := v.VerifyChallenge(ctx, &SecurityParameters{ServerChallenge: nonce}, cred, nonce, am)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkLogonInfo{
+		UserSessionKey: resp.SessionBaseKey,
+		UserName:       am.UserName,
+		DomainName:     am.DomainName,
+	}, nil
+}
+
+func TestRemoteVerifier(t *testing.T) {
+
+	ctx := context.Background()
+
+	cred := credential.NewFromPassword("Domain\\User", "Password", credential.Workstation("Workstation"))
+
+	db := credential.NewLocalDatabase()
+	db.Add(cred)
+
+	clientConfig := NewConfig()
+	clientConfig.Credential = cred
+	clientConfig.Integrity, clientConfig.Confidentiality = true, true
+
+	client := &Authentifier{Config: clientConfig}
+
+	serverConfig := NewConfig()
+	serverConfig.IsServer = true
+	serverConfig.NetBIOSDomainName = "Domain"
+	serverConfig.NetBIOSComputerName = "Server"
+	logon := &testNetworkLogon{db: db}
+	serverConfig.Verifier = &RemoteVerifier{Config: serverConfig, NetworkLogon: logon}
+
+	server := &Authentifier{Config: serverConfig}
+
+	b, err := client.Negotiate(ctx)
+	if err != nil {
+		t.Fatalf("test_remote_verifier: negotiate: %v", err)
+	}
+
+	if b, err = server.Challenge(ctx, b); err != nil {
+		t.Fatalf("test_remote_verifier: challenge: %v", err)
+	}
+
+	if b, err = client.Authenticate(ctx, b); err != nil {
+		t.Fatalf("test_remote_verifier: authenticate: %v", err)
+	}
+
+	if err = server.VerifyAuthenticate(ctx, b); err != nil {
+		t.Fatalf("test_remote_verifier: verify authenticate: %v", err)
+	}
+
+	if !bytes.Equal(client.SessionKey(), server.SessionKey()) {
+		t.Errorf("test_remote_verifier: session key mismatch")
+	}
+
+	// the invalid password is rejected with the single network logon.
+	clientConfig.Credential = credential.NewFromPassword("Domain\\User", "Invalid", credential.Workstation("Workstation"))
+
+	client, server, logon.calls = &Authentifier{Config: clientConfig}, &Authentifier{Config: serverConfig}, 0
+
+	if b, err = client.Negotiate(ctx); err != nil {
+		t.Fatalf("test_remote_verifier: negotiate: %v", err)
+	}
+
+	if b, err = server.Challenge(ctx, b); err != nil {
+		t.Fatalf("test_remote_verifier: challenge: %v", err)
+	}
+
+	if b, err = client.Authenticate(ctx, b); err != nil {
+		t.Fatalf("test_remote_verifier: authenticate: %v", err)
+	}
+
+	if err = server.VerifyAuthenticate(ctx, b); err == nil {
+		t.Fatalf("test_remote_verifier: verify authenticate: expected error")
+	}
+
+	if logon.calls != 1 {
+		t.Errorf("test_remote_verifier: expected single network logon, got %d", logon.calls)
+	}
+}
+
+func TestLocalVerifierNotFound(t *testing.T) {
+
+	v := &LocalVerifier{Config: &Config{}, Database: credential.NewLocalDatabase()}
+
+	cred := credential.NewFromPassword("Domain\\User", "Password")
+
+	_, err := v.VerifyChallenge(context.Background(), &SecurityParameters{}, cred, make([]byte, 8), &AuthenticateMessage{})
+	if err == nil || !strings.Contains(err.Error(), "Domain\\User") {
+		t.Fatalf("test_local_verifier: expected credential not found error, got %v", err)
+	}
+}
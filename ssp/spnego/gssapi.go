@@ -3,7 +3,9 @@ package spnego
 import (
 	"context"
 	"encoding/asn1"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/oiweiwei/go-msrpc/ssp/gssapi"
 )
@@ -132,6 +134,71 @@ func (m *Mechanism) Accept(ctx context.Context, tok *gssapi.Token) (*gssapi.Toke
 
 }
 
+// exportedContext is the established security context of the negotiated
+// mechanism.
+type exportedContext struct {
+	Mechanism string `json:"mechanism"`
+	Context   []byte `json:"context"`
+}
+
+// ExportSecurityContext function exports the security context of the
+// negotiated mechanism.
+func (m *Mechanism) ExportSecurityContext(ctx context.Context) ([]byte, error) {
+
+	exp, ok := (interface{})(m.Mechanism).(gssapi.ContextExporter)
+	if !ok {
+		return nil, fmt.Errorf("spnego: export security context: %w", gssapi.ErrUnavailable)
+	}
+
+	b, err := exp.ExportSecurityContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("spnego: export security context: %w", err)
+	}
+
+	if b, err = json.Marshal(&exportedContext{Mechanism: m.Mechanism.Type().String(), Context: b}); err != nil {
+		return nil, fmt.Errorf("spnego: export security context: %w", err)
+	}
+
+	return b, nil
+}
+
+// ImportSecurityContext function imports the security context of the
+// negotiated mechanism from the mechanism list.
+func (m *Mechanism) ImportSecurityContext(ctx context.Context, b []byte) error {
+
+	exp := &exportedContext{}
+	if err := json.Unmarshal(b, exp); err != nil {
+		return fmt.Errorf("spnego: import security context: %w", err)
+	}
+
+	for _, f := range m.MechanismsList {
+
+		if f.Type().String() != exp.Mechanism {
+			continue
+		}
+
+		mech, err := f.New(ctx)
+		if err != nil {
+			return fmt.Errorf("spnego: import security context: %w", err)
+		}
+
+		imp, ok := (interface{})(mech).(gssapi.ContextExporter)
+		if !ok {
+			break
+		}
+
+		if err := imp.ImportSecurityContext(ctx, exp.Context); err != nil {
+			return fmt.Errorf("spnego: import security context: %w", err)
+		}
+
+		m.Mechanism = mech
+
+		return nil
+	}
+
+	return fmt.Errorf("spnego: import security context: %s: %w", exp.Mechanism, gssapi.ErrBadMech)
+}
+
 func (m *Mechanism) WrapSizeLimit(ctx context.Context, sz int, conf bool) int {
 	return m.Mechanism.WrapSizeLimit(ctx, sz, conf)
 }
@@ -193,6 +260,7 @@ func (m *Mechanism) VerifySignatureEx(ctx context.Context, tok *gssapi.MessageTo
 }
 
 var (
-	_ gssapi.Mechanism   = (*Mechanism)(nil)
-	_ gssapi.MechanismEx = (*Mechanism)(nil)
+	_ gssapi.Mechanism       = (*Mechanism)(nil)
+	_ gssapi.MechanismEx     = (*Mechanism)(nil)
+	_ gssapi.ContextExporter = (*Mechanism)(nil)
 )